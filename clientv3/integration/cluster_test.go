@@ -17,8 +17,10 @@ package integration
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	"go.etcd.io/etcd/integration"
 	"go.etcd.io/etcd/pkg/testutil"
 	"go.etcd.io/etcd/pkg/types"
@@ -159,3 +161,102 @@ func TestMemberAddUpdateWrongURLs(t *testing.T) {
 		}
 	}
 }
+
+func TestMemberAddForLearner(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.RandClient()
+
+	urls := []string{"http://127.0.0.1:1234"}
+	resp, err := capi.MemberAddAsLearner(context.Background(), urls)
+	if err != nil {
+		t.Fatalf("failed to add member %v", err)
+	}
+
+	if !resp.Member.IsLearner {
+		t.Errorf("Added a member as learner, got resp.Member.IsLearner = %v", resp.Member.IsLearner)
+	}
+
+	numberOfLearners := 0
+	for _, m := range resp.Members {
+		if m.IsLearner {
+			numberOfLearners++
+		}
+	}
+	if numberOfLearners != 1 {
+		t.Errorf("Added 1 learner node to cluster, got %d", numberOfLearners)
+	}
+}
+
+func TestMemberAddForWitness(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	leaderIdx := clus.WaitLeader(t)
+	cli := clus.Client(leaderIdx)
+
+	urls := []string{"http://127.0.0.1:1234"}
+	resp, err := cli.MemberAddAsWitness(context.Background(), urls)
+	if err != nil {
+		t.Fatalf("failed to add member %v", err)
+	}
+	if !resp.Member.IsWitness || resp.Member.IsLearner {
+		t.Errorf("Added a member as witness, got resp.Member = %+v", resp.Member)
+	}
+
+	numberOfWitnesses := 0
+	for _, m := range resp.Members {
+		if m.IsWitness {
+			numberOfWitnesses++
+		}
+	}
+	if numberOfWitnesses != 1 {
+		t.Errorf("Added 1 witness node to cluster, got %d", numberOfWitnesses)
+	}
+
+	// the leader must never be moved to a witness
+	_, err = cli.MoveLeader(context.Background(), resp.Member.ID)
+	if err != rpctypes.ErrWitnessTransferee {
+		t.Errorf("MoveLeader to witness error = %v, want %v", err, rpctypes.ErrWitnessTransferee)
+	}
+}
+
+func TestMemberPromoteMemberNotLearner(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	// member promote request can be sent to any server in cluster,
+	// the request will be auto-forwarded to leader on server-side.
+	// This test explicitly includes the server-side forwarding by
+	// sending the request to follower.
+	leaderIdx := clus.WaitLeader(t)
+	followerIdx := (leaderIdx + 1) % 3
+	cli := clus.Client(followerIdx)
+
+	resp, err := cli.MemberList(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list member %v", err)
+	}
+	if len(resp.Members) != 3 {
+		t.Fatalf("number of members = %d, want %d", len(resp.Members), 3)
+	}
+
+	// promoting any of the voting members in cluster should fail
+	expectedErrKeywords := "can only promote a learner member"
+	for _, m := range resp.Members {
+		_, err = cli.MemberPromote(context.Background(), m.ID)
+		if err == nil {
+			t.Fatalf("expect promoting voting member to fail, got no error")
+		}
+		if !strings.Contains(err.Error(), expectedErrKeywords) {
+			t.Fatalf("expect error to contain %s, got %s", expectedErrKeywords, err.Error())
+		}
+	}
+}
@@ -430,6 +430,67 @@ func TestKVRange(t *testing.T) {
 	}
 }
 
+// TestKVPager ensures the pager gets every key of a range once, at the
+// revision of the first page, and fails once that revision is compacted.
+func TestKVPager(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := context.TODO()
+
+	keys := []string{"a", "b", "c", "d", "e", "f", "g"}
+	for _, k := range keys {
+		if _, err := kv.Put(ctx, k, "v1"); err != nil {
+			t.Fatalf("couldn't put %q (%v)", k, err)
+		}
+	}
+
+	var got []string
+	pages := 0
+	p := clientv3.NewPager(kv, "a", 3, clientv3.WithRange("z"))
+	for p.Next(ctx) {
+		pages++
+		for _, ev := range p.Page().Kvs {
+			got = append(got, string(ev.Key))
+		}
+		if pages == 1 {
+			if _, err := kv.Delete(ctx, "g"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if pages != 3 {
+		t.Errorf("pages = %d, want 3", pages)
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Errorf("keys = %v, want %v", got, keys)
+	}
+
+	p = clientv3.NewPager(kv, "a", 3, clientv3.WithRange("z"))
+	if !p.Next(ctx) {
+		t.Fatal(p.Err())
+	}
+	resp, err := kv.Put(ctx, "a", "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kv.Compact(ctx, resp.Header.Revision); err != nil {
+		t.Fatal(err)
+	}
+	if p.Next(ctx) {
+		t.Fatal("got a page after its revision was compacted")
+	}
+	if err = p.Err(); err != rpctypes.ErrPageTokenCompacted {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrPageTokenCompacted)
+	}
+}
+
 func TestKVGetErrConnClosed(t *testing.T) {
 	defer testutil.AfterTest(t)
 
@@ -239,3 +239,55 @@ func TestMaintenanceStatus(t *testing.T) {
 		t.Fatal("no leader found")
 	}
 }
+
+// TestMaintenanceDefragmentOnline ensures online defragmentation reports its
+// progress and keeps the data written before and during it.
+func TestMaintenanceDefragmentOnline(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	for i := 0; i < 100; i++ {
+		if _, err := cli.Put(context.TODO(), fmt.Sprintf("foo%d", i), "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		for i := 0; i < 100; i++ {
+			if _, err := cli.Put(context.TODO(), fmt.Sprintf("baz%d", i), "bar"); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	reports := 0
+	resp, err := cli.DefragmentOnline(context.TODO(), clus.Members[0].GRPCAddr(), func(*clientv3.DefragmentProgressResponse) {
+		reports++
+	})
+	<-donec
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Done || resp.DbSize == 0 {
+		t.Errorf("final response = %+v, want done with database size", resp)
+	}
+	if reports < 2 {
+		t.Errorf("progress reports = %d, want at least 2", reports)
+	}
+
+	for _, prefix := range []string{"foo", "baz"} {
+		gresp, err := cli.Get(context.TODO(), prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gresp.Count != 100 {
+			t.Errorf("count of %q keys = %d, want 100", prefix, gresp.Count)
+		}
+	}
+}
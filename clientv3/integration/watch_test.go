@@ -877,6 +877,139 @@ func TestWatchWithFilter(t *testing.T) {
 	}
 }
 
+// TestWatchWithRichFilter checks that the key, value, lease and
+// create/modify filters drop the events not matching them on the server.
+func TestWatchWithRichFilter(t *testing.T) {
+	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	client := cluster.RandClient()
+	ctx := context.Background()
+
+	wc := client.Watch(ctx, "a/", clientv3.WithPrefix(),
+		clientv3.WithFilterKeyGlob("a/?"),
+		clientv3.WithFilterKeyRegex("[xy]$"),
+		clientv3.WithFilterValuePrefix("v"),
+		clientv3.WithFilterModify(),
+	)
+
+	for _, kv := range [][2]string{{"a/x", "v1"}, {"a/y", "w1"}, {"a/b/x", "v1"}, {"a/z", "v1"}, {"a/x", "v2"}} {
+		if _, err := client.Put(ctx, kv[0], kv[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.Delete(ctx, "a/x"); err != nil {
+		t.Fatal(err)
+	}
+
+	var evs []*clientv3.Event
+	for len(evs) < 2 {
+		select {
+		case resp := <-wc:
+			evs = append(evs, resp.Events...)
+		case <-time.After(integration.RequestWaitTimeout):
+			t.Fatalf("timed out waiting for events, got %+v", evs)
+		}
+	}
+	if len(evs) != 2 || !evs[0].IsCreate() || string(evs[0].Kv.Key) != "a/x" || evs[1].Type != clientv3.EventTypeDelete {
+		t.Fatalf("expected create and delete of a/x, got %+v", evs)
+	}
+
+	wc = client.Watch(ctx, "a/", clientv3.WithPrefix(), clientv3.WithFilterKeyGlob("a/["))
+	resp, ok := <-wc
+	if !ok || resp.Err() != rpctypes.ErrInvalidWatchFilter {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidWatchFilter, resp.Err())
+	}
+}
+
+// TestWatchResumeToken checks that a watch resumed from the resume token of a
+// response on another member receives the events after it exactly once.
+func TestWatchResumeToken(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer cluster.Terminate(t)
+
+	ctx := context.Background()
+	cli := cluster.Client(0)
+	for _, k := range []string{"a", "b", "c"} {
+		if _, err := cli.Put(ctx, k, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+	resp := <-cluster.Client(1).Watch(wctx, "a", clientv3.WithPrefix(), clientv3.WithRev(1))
+	cancel()
+	if len(resp.Events) == 0 || len(resp.ResumeToken) == 0 {
+		t.Fatalf("expected events with resume token, got %+v", resp)
+	}
+	if _, err := cli.Put(ctx, "a", "v2"); err != nil {
+		t.Fatal(err)
+	}
+
+	wc := cluster.Client(2).Watch(ctx, "a", clientv3.WithPrefix(), clientv3.WithRev(1), clientv3.WithResumeToken(resp.ResumeToken))
+	select {
+	case resp = <-wc:
+		if len(resp.Events) != 1 || string(resp.Events[0].Kv.Value) != "v2" {
+			t.Fatalf("expected the put after the token, got %+v", resp.Events)
+		}
+	case <-time.After(integration.RequestWaitTimeout):
+		t.Fatal("timed out waiting for the resumed watch")
+	}
+}
+
+// TestWatchWithInitialState ensures a watch with the initial state receives the
+// key-values in its range first, and does not receive them again when it
+// resumes after the events following them.
+func TestWatchWithInitialState(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer cluster.Terminate(t)
+
+	ctx := context.Background()
+	// put through another member than the watch, which loses its connection
+	cli, kv := cluster.Client(0), cluster.Client(1)
+	for _, k := range []string{"a", "ab", "b"} {
+		if _, err := kv.Put(ctx, k, k); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	wc := cli.Watch(wctx, "a", clientv3.WithPrefix(), clientv3.WithInitialState())
+	recv := func() clientv3.WatchResponse {
+		select {
+		case resp := <-wc:
+			return resp
+		case <-time.After(integration.RequestWaitTimeout):
+			t.Fatal("timed out waiting for the watch response")
+		}
+		return clientv3.WatchResponse{}
+	}
+	resp := recv()
+	if len(resp.Events) != 2 || string(resp.Events[0].Kv.Key) != "a" || string(resp.Events[1].Kv.Key) != "ab" || !resp.Events[0].IsCreate() {
+		t.Fatalf("expected the initial state, got %+v", resp.Events)
+	}
+
+	if _, err := kv.Put(ctx, "a", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if resp = recv(); len(resp.Events) != 1 || string(resp.Events[0].Kv.Value) != "v2" {
+		t.Fatalf("expected the put after the initial state, got %+v", resp.Events)
+	}
+
+	cluster.Members[0].DropConnections()
+	if _, err := kv.Put(ctx, "ab", "v2"); err != nil {
+		t.Fatal(err)
+	}
+	if resp = recv(); len(resp.Events) != 1 || string(resp.Events[0].Kv.Key) != "ab" {
+		t.Fatalf("expected the put after resuming, got %+v", resp.Events)
+	}
+}
+
 // TestWatchWithCreatedNotification checks that WithCreatedNotify returns a
 // Created watch response.
 func TestWatchWithCreatedNotification(t *testing.T) {
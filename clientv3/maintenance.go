@@ -25,12 +25,13 @@ import (
 )
 
 type (
-	DefragmentResponse pb.DefragmentResponse
-	AlarmResponse      pb.AlarmResponse
-	AlarmMember        pb.AlarmMember
-	StatusResponse     pb.StatusResponse
-	HashKVResponse     pb.HashKVResponse
-	MoveLeaderResponse pb.MoveLeaderResponse
+	DefragmentResponse         pb.DefragmentResponse
+	DefragmentProgressResponse pb.DefragmentProgressResponse
+	AlarmResponse              pb.AlarmResponse
+	AlarmMember                pb.AlarmMember
+	StatusResponse             pb.StatusResponse
+	HashKVResponse             pb.HashKVResponse
+	MoveLeaderResponse         pb.MoveLeaderResponse
 )
 
 type Maintenance interface {
@@ -49,6 +50,12 @@ type Maintenance interface {
 	// times with different endpoints.
 	Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error)
 
+	// DefragmentOnline is like Defragment, but keeps serving requests on the member
+	// while the data is copied, only blocking them for the final switch-over.
+	// If progress is not nil, it is called with each progress report received.
+	// The returned response is the final one, which reports the resulting database size.
+	DefragmentOnline(ctx context.Context, endpoint string, progress func(*DefragmentProgressResponse)) (*DefragmentProgressResponse, error)
+
 	// Status gets the status of the endpoint.
 	Status(ctx context.Context, endpoint string) (*StatusResponse, error)
 
@@ -160,6 +167,33 @@ func (m *maintenance) Defragment(ctx context.Context, endpoint string) (*Defragm
 	return (*DefragmentResponse)(resp), nil
 }
 
+func (m *maintenance) DefragmentOnline(ctx context.Context, endpoint string, progress func(*DefragmentProgressResponse)) (*DefragmentProgressResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	defer cancel()
+	dc, err := remote.DefragmentOnline(ctx, &pb.DefragmentRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	for {
+		resp, err := dc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("defragment stream closed before completion")
+			}
+			return nil, toErr(ctx, err)
+		}
+		if progress != nil {
+			progress((*DefragmentProgressResponse)(resp))
+		}
+		if resp.Done {
+			return (*DefragmentProgressResponse)(resp), nil
+		}
+	}
+}
+
 func (m *maintenance) Status(ctx context.Context, endpoint string) (*StatusResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
@@ -38,12 +38,16 @@ type Op struct {
 	limit        int64
 	sort         *SortOption
 	serializable bool
+	maxStaleness int64
 	keysOnly     bool
 	countOnly    bool
 	minModRev    int64
 	maxModRev    int64
 	minCreateRev int64
 	maxCreateRev int64
+	pageToken    []byte
+	// countFiltered counts the keys passing the minimum revisions
+	countFiltered bool
 
 	// for range, watch
 	rev int64
@@ -66,8 +70,18 @@ type Op struct {
 	// createdNotify is for created event
 	createdNotify bool
 	// filters for watchers
-	filterPut    bool
-	filterDelete bool
+	filterPut         bool
+	filterDelete      bool
+	filterCreate      bool
+	filterModify      bool
+	filterKeyGlob     string
+	filterKeyRegex    string
+	filterValuePrefix []byte
+	filterLease       LeaseID
+	// resumeToken resumes a watch after the response it came with.
+	resumeToken []byte
+	// initialState sends the key-values in the range before the events.
+	initialState bool
 
 	// for put
 	val     []byte
@@ -115,12 +129,21 @@ func (op Op) IsDelete() bool { return op.t == tDeleteRange }
 // IsSerializable returns true if the serializable field is true.
 func (op Op) IsSerializable() bool { return op.serializable == true }
 
+// MaxStaleness returns the operation's bounded-staleness lag, if any.
+func (op Op) MaxStaleness() int64 { return op.maxStaleness }
+
+// PageToken returns the page token the operation resumes a paginated range from, if any.
+func (op Op) PageToken() []byte { return op.pageToken }
+
 // IsKeysOnly returns whether keysOnly is set.
 func (op Op) IsKeysOnly() bool { return op.keysOnly == true }
 
 // IsCountOnly returns whether countOnly is set.
 func (op Op) IsCountOnly() bool { return op.countOnly == true }
 
+// IsCountFiltered returns whether countFiltered is set.
+func (op Op) IsCountFiltered() bool { return op.countFiltered }
+
 // MinModRev returns the operation's minimum modify revision.
 func (op Op) MinModRev() int64 { return op.minModRev }
 
@@ -152,12 +175,15 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		Limit:             op.limit,
 		Revision:          op.rev,
 		Serializable:      op.serializable,
+		MaxStaleness:      op.maxStaleness,
 		KeysOnly:          op.keysOnly,
 		CountOnly:         op.countOnly,
+		CountFiltered:     op.countFiltered,
 		MinModRevision:    op.minModRev,
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		PageToken:         op.pageToken,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -238,14 +264,26 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected sort in delete")
 	case ret.serializable:
 		panic("unexpected serializable in delete")
+	case ret.maxStaleness != 0:
+		panic("unexpected max staleness in delete")
 	case ret.countOnly:
 		panic("unexpected countOnly in delete")
+	case ret.countFiltered:
+		panic("unexpected countFiltered in delete")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in delete")
-	case ret.filterDelete, ret.filterPut:
+	case ret.pageToken != nil:
+		panic("unexpected page token in delete")
+	case ret.filterDelete, ret.filterPut, ret.filterCreate, ret.filterModify:
+		panic("unexpected filter in delete")
+	case ret.filterKeyGlob != "", ret.filterKeyRegex != "", ret.filterValuePrefix != nil, ret.filterLease != 0:
 		panic("unexpected filter in delete")
+	case ret.resumeToken != nil:
+		panic("unexpected resume token in delete")
+	case ret.initialState:
+		panic("unexpected initial state in delete")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in delete")
 	}
@@ -267,14 +305,26 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected sort in put")
 	case ret.serializable:
 		panic("unexpected serializable in put")
+	case ret.maxStaleness != 0:
+		panic("unexpected max staleness in put")
 	case ret.countOnly:
 		panic("unexpected countOnly in put")
+	case ret.countFiltered:
+		panic("unexpected countFiltered in put")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in put")
-	case ret.filterDelete, ret.filterPut:
+	case ret.pageToken != nil:
+		panic("unexpected page token in put")
+	case ret.filterDelete, ret.filterPut, ret.filterCreate, ret.filterModify:
 		panic("unexpected filter in put")
+	case ret.filterKeyGlob != "", ret.filterKeyRegex != "", ret.filterValuePrefix != nil, ret.filterLease != 0:
+		panic("unexpected filter in put")
+	case ret.resumeToken != nil:
+		panic("unexpected resume token in put")
+	case ret.initialState:
+		panic("unexpected initial state in put")
 	case ret.createdNotify:
 		panic("unexpected createdNotify in put")
 	}
@@ -298,12 +348,18 @@ func opWatch(key string, opts ...OpOption) Op {
 		panic("unexpected sort in watch")
 	case ret.serializable:
 		panic("unexpected serializable in watch")
+	case ret.maxStaleness != 0:
+		panic("unexpected max staleness in watch")
 	case ret.countOnly:
 		panic("unexpected countOnly in watch")
+	case ret.countFiltered:
+		panic("unexpected countFiltered in watch")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
 		panic("unexpected create revision filter in watch")
+	case ret.pageToken != nil:
+		panic("unexpected page token in watch")
 	}
 	return ret
 }
@@ -401,6 +457,21 @@ func WithSerializable() OpOption {
 	return func(op *Op) { op.serializable = true }
 }
 
+// WithMaxStaleness makes the linearizable 'Get' request bounded-staleness:
+// the serving member answers once its applied index is at most 'entries'
+// raft entries behind the leader's commit index, instead of waiting to
+// apply everything committed before the request.
+func WithMaxStaleness(entries int64) OpOption {
+	return func(op *Op) { op.maxStaleness = entries }
+}
+
+// WithPageToken makes the 'Get' request return the page after the one whose
+// response carried the given token in NextPageToken. The request must
+// otherwise be the same as the one for the first page.
+func WithPageToken(token []byte) OpOption {
+	return func(op *Op) { op.pageToken = token }
+}
+
 // WithKeysOnly makes the 'Get' request return only the keys and the corresponding
 // values will be omitted.
 func WithKeysOnly() OpOption {
@@ -412,6 +483,12 @@ func WithCountOnly() OpOption {
 	return func(op *Op) { op.countOnly = true }
 }
 
+// WithCountFiltered makes the count of a 'Get' request with minimum revisions
+// that of the keys passing them, instead of all keys in the range.
+func WithCountFiltered() OpOption {
+	return func(op *Op) { op.countFiltered = true }
+}
+
 // WithMinModRev filters out keys for Get with modification revisions less than the given revision.
 func WithMinModRev(rev int64) OpOption { return func(op *Op) { op.minModRev = rev } }
 
@@ -473,6 +550,62 @@ func WithFilterDelete() OpOption {
 	return func(op *Op) { op.filterDelete = true }
 }
 
+// WithFilterCreate discards PUT events creating a key from the watcher.
+func WithFilterCreate() OpOption {
+	return func(op *Op) { op.filterCreate = true }
+}
+
+// WithFilterModify discards PUT events modifying an existing key from the watcher.
+func WithFilterModify() OpOption {
+	return func(op *Op) { op.filterModify = true }
+}
+
+// WithFilterKeyGlob discards events on keys not matching the glob pattern,
+// in the syntax of path.Match, from the watcher. The watcher is canceled
+// with ErrInvalidWatchFilter if the pattern is malformed.
+func WithFilterKeyGlob(pattern string) OpOption {
+	return func(op *Op) { op.filterKeyGlob = pattern }
+}
+
+// WithFilterKeyRegex discards events on keys not matching the regular
+// expression, in RE2 syntax, from the watcher. The expression is not
+// anchored. The watcher is canceled with ErrInvalidWatchFilter if the
+// expression is malformed.
+func WithFilterKeyRegex(expr string) OpOption {
+	return func(op *Op) { op.filterKeyRegex = expr }
+}
+
+// WithFilterValuePrefix discards PUT events whose value does not start with
+// the prefix from the watcher. DELETE events are kept.
+func WithFilterValuePrefix(prefix string) OpOption {
+	return func(op *Op) { op.filterValuePrefix = []byte(prefix) }
+}
+
+// WithFilterLease discards PUT events not attaching the key to the lease
+// from the watcher. DELETE events are kept.
+func WithFilterLease(id LeaseID) OpOption {
+	return func(op *Op) { op.filterLease = id }
+}
+
+// WithResumeToken resumes a watch right after the watch response the token
+// came with, as given by its ResumeToken. The watch must be on the same key
+// or range and have the same filters. The watch receives every event once,
+// even if it resumes on another member, and the revision given by WithRev
+// is only used by servers that do not support resume tokens.
+func WithResumeToken(token []byte) OpOption {
+	return func(op *Op) { op.resumeToken = token }
+}
+
+// WithInitialState makes the watcher receive the key-values in its range as
+// PUT events first, at the revision before the one given by WithRev or at the
+// current revision, then the events after that revision. The key-values come
+// ordered by key in responses at that revision, which is the revision of the
+// created response. If the watch is resumed before they were all received,
+// they are sent again.
+func WithInitialState() OpOption {
+	return func(op *Op) { op.initialState = true }
+}
+
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
@@ -0,0 +1,90 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"errors"
+)
+
+var errNoPageToken = errors.New("clientv3: server returned a partial page without a page token")
+
+// Pager gets the keys of a range a page at a time. All pages are read at the
+// revision of the first one, so together they are a consistent view of the
+// range. If that revision is compacted before the last page has been read,
+// Next fails with rpctypes.ErrPageTokenCompacted and the range has to be
+// started over with a new Pager.
+//
+//	p := clientv3.NewPager(cli, "foo", 100, clientv3.WithPrefix())
+//	for p.Next(ctx) {
+//		for _, kv := range p.Page().Kvs {
+//			// ...
+//		}
+//	}
+//	if err := p.Err(); err != nil {
+//		// ...
+//	}
+type Pager struct {
+	kv   KV
+	key  string
+	opts []OpOption
+
+	token []byte
+	page  *GetResponse
+	err   error
+	done  bool
+}
+
+// NewPager returns a pager over the keys of 'Get(key, opts...)', getting at
+// most pageSize keys per page. The options must not sort the keys other than
+// by key in ascending order.
+func NewPager(kv KV, key string, pageSize int64, opts ...OpOption) *Pager {
+	opts = append(opts[:len(opts):len(opts)], WithLimit(pageSize))
+	return &Pager{kv: kv, key: key, opts: opts}
+}
+
+// Next gets the next page. It returns false once the range is exhausted or
+// on an error, which Err returns.
+func (p *Pager) Next(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	opts := p.opts
+	if p.token != nil {
+		opts = append(opts[:len(opts):len(opts)], WithPageToken(p.token))
+	}
+	resp, err := p.kv.Get(ctx, p.key, opts...)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.page = resp
+	p.token = resp.NextPageToken
+	if p.token == nil {
+		p.done = true
+		if resp.More {
+			// the server does not support paging; do not silently
+			// return part of the range as if it were all of it
+			p.err = errNoPageToken
+		}
+	}
+	return true
+}
+
+// Page returns the page got by the last call to Next.
+func (p *Pager) Page() *GetResponse { return p.page }
+
+// Err returns the error that stopped the pager, if any.
+func (p *Pager) Err() error { return p.err }
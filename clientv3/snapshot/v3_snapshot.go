@@ -157,6 +157,9 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 	if _, err = os.Stat(dbPath); err != nil {
 		return ds, err
 	}
+	if err = checkEngine(dbPath); err != nil {
+		return ds, err
+	}
 
 	db, err := bolt.Open(dbPath, 0400, &bolt.Options{ReadOnly: true})
 	if err != nil {
@@ -283,6 +286,9 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	s.walDir = walDir
 	s.snapDir = filepath.Join(dataDir, "member", "snap")
 	s.skipHashCheck = cfg.SkipHashCheck
+	if err = checkEngine(s.dbPath); err != nil {
+		return err
+	}
 
 	s.lg.Info(
 		"restoring snapshot",
@@ -308,6 +314,19 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	return nil
 }
 
+// checkEngine returns an error if the snapshot was not written by the bbolt
+// storage engine, the only one the snapshot manager reads.
+func checkEngine(dbPath string) error {
+	engine, err := backend.DetectEngine(dbPath)
+	if err != nil {
+		return err
+	}
+	if engine != backend.EngineBolt {
+		return fmt.Errorf("snapshot %q was taken from a member using the %s storage engine; only %s snapshots are supported", dbPath, engine, backend.EngineBolt)
+	}
+	return nil
+}
+
 // saveDB copies the database snapshot to the snapshot directory
 func (s *v3Manager) saveDB() error {
 	f, ferr := os.OpenFile(s.dbPath, os.O_RDONLY, 0600)
@@ -27,6 +27,7 @@ import (
 
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/embed"
+	"go.etcd.io/etcd/mvcc/backend"
 	"go.etcd.io/etcd/pkg/fileutil"
 	"go.etcd.io/etcd/pkg/testutil"
 
@@ -187,6 +188,21 @@ func TestCorruptedBackupFileCheck(t *testing.T) {
 	}
 }
 
+// TestMemoryEngineSnapshotRejected ensures the snapshot manager reports that
+// it does not read a snapshot of the memory storage engine.
+func TestMemoryEngineSnapshotRejected(t *testing.T) {
+	dbPath := filepath.Join(os.TempDir(), fmt.Sprintf("memory%d.db", time.Now().Nanosecond()))
+	defer os.RemoveAll(dbPath)
+	bcfg := backend.DefaultBackendConfig()
+	bcfg.Path, bcfg.Engine = dbPath, backend.EngineMemory
+	backend.New(bcfg).Close()
+
+	sp := NewV3(zap.NewExample())
+	if _, err := sp.Status(dbPath); err == nil || !strings.Contains(err.Error(), "storage engine") {
+		t.Fatalf("expected storage engine error, got %v", err)
+	}
+}
+
 type kv struct {
 	k, v string
 }
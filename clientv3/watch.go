@@ -93,6 +93,10 @@ type WatchResponse struct {
 	// Created is used to indicate the creation of the watcher.
 	Created bool
 
+	// ResumeToken is the position of the watcher after this response, if
+	// the server supports it. WithResumeToken resumes a watch from it.
+	ResumeToken []byte
+
 	closeErr error
 
 	// cancelReason is a reason of canceling watch
@@ -201,6 +205,16 @@ type watchRequest struct {
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
+	// keyGlob, keyRegex, valuePrefix and lease filter out the events not
+	// matching them, if set
+	keyGlob     string
+	keyRegex    string
+	valuePrefix []byte
+	lease       LeaseID
+	// resumeToken is the position to resume the watch from, if set
+	resumeToken []byte
+	// initialState sends the key-values in the range before the events
+	initialState bool
 	// get the previous key-value pair before the event happens
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
@@ -289,6 +303,12 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	if ow.filterDelete {
 		filters = append(filters, pb.WatchCreateRequest_NODELETE)
 	}
+	if ow.filterCreate {
+		filters = append(filters, pb.WatchCreateRequest_NOCREATE)
+	}
+	if ow.filterModify {
+		filters = append(filters, pb.WatchCreateRequest_NOMODIFY)
+	}
 
 	wr := &watchRequest{
 		ctx:            ctx,
@@ -299,6 +319,12 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		progressNotify: ow.progressNotify,
 		fragment:       ow.fragment,
 		filters:        filters,
+		keyGlob:        ow.filterKeyGlob,
+		keyRegex:       ow.filterKeyRegex,
+		valuePrefix:    ow.filterValuePrefix,
+		lease:          ow.filterLease,
+		resumeToken:    ow.resumeToken,
+		initialState:   ow.initialState,
 		prevKV:         ow.prevKV,
 		retc:           make(chan chan WatchResponse, 1),
 	}
@@ -554,6 +580,7 @@ func (w *watchGrpcStream) run() {
 				cur.Events = append(cur.Events, pbresp.Events...)
 				// update "Fragment" field; last response with "Fragment" == false
 				cur.Fragment = pbresp.Fragment
+				cur.ResumeToken = pbresp.ResumeToken
 			}
 
 			switch {
@@ -623,6 +650,9 @@ func (w *watchGrpcStream) run() {
 			if wc, closeErr = w.newWatchClient(); closeErr != nil {
 				return
 			}
+			// drop the fragments of a response cut short; the watchers
+			// resume from their last complete response
+			cur = nil
 			if ws := w.nextResume(); ws != nil {
 				wc.Send(ws.initReq.toPB())
 			}
@@ -667,6 +697,7 @@ func (w *watchGrpcStream) dispatchEvent(pbresp *pb.WatchResponse) bool {
 		CompactRevision: pbresp.CompactRevision,
 		Created:         pbresp.Created,
 		Canceled:        pbresp.Canceled,
+		ResumeToken:     pbresp.ResumeToken,
 		cancelReason:    pbresp.CancelReason,
 	}
 
@@ -797,9 +828,18 @@ func (w *watchGrpcStream) serveSubstream(ws *watcherStream, resumec chan struct{
 			}
 
 			if len(wr.Events) > 0 {
-				nextRev = wr.Events[len(wr.Events)-1].Kv.ModRevision + 1
+				// the events of an initial state are older than the revision
+				// it was sent at
+				if rev := wr.Events[len(wr.Events)-1].Kv.ModRevision + 1; rev > nextRev {
+					nextRev = rev
+				}
 			}
 			ws.initReq.rev = nextRev
+			if len(wr.ResumeToken) != 0 {
+				// resume exactly after this response, whatever the
+				// revisions of the member resumed on
+				ws.initReq.resumeToken = wr.ResumeToken
+			}
 
 			// created event is already sent above,
 			// watcher should not post duplicate events
@@ -960,6 +1000,12 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		RangeEnd:       []byte(wr.end),
 		ProgressNotify: wr.progressNotify,
 		Filters:        wr.filters,
+		KeyGlob:        wr.keyGlob,
+		KeyRegex:       wr.keyRegex,
+		ValuePrefix:    wr.valuePrefix,
+		Lease:          int64(wr.lease),
+		ResumeToken:    wr.resumeToken,
+		InitialState:   wr.initialState,
 		PrevKv:         wr.prevKV,
 		Fragment:       wr.fragment,
 	}
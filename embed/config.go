@@ -29,6 +29,8 @@ import (
 
 	"go.etcd.io/etcd/etcdserver"
 	"go.etcd.io/etcd/etcdserver/api/v3compactor"
+	"go.etcd.io/etcd/mvcc"
+	"go.etcd.io/etcd/mvcc/backend"
 	"go.etcd.io/etcd/pkg/flags"
 	"go.etcd.io/etcd/pkg/netutil"
 	"go.etcd.io/etcd/pkg/srv"
@@ -67,6 +69,21 @@ const (
 	// DefaultStrictReconfigCheck is the default value for "--strict-reconfig-check" flag.
 	// It's enabled by default.
 	DefaultStrictReconfigCheck = true
+	// DefaultLeaseReadClockDrift is the default value for
+	// "--experimental-lease-read-clock-drift" flag.
+	DefaultLeaseReadClockDrift = 100 * time.Millisecond
+	// DefaultLeaderBalanceInterval is the default value for
+	// "--experimental-leader-balance-interval" flag.
+	DefaultLeaderBalanceInterval = 10 * time.Second
+	// DefaultAutoDefragRatio is the default value for
+	// "--experimental-auto-defrag-ratio" flag.
+	DefaultAutoDefragRatio = 0.5
+	// DefaultAutoDefragMinBytes is the default value for
+	// "--experimental-auto-defrag-min-bytes" flag.
+	DefaultAutoDefragMinBytes = 100 * 1024 * 1024
+	// DefaultCompactionBatchLimit is the default value for
+	// "--experimental-compaction-batch-limit" flag.
+	DefaultCompactionBatchLimit = 10000
 	// DefaultEnableV2 is the default value for "--enable-v2" flag.
 	// v2 is enabled by default.
 	// TODO: disable v2 when deprecated.
@@ -273,6 +290,85 @@ type Config struct {
 	ExperimentalInitialCorruptCheck bool          `json:"experimental-initial-corrupt-check"`
 	ExperimentalCorruptCheckTime    time.Duration `json:"experimental-corrupt-check-time"`
 	ExperimentalEnableV2V3          string        `json:"experimental-enable-v2v3"`
+	// ExperimentalLeaseRead is true to let the leader serve linearizable
+	// reads from its lease instead of confirming its leadership with a round
+	// of heartbeats first. Relies on bounded clock drift between members.
+	ExperimentalLeaseRead bool `json:"experimental-lease-read"`
+	// ExperimentalLeaseReadClockDrift is the maximum clock drift between
+	// members over one election timeout that the lease accounts for.
+	ExperimentalLeaseReadClockDrift time.Duration `json:"experimental-lease-read-clock-drift"`
+	// ExperimentalMaxUncommittedEntriesBytes limits the aggregate byte size of
+	// the uncommitted tail of the leader's raft log; proposals beyond it are
+	// rejected with "too many requests". 0 means no limit.
+	ExperimentalMaxUncommittedEntriesBytes uint64 `json:"experimental-max-uncommitted-entries-bytes"`
+	// ExperimentalLeaderPriority is the priority of this member to become the
+	// leader. The leader hands its leadership over to a healthy member with a
+	// higher priority.
+	ExperimentalLeaderPriority int `json:"experimental-leader-priority"`
+	// ExperimentalLeaderBalanceInterval is how long the leader and a member
+	// with a higher priority must have been stable before the leadership is
+	// transferred, and how often that is checked. 0 disables it.
+	ExperimentalLeaderBalanceInterval time.Duration `json:"experimental-leader-balance-interval"`
+	// ExperimentalSnapshotBytes triggers a snapshot once the entries applied
+	// since the last one reach this aggregate size, in addition to
+	// SnapshotCount. 0 disables it.
+	ExperimentalSnapshotBytes uint64 `json:"experimental-snapshot-bytes"`
+	// ExperimentalSnapshotCatchUpBytes limits the aggregate size of the raft
+	// log entries kept in memory for slow followers after a snapshot.
+	// 0 means no limit.
+	ExperimentalSnapshotCatchUpBytes uint64 `json:"experimental-snapshot-catchup-bytes"`
+	// ExperimentalBackendEngine is the storage engine the backend is kept in,
+	// either "bbolt" or "memory". All members must use the same engine.
+	ExperimentalBackendEngine string `json:"experimental-backend-engine"`
+	// ExperimentalRevisionIndex keeps the keys ordered by the revisions they
+	// were modified at, to serve ranges with a minimum modification or
+	// creation revision without visiting every key in the range.
+	ExperimentalRevisionIndex bool `json:"experimental-revision-index"`
+	// ExperimentalAutoDefragCheckInterval is how often the leader checks the
+	// other members for one to defragment. 0 disables it.
+	ExperimentalAutoDefragCheckInterval time.Duration `json:"experimental-auto-defrag-check-interval"`
+	// ExperimentalAutoDefragRatio is the ratio of its backend size a member
+	// must waste to be defragmented automatically.
+	ExperimentalAutoDefragRatio float64 `json:"experimental-auto-defrag-ratio"`
+	// ExperimentalAutoDefragMinBytes is the number of bytes a member must
+	// waste to be defragmented automatically.
+	ExperimentalAutoDefragMinBytes uint64 `json:"experimental-auto-defrag-min-bytes"`
+	// ExperimentalIndexCheckpoint saves the key index on every snapshot, so
+	// that a restart loads it instead of scanning the entire backend.
+	ExperimentalIndexCheckpoint bool `json:"experimental-index-checkpoint"`
+	// ExperimentalCompactionBatchLimit is the maximum number of revisions a
+	// compaction visits in the backend per batch.
+	ExperimentalCompactionBatchLimit int `json:"experimental-compaction-batch-limit"`
+	// ExperimentalCompactionMaxPause bounds how long a batch of a compaction
+	// blocks writes to the backend. 0 does not bound it.
+	ExperimentalCompactionMaxPause time.Duration `json:"experimental-compaction-max-pause"`
+	// ExperimentalCompactionKeysPerSecond limits the revisions a compaction
+	// deletes per second. 0 is unlimited.
+	ExperimentalCompactionKeysPerSecond int64 `json:"experimental-compaction-keys-per-second"`
+	// ExperimentalCompactionBytesPerSecond limits the bytes a compaction
+	// deletes per second. 0 is unlimited.
+	ExperimentalCompactionBytesPerSecond int64 `json:"experimental-compaction-bytes-per-second"`
+	// ExperimentalCompactionPrefixRetention is a comma-separated list of
+	// "prefix=retention" pairs. Compactions keep the history of the keys
+	// under each prefix for the given number of revisions, or for the given
+	// duration such as "72h".
+	ExperimentalCompactionPrefixRetention string `json:"experimental-compaction-prefix-retention"`
+	// ExperimentalValueCompression compresses the values put in the
+	// backend; "flate" or "" to store them verbatim.
+	ExperimentalValueCompression string `json:"experimental-value-compression"`
+	// ExperimentalWatchCacheRevisions is the number of most recent
+	// revisions whose events are held in memory for watchers to catch up
+	// from. 0 disables it.
+	ExperimentalWatchCacheRevisions int64 `json:"experimental-watch-cache-revisions"`
+	// ExperimentalMaxWatchersPerConnection and the other client limits
+	// bound the watchers, lease keepalive streams and buffered watch bytes
+	// of each client connection and authenticated user. 0 is unlimited.
+	ExperimentalMaxWatchersPerConnection           int64 `json:"experimental-max-watchers-per-connection"`
+	ExperimentalMaxWatchersPerUser                 int64 `json:"experimental-max-watchers-per-user"`
+	ExperimentalMaxLeaseKeepAlivesPerConnection    int64 `json:"experimental-max-lease-keepalives-per-connection"`
+	ExperimentalMaxLeaseKeepAlivesPerUser          int64 `json:"experimental-max-lease-keepalives-per-user"`
+	ExperimentalMaxWatchBufferedBytesPerConnection int64 `json:"experimental-max-watch-buffered-bytes-per-connection"`
+	ExperimentalMaxWatchBufferedBytesPerUser       int64 `json:"experimental-max-watch-buffered-bytes-per-user"`
 
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster bool `json:"force-new-cluster"`
@@ -397,6 +493,13 @@ func NewConfig() *Config {
 
 		PreVote: false, // TODO: enable by default in v3.5
 
+		ExperimentalLeaseReadClockDrift:   DefaultLeaseReadClockDrift,
+		ExperimentalLeaderBalanceInterval: DefaultLeaderBalanceInterval,
+		ExperimentalBackendEngine:         backend.EngineBolt,
+		ExperimentalAutoDefragRatio:       DefaultAutoDefragRatio,
+		ExperimentalAutoDefragMinBytes:    DefaultAutoDefragMinBytes,
+		ExperimentalCompactionBatchLimit:  DefaultCompactionBatchLimit,
+
 		loggerMu:            new(sync.RWMutex),
 		logger:              nil,
 		Logger:              "capnslog",
@@ -588,6 +691,62 @@ func (cfg *Config) Validate() error {
 	if cfg.ElectionMs > maxElectionMs {
 		return fmt.Errorf("--election-timeout[%vms] is too long, and should be set less than %vms", cfg.ElectionMs, maxElectionMs)
 	}
+	if cfg.ExperimentalLeaseReadClockDrift < 0 {
+		return fmt.Errorf("--experimental-lease-read-clock-drift must be >=0 (set to %v)", cfg.ExperimentalLeaseReadClockDrift)
+	}
+	if cfg.ExperimentalLeaseRead {
+		// the lease is one heartbeat interval shorter than the election timeout
+		lease := time.Duration(cfg.ElectionMs-cfg.TickMs) * time.Millisecond
+		if cfg.ExperimentalLeaseReadClockDrift+time.Duration(cfg.TickMs)*time.Millisecond > lease {
+			return fmt.Errorf("--experimental-lease-read-clock-drift[%v] leaves no lease within --election-timeout[%vms]", cfg.ExperimentalLeaseReadClockDrift, cfg.ElectionMs)
+		}
+	}
+	if cfg.ExperimentalLeaderBalanceInterval != 0 && cfg.ExperimentalLeaderBalanceInterval < time.Duration(cfg.ElectionMs)*time.Millisecond {
+		return fmt.Errorf("--experimental-leader-balance-interval[%v] should be 0 or at least --election-timeout[%vms]", cfg.ExperimentalLeaderBalanceInterval, cfg.ElectionMs)
+	}
+
+	if !backend.IsValidEngine(cfg.ExperimentalBackendEngine) {
+		return fmt.Errorf("unknown experimental-backend-engine %q", cfg.ExperimentalBackendEngine)
+	}
+	if cfg.ExperimentalAutoDefragRatio <= 0 || cfg.ExperimentalAutoDefragRatio > 1 {
+		return fmt.Errorf("--experimental-auto-defrag-ratio[%v] should be in (0, 1]", cfg.ExperimentalAutoDefragRatio)
+	}
+	if cfg.ExperimentalCompactionBatchLimit <= 0 {
+		return fmt.Errorf("--experimental-compaction-batch-limit[%d] should be positive", cfg.ExperimentalCompactionBatchLimit)
+	}
+	if cfg.ExperimentalCompactionMaxPause < 0 || cfg.ExperimentalCompactionKeysPerSecond < 0 || cfg.ExperimentalCompactionBytesPerSecond < 0 {
+		return fmt.Errorf("--experimental-compaction-max-pause[%v], --experimental-compaction-keys-per-second[%d] and --experimental-compaction-bytes-per-second[%d] should not be negative",
+			cfg.ExperimentalCompactionMaxPause, cfg.ExperimentalCompactionKeysPerSecond, cfg.ExperimentalCompactionBytesPerSecond)
+	}
+	if _, err := v3compactor.ParseRetention(cfg.ExperimentalCompactionPrefixRetention); err != nil {
+		return fmt.Errorf("invalid --experimental-compaction-prefix-retention: %v", err)
+	}
+	switch cfg.ExperimentalValueCompression {
+	case mvcc.ValueCompressionNone, mvcc.ValueCompressionFlate:
+	default:
+		return fmt.Errorf("unknown --experimental-value-compression %q (expected %q)", cfg.ExperimentalValueCompression, mvcc.ValueCompressionFlate)
+	}
+	if cfg.ExperimentalWatchCacheRevisions < 0 {
+		return fmt.Errorf("--experimental-watch-cache-revisions must not be negative (got %d)", cfg.ExperimentalWatchCacheRevisions)
+	}
+	if cfg.ExperimentalMaxWatchersPerConnection < 0 {
+		return fmt.Errorf("--experimental-max-watchers-per-connection must not be negative (got %d)", cfg.ExperimentalMaxWatchersPerConnection)
+	}
+	if cfg.ExperimentalMaxWatchersPerUser < 0 {
+		return fmt.Errorf("--experimental-max-watchers-per-user must not be negative (got %d)", cfg.ExperimentalMaxWatchersPerUser)
+	}
+	if cfg.ExperimentalMaxLeaseKeepAlivesPerConnection < 0 {
+		return fmt.Errorf("--experimental-max-lease-keepalives-per-connection must not be negative (got %d)", cfg.ExperimentalMaxLeaseKeepAlivesPerConnection)
+	}
+	if cfg.ExperimentalMaxLeaseKeepAlivesPerUser < 0 {
+		return fmt.Errorf("--experimental-max-lease-keepalives-per-user must not be negative (got %d)", cfg.ExperimentalMaxLeaseKeepAlivesPerUser)
+	}
+	if cfg.ExperimentalMaxWatchBufferedBytesPerConnection < 0 {
+		return fmt.Errorf("--experimental-max-watch-buffered-bytes-per-connection must not be negative (got %d)", cfg.ExperimentalMaxWatchBufferedBytesPerConnection)
+	}
+	if cfg.ExperimentalMaxWatchBufferedBytesPerUser < 0 {
+		return fmt.Errorf("--experimental-max-watch-buffered-bytes-per-user must not be negative (got %d)", cfg.ExperimentalMaxWatchBufferedBytesPerUser)
+	}
 
 	// check this last since proxying in etcdmain may make this OK
 	if cfg.LCUrls != nil && cfg.ACUrls == nil {
@@ -20,6 +20,7 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"go.etcd.io/etcd/pkg/transport"
 
@@ -175,3 +176,27 @@ func TestAutoCompactionModeParse(t *testing.T) {
 		t.Fatalf("AutoCompactionRetention expected 1, got %d", dur)
 	}
 }
+
+func TestLeaseReadClockDrift(t *testing.T) {
+	tests := []struct {
+		drift time.Duration
+		ok    bool
+	}{
+		{0, true},
+		{DefaultLeaseReadClockDrift, true},
+		{800 * time.Millisecond, true},
+		{801 * time.Millisecond, false},
+		{-time.Millisecond, false},
+	}
+	for i, tt := range tests {
+		cfg := NewConfig()
+		cfg.Logger = "zap"
+		cfg.LogOutputs = []string{"/dev/null"}
+		cfg.Debug = false
+		cfg.ExperimentalLeaseRead = true
+		cfg.ExperimentalLeaseReadClockDrift = tt.drift
+		if err := cfg.Validate(); (err == nil) != tt.ok {
+			t.Errorf("#%d: drift %v, got error %v, want ok %t", i, tt.drift, err, tt.ok)
+		}
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"go.etcd.io/etcd/etcdserver/api/v2http"
 	"go.etcd.io/etcd/etcdserver/api/v2v3"
 	"go.etcd.io/etcd/etcdserver/api/v3client"
+	"go.etcd.io/etcd/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/etcdserver/api/v3rpc"
 	"go.etcd.io/etcd/pkg/debugutil"
 	runtimeutil "go.etcd.io/etcd/pkg/runtime"
@@ -158,48 +159,78 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 	if err != nil {
 		return e, err
 	}
+	prefixRetention, err := v3compactor.ParseRetention(cfg.ExperimentalCompactionPrefixRetention)
+	if err != nil {
+		return e, err
+	}
 
 	srvcfg := etcdserver.ServerConfig{
-		Name:                       cfg.Name,
-		ClientURLs:                 cfg.ACUrls,
-		PeerURLs:                   cfg.APUrls,
-		DataDir:                    cfg.Dir,
-		DedicatedWALDir:            cfg.WalDir,
-		SnapshotCount:              cfg.SnapshotCount,
-		SnapshotCatchUpEntries:     cfg.SnapshotCatchUpEntries,
-		MaxSnapFiles:               cfg.MaxSnapFiles,
-		MaxWALFiles:                cfg.MaxWalFiles,
-		InitialPeerURLsMap:         urlsmap,
-		InitialClusterToken:        token,
-		DiscoveryURL:               cfg.Durl,
-		DiscoveryProxy:             cfg.Dproxy,
-		NewCluster:                 cfg.IsNewCluster(),
-		PeerTLSInfo:                cfg.PeerTLSInfo,
-		TickMs:                     cfg.TickMs,
-		ElectionTicks:              cfg.ElectionTicks(),
-		InitialElectionTickAdvance: cfg.InitialElectionTickAdvance,
-		AutoCompactionRetention:    autoCompactionRetention,
-		AutoCompactionMode:         cfg.AutoCompactionMode,
-		QuotaBackendBytes:          cfg.QuotaBackendBytes,
-		BackendBatchLimit:          cfg.BackendBatchLimit,
-		BackendBatchInterval:       cfg.BackendBatchInterval,
-		MaxTxnOps:                  cfg.MaxTxnOps,
-		MaxRequestBytes:            cfg.MaxRequestBytes,
-		StrictReconfigCheck:        cfg.StrictReconfigCheck,
-		ClientCertAuthEnabled:      cfg.ClientTLSInfo.ClientCertAuth,
-		AuthToken:                  cfg.AuthToken,
-		BcryptCost:                 cfg.BcryptCost,
-		CORS:                       cfg.CORS,
-		HostWhitelist:              cfg.HostWhitelist,
-		InitialCorruptCheck:        cfg.ExperimentalInitialCorruptCheck,
-		CorruptCheckTime:           cfg.ExperimentalCorruptCheckTime,
-		PreVote:                    cfg.PreVote,
-		Logger:                     cfg.logger,
-		LoggerConfig:               cfg.loggerConfig,
-		LoggerCore:                 cfg.loggerCore,
-		LoggerWriteSyncer:          cfg.loggerWriteSyncer,
-		Debug:                      cfg.Debug,
-		ForceNewCluster:            cfg.ForceNewCluster,
+		Name:                               cfg.Name,
+		ClientURLs:                         cfg.ACUrls,
+		PeerURLs:                           cfg.APUrls,
+		DataDir:                            cfg.Dir,
+		DedicatedWALDir:                    cfg.WalDir,
+		SnapshotCount:                      cfg.SnapshotCount,
+		SnapshotCatchUpEntries:             cfg.SnapshotCatchUpEntries,
+		MaxSnapFiles:                       cfg.MaxSnapFiles,
+		MaxWALFiles:                        cfg.MaxWalFiles,
+		InitialPeerURLsMap:                 urlsmap,
+		InitialClusterToken:                token,
+		DiscoveryURL:                       cfg.Durl,
+		DiscoveryProxy:                     cfg.Dproxy,
+		NewCluster:                         cfg.IsNewCluster(),
+		PeerTLSInfo:                        cfg.PeerTLSInfo,
+		TickMs:                             cfg.TickMs,
+		ElectionTicks:                      cfg.ElectionTicks(),
+		InitialElectionTickAdvance:         cfg.InitialElectionTickAdvance,
+		AutoCompactionRetention:            autoCompactionRetention,
+		AutoCompactionMode:                 cfg.AutoCompactionMode,
+		QuotaBackendBytes:                  cfg.QuotaBackendBytes,
+		BackendBatchLimit:                  cfg.BackendBatchLimit,
+		BackendBatchInterval:               cfg.BackendBatchInterval,
+		MaxTxnOps:                          cfg.MaxTxnOps,
+		MaxRequestBytes:                    cfg.MaxRequestBytes,
+		StrictReconfigCheck:                cfg.StrictReconfigCheck,
+		ClientCertAuthEnabled:              cfg.ClientTLSInfo.ClientCertAuth,
+		AuthToken:                          cfg.AuthToken,
+		BcryptCost:                         cfg.BcryptCost,
+		CORS:                               cfg.CORS,
+		HostWhitelist:                      cfg.HostWhitelist,
+		InitialCorruptCheck:                cfg.ExperimentalInitialCorruptCheck,
+		CorruptCheckTime:                   cfg.ExperimentalCorruptCheckTime,
+		PreVote:                            cfg.PreVote,
+		LeaseRead:                          cfg.ExperimentalLeaseRead,
+		LeaseReadClockDrift:                cfg.ExperimentalLeaseReadClockDrift,
+		MaxUncommittedEntriesBytes:         cfg.ExperimentalMaxUncommittedEntriesBytes,
+		LeaderPriority:                     cfg.ExperimentalLeaderPriority,
+		LeaderBalanceInterval:              cfg.ExperimentalLeaderBalanceInterval,
+		SnapshotBytes:                      cfg.ExperimentalSnapshotBytes,
+		SnapshotCatchUpBytes:               cfg.ExperimentalSnapshotCatchUpBytes,
+		BackendEngine:                      cfg.ExperimentalBackendEngine,
+		RevisionIndex:                      cfg.ExperimentalRevisionIndex,
+		AutoDefragCheckInterval:            cfg.ExperimentalAutoDefragCheckInterval,
+		AutoDefragRatio:                    cfg.ExperimentalAutoDefragRatio,
+		AutoDefragMinBytes:                 cfg.ExperimentalAutoDefragMinBytes,
+		IndexCheckpoint:                    cfg.ExperimentalIndexCheckpoint,
+		CompactionBatchLimit:               cfg.ExperimentalCompactionBatchLimit,
+		CompactionMaxPause:                 cfg.ExperimentalCompactionMaxPause,
+		CompactionKeysPerSecond:            cfg.ExperimentalCompactionKeysPerSecond,
+		CompactionBytesPerSecond:           cfg.ExperimentalCompactionBytesPerSecond,
+		CompactionPrefixRetention:          prefixRetention,
+		ValueCompression:                   cfg.ExperimentalValueCompression,
+		WatchCacheRevisions:                cfg.ExperimentalWatchCacheRevisions,
+		MaxWatchersPerConnection:           cfg.ExperimentalMaxWatchersPerConnection,
+		MaxWatchersPerUser:                 cfg.ExperimentalMaxWatchersPerUser,
+		MaxLeaseKeepAlivesPerConnection:    cfg.ExperimentalMaxLeaseKeepAlivesPerConnection,
+		MaxLeaseKeepAlivesPerUser:          cfg.ExperimentalMaxLeaseKeepAlivesPerUser,
+		MaxWatchBufferedBytesPerConnection: cfg.ExperimentalMaxWatchBufferedBytesPerConnection,
+		MaxWatchBufferedBytesPerUser:       cfg.ExperimentalMaxWatchBufferedBytesPerUser,
+		Logger:                             cfg.logger,
+		LoggerConfig:                       cfg.loggerConfig,
+		LoggerCore:                         cfg.loggerCore,
+		LoggerWriteSyncer:                  cfg.loggerWriteSyncer,
+		Debug:                              cfg.Debug,
+		ForceNewCluster:                    cfg.ForceNewCluster,
 	}
 	print(e.cfg.logger, *cfg, srvcfg, memberInitialized)
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
@@ -311,6 +342,27 @@ func print(lg *zap.Logger, ec Config, sc etcdserver.ServerConfig, memberInitiali
 			zap.Bool("initial-election-tick-advance", sc.InitialElectionTickAdvance),
 			zap.Uint64("snapshot-count", sc.SnapshotCount),
 			zap.Uint64("snapshot-catchup-entries", sc.SnapshotCatchUpEntries),
+			zap.Uint64("snapshot-bytes", sc.SnapshotBytes),
+			zap.Uint64("snapshot-catchup-bytes", sc.SnapshotCatchUpBytes),
+			zap.String("backend-engine", sc.BackendEngine),
+			zap.Bool("revision-index", sc.RevisionIndex),
+			zap.String("auto-defrag-check-interval", sc.AutoDefragCheckInterval.String()),
+			zap.Float64("auto-defrag-ratio", sc.AutoDefragRatio),
+			zap.Uint64("auto-defrag-min-bytes", sc.AutoDefragMinBytes),
+			zap.Bool("index-checkpoint", sc.IndexCheckpoint),
+			zap.Int("compaction-batch-limit", sc.CompactionBatchLimit),
+			zap.String("compaction-max-pause", sc.CompactionMaxPause.String()),
+			zap.Int64("compaction-keys-per-second", sc.CompactionKeysPerSecond),
+			zap.Int64("compaction-bytes-per-second", sc.CompactionBytesPerSecond),
+			zap.String("compaction-prefix-retention", ec.ExperimentalCompactionPrefixRetention),
+			zap.String("value-compression", sc.ValueCompression),
+			zap.Int64("watch-cache-revisions", sc.WatchCacheRevisions),
+			zap.Int64("max-watchers-per-connection", sc.MaxWatchersPerConnection),
+			zap.Int64("max-watchers-per-user", sc.MaxWatchersPerUser),
+			zap.Int64("max-lease-keepalives-per-connection", sc.MaxLeaseKeepAlivesPerConnection),
+			zap.Int64("max-lease-keepalives-per-user", sc.MaxLeaseKeepAlivesPerUser),
+			zap.Int64("max-watch-buffered-bytes-per-connection", sc.MaxWatchBufferedBytesPerConnection),
+			zap.Int64("max-watch-buffered-bytes-per-user", sc.MaxWatchBufferedBytesPerUser),
 			zap.Strings("initial-advertise-peer-urls", ec.getAPURLs()),
 			zap.Strings("listen-peer-urls", ec.getLPURLs()),
 			zap.Strings("advertise-client-urls", ec.getACURLs()),
@@ -323,6 +375,11 @@ func print(lg *zap.Logger, ec Config, sc etcdserver.ServerConfig, memberInitiali
 			zap.String("initial-cluster-token", sc.InitialClusterToken),
 			zap.Int64("quota-size-bytes", quota),
 			zap.Bool("pre-vote", sc.PreVote),
+			zap.Bool("lease-read", sc.LeaseRead),
+			zap.String("lease-read-clock-drift", sc.LeaseReadClockDrift.String()),
+			zap.Uint64("max-uncommitted-entries-bytes", sc.MaxUncommittedEntriesBytes),
+			zap.Int("leader-priority", sc.LeaderPriority),
+			zap.String("leader-balance-interval", sc.LeaderBalanceInterval.String()),
 			zap.Bool("initial-corrupt-check", sc.InitialCorruptCheck),
 			zap.String("corrupt-check-time-interval", sc.CorruptCheckTime.String()),
 			zap.String("auto-compaction-mode", sc.AutoCompactionMode),
@@ -15,17 +15,21 @@
 package command
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
+	v3 "go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/mvcc/backend"
 )
 
 var (
 	defragDataDir string
+	defragOnline  bool
 )
 
 // NewDefragCommand returns the cobra command for "Defrag".
@@ -37,6 +41,7 @@ func NewDefragCommand() *cobra.Command {
 	}
 	cmd.PersistentFlags().BoolVar(&epClusterEndpoints, "cluster", false, "use all endpoints from the cluster member list")
 	cmd.Flags().StringVar(&defragDataDir, "data-dir", "", "Optional. If present, defragments a data directory not in use by etcd.")
+	cmd.Flags().BoolVar(&defragOnline, "online", false, "Keep serving requests on the members while defragmenting, and print progress.")
 	return cmd
 }
 
@@ -54,7 +59,12 @@ func defragCommandFunc(cmd *cobra.Command, args []string) {
 	c := mustClientFromCmd(cmd)
 	for _, ep := range endpointsFromCluster(cmd) {
 		ctx, cancel := commandCtx(cmd)
-		_, err := c.Defragment(ctx, ep)
+		var err error
+		if defragOnline {
+			err = defragOnlineMember(ctx, c, ep)
+		} else {
+			_, err = c.Defragment(ctx, ep)
+		}
 		cancel()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to defragment etcd member[%s] (%v)\n", ep, err)
@@ -69,6 +79,17 @@ func defragCommandFunc(cmd *cobra.Command, args []string) {
 	}
 }
 
+func defragOnlineMember(ctx context.Context, c *v3.Client, ep string) error {
+	_, err := c.DefragmentOnline(ctx, ep, func(p *v3.DefragmentProgressResponse) {
+		if p.Done {
+			fmt.Printf("Defragmented etcd member[%s] to %s\n", ep, humanize.Bytes(uint64(p.DbSize)))
+		} else {
+			fmt.Printf("Defragmenting etcd member[%s]: copied %d keys, replayed %d writes\n", ep, p.CopiedKeys, p.ReplayedWrites)
+		}
+	})
+	return err
+}
+
 func defragData(dataDir string) error {
 	var be backend.Backend
 
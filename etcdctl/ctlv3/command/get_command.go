@@ -24,7 +24,9 @@ import (
 
 var (
 	getConsistency string
+	getMaxStale    int64
 	getLimit       int64
+	getPageSize    int64
 	getSortOrder   string
 	getSortTarget  string
 	getPrefix      bool
@@ -43,9 +45,11 @@ func NewGetCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&getConsistency, "consistency", "l", "Linearizable(l) or Serializable(s)")
+	cmd.Flags().Int64Var(&getMaxStale, "max-staleness", 0, "Maximum number of raft entries a linearizable read may lag behind the leader's commit index")
 	cmd.Flags().StringVar(&getSortOrder, "order", "", "Order of results; ASCEND or DESCEND (ASCEND by default)")
 	cmd.Flags().StringVar(&getSortTarget, "sort-by", "", "Sort target; CREATE, KEY, MODIFY, VALUE, or VERSION")
 	cmd.Flags().Int64Var(&getLimit, "limit", 0, "Maximum number of results")
+	cmd.Flags().Int64Var(&getPageSize, "page-size", 0, "Get the results in pages of at most this many keys, all at the revision of the first page")
 	cmd.Flags().BoolVar(&getPrefix, "prefix", false, "Get keys with matching prefix")
 	cmd.Flags().BoolVar(&getFromKey, "from-key", false, "Get keys that are greater than or equal to the given key using byte compare")
 	cmd.Flags().Int64Var(&getRev, "rev", 0, "Specify the kv revision")
@@ -57,12 +61,6 @@ func NewGetCommand() *cobra.Command {
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getGetOp(args)
-	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
-	cancel()
-	if err != nil {
-		ExitWithError(ExitError, err)
-	}
 
 	if printValueOnly {
 		dp, simple := (display).(*simplePrinter)
@@ -71,9 +69,38 @@ func getCommandFunc(cmd *cobra.Command, args []string) {
 		}
 		dp.valueOnly = true
 	}
+
+	if getPageSize > 0 {
+		getPages(cmd, key, opts)
+		return
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
 	display.Get(*resp)
 }
 
+// getPages displays the range a page at a time, each page with its own request.
+func getPages(cmd *cobra.Command, key string, opts []clientv3.OpOption) {
+	p := clientv3.NewPager(mustClientFromCmd(cmd), key, getPageSize, opts...)
+	for {
+		ctx, cancel := commandCtx(cmd)
+		ok := p.Next(ctx)
+		cancel()
+		if !ok {
+			break
+		}
+		display.Get(*p.Page())
+	}
+	if err := p.Err(); err != nil {
+		ExitWithError(ExitError, err)
+	}
+}
+
 func getGetOp(args []string) (string, []clientv3.OpOption) {
 	if len(args) == 0 {
 		ExitWithError(ExitBadArgs, fmt.Errorf("get command needs one argument as key and an optional argument as range_end."))
@@ -91,6 +118,12 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 	default:
 		ExitWithError(ExitBadFeature, fmt.Errorf("unknown consistency flag %q", getConsistency))
 	}
+	if getMaxStale != 0 {
+		if getConsistency != "l" {
+			ExitWithError(ExitBadArgs, fmt.Errorf("`--max-staleness` is only for linearizable reads."))
+		}
+		opts = append(opts, clientv3.WithMaxStaleness(getMaxStale))
+	}
 
 	key := args[0]
 	if len(args) > 1 {
@@ -100,6 +133,12 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithRange(args[1]))
 	}
 
+	if getPageSize < 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("`--page-size` must not be negative."))
+	}
+	if getPageSize > 0 && getLimit != 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("`--page-size` and `--limit` cannot be set at the same time, choose one."))
+	}
 	opts = append(opts, clientv3.WithLimit(getLimit))
 	if getRev > 0 {
 		opts = append(opts, clientv3.WithRev(getRev))
@@ -137,6 +176,9 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		ExitWithError(ExitBadFeature, fmt.Errorf("bad sort target %v", getSortTarget))
 	}
 
+	if getPageSize > 0 && (sortByTarget != clientv3.SortByKey || sortByOrder == clientv3.SortDescend) {
+		ExitWithError(ExitBadArgs, fmt.Errorf("`--page-size` only supports keys sorted by key in ascending order."))
+	}
 	opts = append(opts, clientv3.WithSort(sortByTarget, sortByOrder))
 
 	if getPrefix {
@@ -40,6 +40,7 @@ var (
 	watchPrefix      bool
 	watchInteractive bool
 	watchPrevKey     bool
+	watchInitial     bool
 )
 
 // NewWatchCommand returns the cobra command for "watch".
@@ -54,6 +55,7 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&watchPrefix, "prefix", false, "Watch on a prefix if prefix is set")
 	cmd.Flags().Int64Var(&watchRev, "rev", 0, "Revision to start watching")
 	cmd.Flags().BoolVar(&watchPrevKey, "prev-kv", false, "get the previous key-value pair before the event happens")
+	cmd.Flags().BoolVar(&watchInitial, "initial", false, "get the key-values as PUT events before the events after them")
 
 	return cmd
 }
@@ -150,6 +152,9 @@ func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error)
 	if watchPrevKey {
 		opts = append(opts, clientv3.WithPrevKV())
 	}
+	if watchInitial {
+		opts = append(opts, clientv3.WithInitialState())
+	}
 	return c.Watch(clientv3.WithRequireLeader(context.Background()), key, opts...), nil
 }
 
@@ -207,7 +212,7 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 	if interactive {
 		if watchArgs[0] != "watch" {
 			// "watch" not found
-			watchPrefix, watchRev, watchPrevKey = false, 0, false
+			watchPrefix, watchRev, watchPrevKey, watchInitial = false, 0, false, false
 			return nil, nil, errBadArgsInteractiveWatch
 		}
 		watchArgs = watchArgs[1:]
@@ -262,20 +267,20 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 		}
 		if execExist && execIdx == len(watchArgs)-1 {
 			// "watch foo bar --" should error
-			watchPrefix, watchRev, watchPrevKey = false, 0, false
+			watchPrefix, watchRev, watchPrevKey, watchInitial = false, 0, false, false
 			return nil, nil, errBadArgsNumSeparator
 		}
 
 		flagset := NewWatchCommand().Flags()
 		if perr := flagset.Parse(watchArgs); perr != nil {
-			watchPrefix, watchRev, watchPrevKey = false, 0, false
+			watchPrefix, watchRev, watchPrevKey, watchInitial = false, 0, false, false
 			return nil, nil, perr
 		}
 		pArgs := flagset.Args()
 
 		// "watch" with no argument should error
 		if !execExist && envKey == "" && len(pArgs) < 1 {
-			watchPrefix, watchRev, watchPrevKey = false, 0, false
+			watchPrefix, watchRev, watchPrevKey, watchInitial = false, 0, false, false
 			return nil, nil, errBadArgsNum
 		}
 		// check conflicting arguments
@@ -283,7 +288,7 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 		if !execExist && len(pArgs) > 0 && envKey != "" {
 			// "ETCDCTL_WATCH_KEY=foo watch foo" should error
 			// (watchArgs==["foo"])
-			watchPrefix, watchRev, watchPrevKey = false, 0, false
+			watchPrefix, watchRev, watchPrevKey, watchInitial = false, 0, false, false
 			return nil, nil, errBadArgsNumConflictEnv
 		}
 	}
@@ -324,6 +329,10 @@ func parseWatchArgs(osArgs, commandArgs []string, envKey, envRange string, inter
 		if err != nil {
 			return nil, nil, err
 		}
+		watchInitial, err = flagset.GetBool("initial")
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// "ETCDCTL_WATCH_KEY=foo watch -- echo hello"
@@ -246,6 +246,32 @@ func newConfig() *config {
 	fs.BoolVar(&cfg.ec.ExperimentalInitialCorruptCheck, "experimental-initial-corrupt-check", cfg.ec.ExperimentalInitialCorruptCheck, "Enable to check data corruption before serving any client/peer traffic.")
 	fs.DurationVar(&cfg.ec.ExperimentalCorruptCheckTime, "experimental-corrupt-check-time", cfg.ec.ExperimentalCorruptCheckTime, "Duration of time between cluster corruption check passes.")
 	fs.StringVar(&cfg.ec.ExperimentalEnableV2V3, "experimental-enable-v2v3", cfg.ec.ExperimentalEnableV2V3, "v3 prefix for serving emulated v2 state.")
+	fs.BoolVar(&cfg.ec.ExperimentalLeaseRead, "experimental-lease-read", cfg.ec.ExperimentalLeaseRead, "Enable to serve linearizable reads on the leader from its lease, without a round of heartbeats.")
+	fs.DurationVar(&cfg.ec.ExperimentalLeaseReadClockDrift, "experimental-lease-read-clock-drift", cfg.ec.ExperimentalLeaseReadClockDrift, "Maximum clock drift between members over one election timeout, subtracted from the leader lease.")
+	fs.Uint64Var(&cfg.ec.ExperimentalMaxUncommittedEntriesBytes, "experimental-max-uncommitted-entries-bytes", cfg.ec.ExperimentalMaxUncommittedEntriesBytes, "Maximum aggregate size of uncommitted raft log entries on the leader before proposals are rejected (0 is unlimited).")
+	fs.IntVar(&cfg.ec.ExperimentalLeaderPriority, "experimental-leader-priority", cfg.ec.ExperimentalLeaderPriority, "Priority of this member to become the leader; leadership moves to healthy members with a higher priority.")
+	fs.DurationVar(&cfg.ec.ExperimentalLeaderBalanceInterval, "experimental-leader-balance-interval", cfg.ec.ExperimentalLeaderBalanceInterval, "Duration the leader and a member with a higher priority must be stable before leadership is transferred (0 to disable).")
+	fs.Uint64Var(&cfg.ec.ExperimentalSnapshotBytes, "experimental-snapshot-bytes", cfg.ec.ExperimentalSnapshotBytes, "Aggregate size of committed transactions to trigger a snapshot to disk, in addition to --snapshot-count (0 to disable).")
+	fs.Uint64Var(&cfg.ec.ExperimentalSnapshotCatchUpBytes, "experimental-snapshot-catchup-bytes", cfg.ec.ExperimentalSnapshotCatchUpBytes, "Maximum aggregate size of the raft log entries kept in memory for slow followers after a snapshot (0 is unlimited).")
+	fs.StringVar(&cfg.ec.ExperimentalBackendEngine, "experimental-backend-engine", cfg.ec.ExperimentalBackendEngine, "Storage engine of the backend, one of: bbolt|memory. All members of a cluster must use the same engine.")
+	fs.BoolVar(&cfg.ec.ExperimentalRevisionIndex, "experimental-revision-index", cfg.ec.ExperimentalRevisionIndex, "Enable to index keys by modification revision, to serve ranges with a minimum modification or creation revision without visiting every key in the range.")
+	fs.DurationVar(&cfg.ec.ExperimentalAutoDefragCheckInterval, "experimental-auto-defrag-check-interval", cfg.ec.ExperimentalAutoDefragCheckInterval, "Duration between checks of the leader for a member to defragment automatically (0 to disable).")
+	fs.Float64Var(&cfg.ec.ExperimentalAutoDefragRatio, "experimental-auto-defrag-ratio", cfg.ec.ExperimentalAutoDefragRatio, "Minimum ratio of its backend size a member must waste to be defragmented automatically.")
+	fs.Uint64Var(&cfg.ec.ExperimentalAutoDefragMinBytes, "experimental-auto-defrag-min-bytes", cfg.ec.ExperimentalAutoDefragMinBytes, "Minimum number of bytes a member must waste to be defragmented automatically.")
+	fs.BoolVar(&cfg.ec.ExperimentalIndexCheckpoint, "experimental-index-checkpoint", cfg.ec.ExperimentalIndexCheckpoint, "Enable to save the key index on every snapshot, so that a restart does not scan the entire backend to rebuild it.")
+	fs.IntVar(&cfg.ec.ExperimentalCompactionBatchLimit, "experimental-compaction-batch-limit", cfg.ec.ExperimentalCompactionBatchLimit, "Maximum number of revisions a compaction visits in the backend per batch.")
+	fs.DurationVar(&cfg.ec.ExperimentalCompactionMaxPause, "experimental-compaction-max-pause", cfg.ec.ExperimentalCompactionMaxPause, "Maximum duration a batch of a compaction blocks writes to the backend (0 is unbounded).")
+	fs.Int64Var(&cfg.ec.ExperimentalCompactionKeysPerSecond, "experimental-compaction-keys-per-second", cfg.ec.ExperimentalCompactionKeysPerSecond, "Maximum number of revisions a compaction deletes per second (0 is unlimited).")
+	fs.Int64Var(&cfg.ec.ExperimentalCompactionBytesPerSecond, "experimental-compaction-bytes-per-second", cfg.ec.ExperimentalCompactionBytesPerSecond, "Maximum number of bytes a compaction deletes per second (0 is unlimited).")
+	fs.StringVar(&cfg.ec.ExperimentalCompactionPrefixRetention, "experimental-compaction-prefix-retention", cfg.ec.ExperimentalCompactionPrefixRetention, "Comma-separated 'prefix=retention' pairs; compactions keep the history of the keys under each prefix for a number of revisions or a duration (e.g. '/audit/=72h').")
+	fs.StringVar(&cfg.ec.ExperimentalValueCompression, "experimental-value-compression", cfg.ec.ExperimentalValueCompression, "Compression of the values put in the backend ('flate' or '' to store them verbatim).")
+	fs.Int64Var(&cfg.ec.ExperimentalWatchCacheRevisions, "experimental-watch-cache-revisions", cfg.ec.ExperimentalWatchCacheRevisions, "Number of most recent revisions whose events are held in memory for watchers to catch up from (0 is disabled).")
+	fs.Int64Var(&cfg.ec.ExperimentalMaxWatchersPerConnection, "experimental-max-watchers-per-connection", cfg.ec.ExperimentalMaxWatchersPerConnection, "Maximum watchers of each client connection (0 is unlimited).")
+	fs.Int64Var(&cfg.ec.ExperimentalMaxWatchersPerUser, "experimental-max-watchers-per-user", cfg.ec.ExperimentalMaxWatchersPerUser, "Maximum watchers of each authenticated user (0 is unlimited).")
+	fs.Int64Var(&cfg.ec.ExperimentalMaxLeaseKeepAlivesPerConnection, "experimental-max-lease-keepalives-per-connection", cfg.ec.ExperimentalMaxLeaseKeepAlivesPerConnection, "Maximum lease keepalive streams of each client connection (0 is unlimited).")
+	fs.Int64Var(&cfg.ec.ExperimentalMaxLeaseKeepAlivesPerUser, "experimental-max-lease-keepalives-per-user", cfg.ec.ExperimentalMaxLeaseKeepAlivesPerUser, "Maximum lease keepalive streams of each authenticated user (0 is unlimited).")
+	fs.Int64Var(&cfg.ec.ExperimentalMaxWatchBufferedBytesPerConnection, "experimental-max-watch-buffered-bytes-per-connection", cfg.ec.ExperimentalMaxWatchBufferedBytesPerConnection, "Maximum bytes of watch responses buffered for sending on each client connection (0 is unlimited).")
+	fs.Int64Var(&cfg.ec.ExperimentalMaxWatchBufferedBytesPerUser, "experimental-max-watch-buffered-bytes-per-user", cfg.ec.ExperimentalMaxWatchBufferedBytesPerUser, "Maximum bytes of watch responses buffered for sending for each authenticated user (0 is unlimited).")
 
 	// unsafe
 	fs.BoolVar(&cfg.ec.ForceNewCluster, "force-new-cluster", false, "Force to create a new one member cluster.")
@@ -200,6 +200,58 @@ Experimental feature:
     Duration of time between cluster corruption check passes.
   --experimental-enable-v2v3 ''
     Serve v2 requests through the v3 backend under a given prefix.
+  --experimental-lease-read 'false'
+    Enable to serve linearizable reads on the leader from its lease, without a round of heartbeats.
+  --experimental-lease-read-clock-drift '100ms'
+    Maximum clock drift between members over one election timeout, subtracted from the leader lease.
+  --experimental-max-uncommitted-entries-bytes '0'
+    Maximum aggregate size of uncommitted raft log entries on the leader before proposals are rejected (0 is unlimited).
+  --experimental-leader-priority '0'
+    Priority of this member to become the leader; leadership moves to healthy members with a higher priority.
+  --experimental-leader-balance-interval '10s'
+    Duration the leader and a member with a higher priority must be stable before leadership is transferred (0 to disable).
+  --experimental-snapshot-bytes '0'
+    Aggregate size of committed transactions to trigger a snapshot to disk, in addition to --snapshot-count (0 to disable).
+  --experimental-snapshot-catchup-bytes '0'
+    Maximum aggregate size of the raft log entries kept in memory for slow followers after a snapshot (0 is unlimited).
+  --experimental-backend-engine 'bbolt'
+    Storage engine of the backend, one of: bbolt|memory. All members of a cluster must use the same engine.
+  --experimental-revision-index 'false'
+    Enable to index keys by modification revision, to serve ranges with a minimum modification or creation revision without visiting every key in the range.
+  --experimental-auto-defrag-check-interval '0s'
+    Duration between checks of the leader for a member to defragment automatically (0 to disable).
+  --experimental-auto-defrag-ratio '0.5'
+    Minimum ratio of its backend size a member must waste to be defragmented automatically.
+  --experimental-auto-defrag-min-bytes '104857600'
+    Minimum number of bytes a member must waste to be defragmented automatically.
+  --experimental-index-checkpoint 'false'
+    Enable to save the key index on every snapshot, so that a restart does not scan the entire backend to rebuild it.
+  --experimental-compaction-batch-limit '10000'
+    Maximum number of revisions a compaction visits in the backend per batch.
+  --experimental-compaction-max-pause '0s'
+    Maximum duration a batch of a compaction blocks writes to the backend (0 is unbounded).
+  --experimental-compaction-keys-per-second '0'
+    Maximum number of revisions a compaction deletes per second (0 is unlimited).
+  --experimental-compaction-bytes-per-second '0'
+    Maximum number of bytes a compaction deletes per second (0 is unlimited).
+  --experimental-compaction-prefix-retention ''
+    Comma-separated 'prefix=retention' pairs; compactions keep the history of the keys under each prefix for a number of revisions or a duration (e.g. '/audit/=72h').
+  --experimental-value-compression ''
+    Compression of the values put in the backend ('flate' or '' to store them verbatim).
+  --experimental-watch-cache-revisions '0'
+    Number of most recent revisions whose events are held in memory for watchers to catch up from (0 is disabled).
+  --experimental-max-watchers-per-connection '0'
+    Maximum watchers of each client connection (0 is unlimited).
+  --experimental-max-watchers-per-user '0'
+    Maximum watchers of each authenticated user (0 is unlimited).
+  --experimental-max-lease-keepalives-per-connection '0'
+    Maximum lease keepalive streams of each client connection (0 is unlimited).
+  --experimental-max-lease-keepalives-per-user '0'
+    Maximum lease keepalive streams of each authenticated user (0 is unlimited).
+  --experimental-max-watch-buffered-bytes-per-connection '0'
+    Maximum bytes of watch responses buffered for sending on each client connection (0 is unlimited).
+  --experimental-max-watch-buffered-bytes-per-user '0'
+    Maximum bytes of watch responses buffered for sending for each authenticated user (0 is unlimited).
 
 Unsafe feature:
   --force-new-cluster 'false'
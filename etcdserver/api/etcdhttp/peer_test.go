@@ -15,7 +15,9 @@
 package etcdhttp
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -26,8 +28,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/coreos/go-semver/semver"
+	"go.etcd.io/etcd/etcdserver"
+	"go.etcd.io/etcd/etcdserver/api"
 	"go.etcd.io/etcd/etcdserver/api/membership"
 	"go.etcd.io/etcd/etcdserver/api/rafthttp"
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
 	"go.etcd.io/etcd/pkg/testutil"
 	"go.etcd.io/etcd/pkg/types"
 )
@@ -51,13 +56,37 @@ func (c *fakeCluster) Members() []*membership.Member {
 func (c *fakeCluster) Member(id types.ID) *membership.Member { return c.members[uint64(id)] }
 func (c *fakeCluster) Version() *semver.Version              { return nil }
 
+type fakeServer struct {
+	cluster    api.Cluster
+	promoteErr error
+}
+
+func (s *fakeServer) AddMember(ctx context.Context, memb membership.Member) ([]*membership.Member, error) {
+	return nil, fmt.Errorf("AddMember not implemented in fakeServer")
+}
+func (s *fakeServer) RemoveMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
+	return nil, fmt.Errorf("RemoveMember not implemented in fakeServer")
+}
+func (s *fakeServer) UpdateMember(ctx context.Context, updateMemb membership.Member) ([]*membership.Member, error) {
+	return nil, fmt.Errorf("UpdateMember not implemented in fakeServer")
+}
+func (s *fakeServer) PromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
+	if s.promoteErr != nil {
+		return nil, s.promoteErr
+	}
+	return s.cluster.Members(), nil
+}
+func (s *fakeServer) ClusterVersion() *semver.Version { return nil }
+func (s *fakeServer) Cluster() api.Cluster            { return s.cluster }
+func (s *fakeServer) Alarms() []*pb.AlarmMember       { return nil }
+
 // TestNewPeerHandlerOnRaftPrefix tests that NewPeerHandler returns a handler that
 // handles raft-prefix requests well.
 func TestNewPeerHandlerOnRaftPrefix(t *testing.T) {
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("test data"))
 	})
-	ph := newPeerHandler(zap.NewExample(), &fakeCluster{}, h, nil)
+	ph := newPeerHandler(zap.NewExample(), &fakeServer{cluster: &fakeCluster{}}, h, nil, nil)
 	srv := httptest.NewServer(ph)
 	defer srv.Close()
 
@@ -128,8 +157,8 @@ func TestServeMembersGet(t *testing.T) {
 		wct   string
 		wbody string
 	}{
-		{peerMembersPrefix, http.StatusOK, "application/json", wms},
-		{path.Join(peerMembersPrefix, "bad"), http.StatusBadRequest, "text/plain; charset=utf-8", "bad path\n"},
+		{peerMembersPath, http.StatusOK, "application/json", wms},
+		{path.Join(peerMembersPath, "bad"), http.StatusBadRequest, "text/plain; charset=utf-8", "bad path\n"},
 	}
 
 	for i, tt := range tests {
@@ -156,3 +185,48 @@ func TestServeMembersGet(t *testing.T) {
 		}
 	}
 }
+
+func TestServeMemberPromote(t *testing.T) {
+	memb1 := membership.Member{ID: 1, Attributes: membership.Attributes{ClientURLs: []string{"http://localhost:8080"}}}
+	memb2 := membership.Member{ID: 2, Attributes: membership.Attributes{ClientURLs: []string{"http://localhost:8081"}}}
+	cluster := &fakeCluster{
+		id:      1,
+		members: map[uint64]*membership.Member{1: &memb1, 2: &memb2},
+	}
+	msb, err := json.Marshal([]membership.Member{memb1, memb2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wms := string(msb) + "\n"
+
+	tests := []struct {
+		method string
+		path   string
+		err    error
+		wcode  int
+		wbody  string
+	}{
+		{"GET", peerMemberPromotePrefix + "2", nil, http.StatusMethodNotAllowed, "Method Not Allowed\n"},
+		{"POST", peerMemberPromotePrefix + "bad", nil, http.StatusNotFound, "member bad not found in cluster\n"},
+		{"POST", peerMemberPromotePrefix + "2", nil, http.StatusOK, wms},
+		{"POST", peerMemberPromotePrefix + "3", membership.ErrIDNotFound, http.StatusNotFound, membership.ErrIDNotFound.Error() + "\n"},
+		{"POST", peerMemberPromotePrefix + "1", membership.ErrMemberNotLearner, http.StatusPreconditionFailed, membership.ErrMemberNotLearner.Error() + "\n"},
+		{"POST", peerMemberPromotePrefix + "2", etcdserver.ErrLearnerNotReady, http.StatusPreconditionFailed, etcdserver.ErrLearnerNotReady.Error() + "\n"},
+	}
+	for i, tt := range tests {
+		h := newPeerMemberPromoteHandler(zap.NewExample(), &fakeServer{cluster: cluster, promoteErr: tt.err})
+		req, err := http.NewRequest(tt.method, testutil.MustNewURL(t, tt.path).String(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rw := httptest.NewRecorder()
+		h.ServeHTTP(rw, req)
+
+		if rw.Code != tt.wcode {
+			t.Errorf("#%d: code=%d, want %d", i, rw.Code, tt.wcode)
+		}
+		if rw.Body.String() != tt.wbody {
+			t.Errorf("#%d: body = %q, want %q", i, rw.Body.String(), tt.wbody)
+		}
+	}
+}
@@ -308,6 +308,51 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 1)}}
+	ctx1, err := json.Marshal(&Member{ID: types.ID(1), RaftAttributes: attr})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 6)}, IsLearner: true}
+	ctx6, err := json.Marshal(&Member{ID: types.ID(6), RaftAttributes: attr})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 7)}, IsLearner: true}
+	ctx7, err := json.Marshal(&Member{ID: types.ID(7), RaftAttributes: attr})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 9)}, IsWitness: true}
+	ctx9, err := json.Marshal(&Member{ID: types.ID(9), RaftAttributes: attr})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 10)}, IsWitness: true}
+	ctx10, err := json.Marshal(&Member{ID: types.ID(10), RaftAttributes: attr})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctxPromote1, err := json.Marshal(&ConfigChangeContext{Member: Member{ID: types.ID(1)}, IsPromote: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctxPromote6, err := json.Marshal(&ConfigChangeContext{Member: Member{ID: types.ID(6)}, IsPromote: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctxPromote8, err := json.Marshal(&ConfigChangeContext{Member: Member{ID: types.ID(8)}, IsPromote: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		cc   raftpb.ConfChange
 		werr error
@@ -335,8 +380,9 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 		},
 		{
 			raftpb.ConfChange{
-				Type:   raftpb.ConfChangeAddNode,
-				NodeID: 1,
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  1,
+				Context: ctx1,
 			},
 			ErrIDExists,
 		},
@@ -388,6 +434,40 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddLearnerNode,
+				NodeID:  6,
+				Context: ctx6,
+			},
+			nil,
+		},
+		{
+			raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddWitnessNode,
+				NodeID:  9,
+				Context: ctx9,
+			},
+			nil,
+		},
+		// promote a voting member
+		{
+			raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  1,
+				Context: ctxPromote1,
+			},
+			ErrMemberNotLearner,
+		},
+		// promote a member that does not exist
+		{
+			raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  8,
+				Context: ctxPromote8,
+			},
+			ErrIDNotFound,
+		},
 	}
 	for i, tt := range tests {
 		err := cl.ValidateConfigurationChange(tt.cc)
@@ -395,6 +475,21 @@ func TestClusterValidateConfigurationChange(t *testing.T) {
 			t.Errorf("#%d: validateConfigurationChange error = %v, want %v", i, err, tt.werr)
 		}
 	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 6)}, IsLearner: true}
+	cl.AddMember(&Member{ID: types.ID(6), RaftAttributes: attr})
+	if err = cl.ValidateConfigurationChange(raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 7, Context: ctx7}); err != ErrTooManyLearners {
+		t.Errorf("validateConfigurationChange error = %v, want %v", err, ErrTooManyLearners)
+	}
+	if err = cl.ValidateConfigurationChange(raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 6, Context: ctxPromote6}); err != nil {
+		t.Errorf("validateConfigurationChange error = %v, want nil", err)
+	}
+
+	attr = RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 9)}, IsWitness: true}
+	cl.AddMember(&Member{ID: types.ID(9), RaftAttributes: attr})
+	if err = cl.ValidateConfigurationChange(raftpb.ConfChange{Type: raftpb.ConfChangeAddWitnessNode, NodeID: 10, Context: ctx10}); err != ErrTooManyWitnesses {
+		t.Errorf("validateConfigurationChange error = %v, want %v", err, ErrTooManyWitnesses)
+	}
 }
 
 func TestClusterGenID(t *testing.T) {
@@ -570,7 +665,7 @@ func newTestCluster(membs []*Member) *RaftCluster {
 
 func stringp(s string) *string { return &s }
 
-func TestIsReadyToAddNewMember(t *testing.T) {
+func TestIsReadyToAddVotingMember(t *testing.T) {
 	tests := []struct {
 		members []*Member
 		want    bool
@@ -644,13 +739,13 @@ func TestIsReadyToAddNewMember(t *testing.T) {
 	}
 	for i, tt := range tests {
 		c := newTestCluster(tt.members)
-		if got := c.IsReadyToAddNewMember(); got != tt.want {
+		if got := c.IsReadyToAddVotingMember(); got != tt.want {
 			t.Errorf("%d: isReadyToAddNewMember returned %t, want %t", i, got, tt.want)
 		}
 	}
 }
 
-func TestIsReadyToRemoveMember(t *testing.T) {
+func TestIsReadyToRemoveVotingMember(t *testing.T) {
 	tests := []struct {
 		members  []*Member
 		removeID uint64
@@ -729,8 +824,105 @@ func TestIsReadyToRemoveMember(t *testing.T) {
 	}
 	for i, tt := range tests {
 		c := newTestCluster(tt.members)
-		if got := c.IsReadyToRemoveMember(tt.removeID); got != tt.want {
+		if got := c.IsReadyToRemoveVotingMember(tt.removeID); got != tt.want {
 			t.Errorf("%d: isReadyToAddNewMember returned %t, want %t", i, got, tt.want)
 		}
 	}
 }
+
+func TestIsReadyToPromoteMember(t *testing.T) {
+	tests := []struct {
+		members   []*Member
+		promoteID uint64
+		want      bool
+	}{
+		{
+			// 1/1 members ready, should succeed (quorum = 1, new quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMemberAsLearner(2, nil, "2", nil),
+			},
+			2,
+			true,
+		},
+		{
+			// 0/1 members ready, should fail (quorum = 1)
+			[]*Member{
+				newTestMember(1, nil, "", nil),
+				newTestMemberAsLearner(2, nil, "2", nil),
+			},
+			2,
+			false,
+		},
+		{
+			// 2/2 members ready, should succeed (quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "2", nil),
+				newTestMemberAsLearner(3, nil, "3", nil),
+			},
+			3,
+			true,
+		},
+		{
+			// 0/2 members ready, should fail (quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "", nil),
+				newTestMember(2, nil, "", nil),
+				newTestMemberAsLearner(3, nil, "3", nil),
+			},
+			3,
+			false,
+		},
+		{
+			// 1/2 members ready, should succeed (quorum = 2, new quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "", nil),
+				newTestMemberAsLearner(3, nil, "3", nil),
+			},
+			3,
+			true,
+		},
+		{
+			// 1/3 members ready, should fail (quorum = 2)
+			[]*Member{
+				newTestMember(1, nil, "1", nil),
+				newTestMember(2, nil, "", nil),
+				newTestMember(3, nil, "", nil),
+				newTestMemberAsLearner(4, nil, "4", nil),
+			},
+			4,
+			false,
+		},
+	}
+	for i, tt := range tests {
+		c := newTestCluster(tt.members)
+		if got := c.IsReadyToPromoteMember(tt.promoteID); got != tt.want {
+			t.Errorf("%d: isReadyToPromoteMember returned %t, want %t", i, got, tt.want)
+		}
+	}
+}
+
+func TestClusterPromoteMember(t *testing.T) {
+	st := mockstore.NewRecorder()
+	c := newTestCluster([]*Member{
+		newTestMember(1, nil, "node1", nil),
+		newTestMemberAsLearner(2, nil, "node2", nil),
+	})
+	c.SetStore(st)
+	c.PromoteMember(2)
+
+	if m := c.Member(2); m.IsLearner {
+		t.Errorf("member 2 IsLearner = true, want false")
+	}
+	if ids := c.VotingMemberIDs(); !reflect.DeepEqual(ids, []types.ID{1, 2}) {
+		t.Errorf("voting member ids = %v, want %v", ids, []types.ID{1, 2})
+	}
+	wactions := []testutil.Action{
+		{Name: "Update", Params: []interface{}{path.Join(MemberStoreKey(2), raftAttributesSuffix), `{"peerURLs":null}`, v2store.TTLOptionSet{ExpireTime: v2store.Permanent}}},
+	}
+	if g := st.Action(); !reflect.DeepEqual(g, wactions) {
+		t.Errorf("actions = %v, want %v", g, wactions)
+	}
+}
@@ -35,12 +35,19 @@ type RaftAttributes struct {
 	// PeerURLs is the list of peers in the raft cluster.
 	// TODO(philips): ensure these are URLs
 	PeerURLs []string `json:"peerURLs"`
+	// IsLearner indicates if the member is raft learner.
+	IsLearner bool `json:"isLearner,omitempty"`
+	// IsWitness indicates if the member is raft witness, a voting member
+	// that does not keep the keyspace.
+	IsWitness bool `json:"isWitness,omitempty"`
 }
 
 // Attributes represents all the non-raft related attributes of an etcd member.
 type Attributes struct {
 	Name       string   `json:"name,omitempty"`
 	ClientURLs []string `json:"clientURLs,omitempty"`
+	// Priority is the priority of the member to become the leader.
+	Priority int `json:"priority,omitempty"`
 }
 
 type Member struct {
@@ -52,9 +59,30 @@ type Member struct {
 // NewMember creates a Member without an ID and generates one based on the
 // cluster name, peer URLs, and time. This is used for bootstrapping/adding new member.
 func NewMember(name string, peerURLs types.URLs, clusterName string, now *time.Time) *Member {
+	return newMember(name, peerURLs, clusterName, now, false)
+}
+
+// NewMemberAsLearner creates a learner Member without an ID and generates one based on the
+// cluster name, peer URLs, and time. This is used for adding new learner member.
+func NewMemberAsLearner(name string, peerURLs types.URLs, clusterName string, now *time.Time) *Member {
+	return newMember(name, peerURLs, clusterName, now, true)
+}
+
+// NewMemberAsWitness creates a witness Member without an ID and generates one based on the
+// cluster name, peer URLs, and time. This is used for adding new witness member.
+func NewMemberAsWitness(name string, peerURLs types.URLs, clusterName string, now *time.Time) *Member {
+	m := newMember(name, peerURLs, clusterName, now, false)
+	m.IsWitness = true
+	return m
+}
+
+func newMember(name string, peerURLs types.URLs, clusterName string, now *time.Time, isLearner bool) *Member {
 	m := &Member{
-		RaftAttributes: RaftAttributes{PeerURLs: peerURLs.StringSlice()},
-		Attributes:     Attributes{Name: name},
+		RaftAttributes: RaftAttributes{
+			PeerURLs:  peerURLs.StringSlice(),
+			IsLearner: isLearner,
+		},
+		Attributes: Attributes{Name: name},
 	}
 
 	var b []byte
@@ -88,8 +116,13 @@ func (m *Member) Clone() *Member {
 	}
 	mm := &Member{
 		ID: m.ID,
+		RaftAttributes: RaftAttributes{
+			IsLearner: m.IsLearner,
+			IsWitness: m.IsWitness,
+		},
 		Attributes: Attributes{
-			Name: m.Name,
+			Name:     m.Name,
+			Priority: m.Priority,
 		},
 	}
 	if m.PeerURLs != nil {
@@ -94,6 +94,7 @@ func TestMemberClone(t *testing.T) {
 		newTestMember(1, []string{"http://a"}, "abc", nil),
 		newTestMember(1, nil, "abc", []string{"http://b"}),
 		newTestMember(1, []string{"http://a"}, "abc", []string{"http://b"}),
+		newTestMemberAsLearner(1, []string{"http://a"}, "abc", []string{"http://b"}),
 	}
 	for i, tt := range tests {
 		nm := tt.Clone()
@@ -113,3 +114,11 @@ func newTestMember(id uint64, peerURLs []string, name string, clientURLs []strin
 		Attributes:     Attributes{Name: name, ClientURLs: clientURLs},
 	}
 }
+
+func newTestMemberAsLearner(id uint64, peerURLs []string, name string, clientURLs []string) *Member {
+	return &Member{
+		ID:             types.ID(id),
+		RaftAttributes: RaftAttributes{PeerURLs: peerURLs, IsLearner: true},
+		Attributes:     Attributes{Name: name, ClientURLs: clientURLs},
+	}
+}
@@ -48,6 +48,9 @@ var (
 	// A map of valid files that can be present in the snap folder.
 	validFiles = map[string]bool{
 		"db": true,
+		// the key index checkpoint and the one being written
+		"db.index":     true,
+		"db.index.tmp": true,
 	}
 )
 
@@ -131,6 +131,10 @@ func (s *serverRecorder) UpdateMember(_ context.Context, m membership.Member) ([
 	s.actions = append(s.actions, action{name: "UpdateMember", params: []interface{}{m}})
 	return nil, nil
 }
+func (s *serverRecorder) PromoteMember(_ context.Context, id uint64) ([]*membership.Member, error) {
+	s.actions = append(s.actions, action{name: "PromoteMember", params: []interface{}{id}})
+	return nil, nil
+}
 
 type action struct {
 	name   string
@@ -168,6 +172,9 @@ func (rs *resServer) RemoveMember(_ context.Context, _ uint64) ([]*membership.Me
 func (rs *resServer) UpdateMember(_ context.Context, _ membership.Member) ([]*membership.Member, error) {
 	return nil, nil
 }
+func (rs *resServer) PromoteMember(_ context.Context, _ uint64) ([]*membership.Member, error) {
+	return nil, nil
+}
 
 func boolp(b bool) *bool { return &b }
 
@@ -49,8 +49,9 @@ func NewServer(lg *zap.Logger, c *clientv3.Client, pfx string) etcdserver.Server
 
 func (s *v2v3Server) ClientCertAuthEnabled() bool { return false }
 
-func (s *v2v3Server) LeaseHandler() http.Handler { panic("STUB: lease handler") }
-func (s *v2v3Server) RaftHandler() http.Handler  { panic("STUB: raft handler") }
+func (s *v2v3Server) LeaseHandler() http.Handler  { panic("STUB: lease handler") }
+func (s *v2v3Server) RaftHandler() http.Handler   { panic("STUB: raft handler") }
+func (s *v2v3Server) DefragHandler() http.Handler { panic("STUB: defrag handler") }
 
 func (s *v2v3Server) Leader() types.ID {
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
@@ -63,7 +64,17 @@ func (s *v2v3Server) Leader() types.ID {
 }
 
 func (s *v2v3Server) AddMember(ctx context.Context, memb membership.Member) ([]*membership.Member, error) {
-	resp, err := s.c.MemberAdd(ctx, memb.PeerURLs)
+	var (
+		resp *clientv3.MemberAddResponse
+		err  error
+	)
+	if memb.IsLearner {
+		resp, err = s.c.MemberAddAsLearner(ctx, memb.PeerURLs)
+	} else if memb.IsWitness {
+		resp, err = s.c.MemberAddAsWitness(ctx, memb.PeerURLs)
+	} else {
+		resp, err = s.c.MemberAdd(ctx, memb.PeerURLs)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -86,13 +97,23 @@ func (s *v2v3Server) UpdateMember(ctx context.Context, m membership.Member) ([]*
 	return v3MembersToMembership(resp.Members), nil
 }
 
+func (s *v2v3Server) PromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
+	resp, err := s.c.MemberPromote(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return v3MembersToMembership(resp.Members), nil
+}
+
 func v3MembersToMembership(v3membs []*pb.Member) []*membership.Member {
 	membs := make([]*membership.Member, len(v3membs))
 	for i, m := range v3membs {
 		membs[i] = &membership.Member{
 			ID: types.ID(m.ID),
 			RaftAttributes: membership.RaftAttributes{
-				PeerURLs: m.PeerURLs,
+				PeerURLs:  m.PeerURLs,
+				IsLearner: m.IsLearner,
+				IsWitness: m.IsWitness,
 			},
 			Attributes: membership.Attributes{
 				Name:       m.Name,
@@ -0,0 +1,187 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+// PrefixRetention keeps the history of the keys under Prefix past
+// compactions, either for the last Revisions revisions or for the
+// revisions made in the last Window.
+type PrefixRetention struct {
+	Prefix    string
+	Revisions int64
+	Window    time.Duration
+}
+
+func (p PrefixRetention) String() string {
+	if p.Window != 0 {
+		return fmt.Sprintf("%s=%v", p.Prefix, p.Window)
+	}
+	return fmt.Sprintf("%s=%d", p.Prefix, p.Revisions)
+}
+
+// ParseRetention parses a comma-separated list of "prefix=retention"
+// pairs, where each retention is either a number of revisions or a
+// duration such as "72h".
+func ParseRetention(s string) ([]PrefixRetention, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var ps []PrefixRetention
+	for _, pair := range strings.Split(s, ",") {
+		i := strings.LastIndex(pair, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid prefix retention %q (expected prefix=retention)", pair)
+		}
+		p := PrefixRetention{Prefix: pair[:i]}
+		v := pair[i+1:]
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			p.Revisions = n
+		} else if p.Window, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("invalid retention %q of prefix %q (expected revisions or duration)", v, p.Prefix)
+		}
+		if p.Revisions <= 0 && p.Window <= 0 {
+			return nil, fmt.Errorf("retention %q of prefix %q should be positive", v, p.Prefix)
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+// Retainer computes the revisions compactions keep the history of each
+// prefix of a retention policy from. It samples the current revision to
+// find the revisions made within the windows of the policy.
+type Retainer struct {
+	lg *zap.Logger
+
+	clock  clockwork.Clock
+	policy []PrefixRetention
+	// window is the longest window of the policy.
+	window time.Duration
+
+	rg RevGetter
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu sync.Mutex
+	// samples holds the revisions sampled over the last window, oldest
+	// first.
+	samples []revSample
+}
+
+type revSample struct {
+	t   time.Time
+	rev int64
+}
+
+// NewRetainer returns a Retainer for the given retention policy.
+func NewRetainer(lg *zap.Logger, policy []PrefixRetention, rg RevGetter) *Retainer {
+	return newRetainer(lg, clockwork.NewRealClock(), policy, rg)
+}
+
+func newRetainer(lg *zap.Logger, clock clockwork.Clock, policy []PrefixRetention, rg RevGetter) *Retainer {
+	r := &Retainer{
+		lg:     lg,
+		clock:  clock,
+		policy: policy,
+		rg:     rg,
+	}
+	for _, p := range policy {
+		if p.Window > r.window {
+			r.window = p.Window
+		}
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	return r
+}
+
+const retainSampleInterval = time.Minute
+
+// Run starts sampling the revision in the background, if the policy has
+// any window. Use Stop() to halt it.
+func (r *Retainer) Run() {
+	if r.window == 0 {
+		return
+	}
+	go func() {
+		for {
+			r.sample()
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-r.clock.After(retainSampleInterval):
+			}
+		}
+	}()
+}
+
+// Stop stops sampling the revision.
+func (r *Retainer) Stop() {
+	r.cancel()
+}
+
+func (r *Retainer) sample() {
+	now, rev := r.clock.Now(), r.rg.Rev()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples = append(r.samples, revSample{now, rev})
+	// drop the samples older than the one the longest window needs
+	i := 0
+	for i+1 < len(r.samples) && !r.samples[i+1].t.After(now.Add(-r.window)) {
+		i++
+	}
+	r.samples = r.samples[i:]
+}
+
+// Retain returns the retention of a compaction made now: each prefix is
+// compacted up to its number of revisions before the current revision, or
+// up to the revision that was current at the start of its window. A window
+// not sampled for long enough retains all the history the previous
+// compaction kept.
+func (r *Retainer) Retain() []*pb.CompactionRetention {
+	now, rev := r.clock.Now(), r.rg.Rev()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var rs []*pb.CompactionRetention
+	for _, p := range r.policy {
+		at := int64(0)
+		if p.Window != 0 {
+			start := now.Add(-p.Window)
+			for _, s := range r.samples {
+				if s.t.After(start) {
+					break
+				}
+				at = s.rev
+			}
+		} else if rev > p.Revisions {
+			at = rev - p.Revisions
+		}
+		rs = append(rs, &pb.CompactionRetention{Prefix: []byte(p.Prefix), Revision: at})
+	}
+	return rs
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3compactor
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+func TestParseRetention(t *testing.T) {
+	tests := []struct {
+		s string
+
+		w    []PrefixRetention
+		werr bool
+	}{
+		{"", nil, false},
+		{"/audit/=1000", []PrefixRetention{{Prefix: "/audit/", Revisions: 1000}}, false},
+		{
+			"/audit/=72h,/a=b/=10",
+			[]PrefixRetention{{Prefix: "/audit/", Window: 72 * time.Hour}, {Prefix: "/a=b/", Revisions: 10}},
+			false,
+		},
+		{"/audit/", nil, true},
+		{"=10", nil, true},
+		{"/audit/=ten", nil, true},
+		{"/audit/=0", nil, true},
+		{"/audit/=-1h", nil, true},
+	}
+	for i, tt := range tests {
+		ps, err := ParseRetention(tt.s)
+		if (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.werr)
+		}
+		if !reflect.DeepEqual(ps, tt.w) {
+			t.Errorf("#%d: retention = %+v, want %+v", i, ps, tt.w)
+		}
+	}
+}
+
+func TestRetainer(t *testing.T) {
+	fc := clockwork.NewFakeClock()
+	rg := &fixedRevGetter{100}
+	policy := []PrefixRetention{{Prefix: "/rev/", Revisions: 50}, {Prefix: "/window/", Window: 3 * time.Minute}}
+	r := newRetainer(zap.NewExample(), fc, policy, rg)
+
+	r.Run()
+	defer r.Stop()
+	fc.BlockUntil(1) // sampled 100 at 0m
+
+	// the window is not sampled for long enough
+	rg.SetRev(200)
+	want := []*pb.CompactionRetention{{Prefix: []byte("/rev/"), Revision: 150}, {Prefix: []byte("/window/"), Revision: 0}}
+	if g := r.Retain(); !reflect.DeepEqual(g, want) {
+		t.Errorf("retain = %v, want %v", g, want)
+	}
+
+	for i := 1; i <= 4; i++ {
+		rg.SetRev(int64(i*100 + 100))
+		fc.Advance(retainSampleInterval)
+		fc.BlockUntil(1) // sampled i*100+100 at i minutes
+	}
+	// the window starts at 1m
+	rg.SetRev(600)
+	want = []*pb.CompactionRetention{{Prefix: []byte("/rev/"), Revision: 550}, {Prefix: []byte("/window/"), Revision: 200}}
+	if g := r.Retain(); !reflect.DeepEqual(g, want) {
+		t.Errorf("retain = %v, want %v", g, want)
+	}
+	r.mu.Lock()
+	n := len(r.samples)
+	r.mu.Unlock()
+	if n != 4 {
+		t.Errorf("kept %d samples, want 4", n)
+	}
+}
+
+type fixedRevGetter struct {
+	rev int64
+}
+
+func (fr *fixedRevGetter) Rev() int64 { return atomic.LoadInt64(&fr.rev) }
+
+func (fr *fixedRevGetter) SetRev(rev int64) { atomic.StoreInt64(&fr.rev, rev) }
@@ -16,6 +16,7 @@ package v3rpc
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -48,6 +49,10 @@ func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
 			return nil, rpctypes.ErrGRPCNotCapable
 		}
 
+		if s.IsWitness() && !isRPCSupportedForWitness(info.FullMethod) {
+			return nil, rpctypes.ErrGRPCNotSupportedForWitness
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if ok {
 			if ks := md[rpctypes.MetadataRequireLeaderKey]; len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader {
@@ -190,6 +195,10 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 			return rpctypes.ErrGRPCNotCapable
 		}
 
+		if s.IsWitness() && !isRPCSupportedForWitness(info.FullMethod) {
+			return rpctypes.ErrGRPCNotSupportedForWitness
+		}
+
 		md, ok := metadata.FromIncomingContext(ss.Context())
 		if ok {
 			if ks := md[rpctypes.MetadataRequireLeaderKey]; len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader {
@@ -218,6 +227,15 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 	}
 }
 
+// isRPCSupportedForWitness returns if the given method can be served by a
+// witness, which does not keep the keyspace: only the cluster membership and
+// the member status are.
+func isRPCSupportedForWitness(method string) bool {
+	return strings.HasPrefix(method, "/etcdserverpb.Cluster/") ||
+		method == "/etcdserverpb.Maintenance/Status" ||
+		method == "/etcdserverpb.Maintenance/MoveLeader"
+}
+
 type serverStreamWithCtx struct {
 	grpc.ServerStream
 	ctx    context.Context
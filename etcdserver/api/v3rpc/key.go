@@ -121,6 +121,9 @@ func checkRangeRequest(r *pb.RangeRequest) error {
 	if len(r.Key) == 0 {
 		return rpctypes.ErrGRPCEmptyKey
 	}
+	if r.MaxStaleness < 0 {
+		return rpctypes.ErrGRPCBadStaleness
+	}
 	return nil
 }
 
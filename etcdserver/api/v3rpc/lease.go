@@ -30,10 +30,13 @@ type LeaseServer struct {
 	lg  *zap.Logger
 	hdr header
 	le  etcdserver.Lessor
+	ag  AuthGetter
+	// limits bounds the keepalive streams of each connection and user.
+	limits *etcdserver.ClientLimits
 }
 
 func NewLeaseServer(s *etcdserver.EtcdServer) pb.LeaseServer {
-	return &LeaseServer{lg: s.Cfg.Logger, le: s, hdr: newHeader(s)}
+	return &LeaseServer{lg: s.Cfg.Logger, le: s, ag: s, limits: s.ClientLimits(), hdr: newHeader(s)}
 }
 
 func (ls *LeaseServer) LeaseGrant(ctx context.Context, cr *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
@@ -87,6 +90,14 @@ func (ls *LeaseServer) LeaseLeases(ctx context.Context, rr *pb.LeaseLeasesReques
 }
 
 func (ls *LeaseServer) LeaseKeepAlive(stream pb.Lease_LeaseKeepAliveServer) (err error) {
+	if ls.limits != nil {
+		conn, user := clientOf(stream.Context(), ls.ag)
+		if err = ls.limits.Acquire(conn, user, etcdserver.ClientLeaseKeepAlives, 1); err != nil {
+			return togRPCError(err)
+		}
+		defer ls.limits.Release(conn, user, etcdserver.ClientLeaseKeepAlives, 1)
+	}
+
 	errc := make(chan error, 1)
 	go func() {
 		errc <- ls.leaseKeepAlive(stream)
@@ -93,6 +93,47 @@ func (ms *maintenanceServer) Defragment(ctx context.Context, sr *pb.DefragmentRe
 	return &pb.DefragmentResponse{}, nil
 }
 
+func (ms *maintenanceServer) DefragmentOnline(dr *pb.DefragmentRequest, srv pb.Maintenance_DefragmentOnlineServer) error {
+	if ms.lg != nil {
+		ms.lg.Info("starting online defragment")
+	} else {
+		plog.Noticef("starting to defragment the storage backend online...")
+	}
+
+	// keep defragmenting if the client goes away; only stop reporting to it
+	var sendErr error
+	send := func(resp *pb.DefragmentProgressResponse) {
+		if sendErr != nil {
+			return
+		}
+		resp.Header = &pb.ResponseHeader{}
+		ms.hdr.fill(resp.Header)
+		sendErr = srv.Send(resp)
+	}
+	err := ms.bg.Backend().DefragOnline(func(p backend.DefragProgress) {
+		send(&pb.DefragmentProgressResponse{CopiedKeys: p.CopiedKeys, ReplayedWrites: p.ReplayedWrites})
+	})
+	if err != nil {
+		if ms.lg != nil {
+			ms.lg.Warn("failed to defragment online", zap.Error(err))
+		} else {
+			plog.Errorf("failed to defragment the storage backend online (%v)", err)
+		}
+		return togRPCError(err)
+	}
+	if ms.lg != nil {
+		ms.lg.Info("finished online defragment")
+	} else {
+		plog.Noticef("finished defragmenting the storage backend online")
+	}
+
+	send(&pb.DefragmentProgressResponse{Done: true, DbSize: ms.bg.Backend().Size()})
+	if sendErr != nil {
+		return togRPCError(sendErr)
+	}
+	return nil
+}
+
 func (ms *maintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance_SnapshotServer) error {
 	snap := ms.bg.Backend().Snapshot()
 	pr, pw := io.Pipe()
@@ -180,6 +221,7 @@ func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (
 		DbSize:           ms.bg.Backend().Size(),
 		DbSizeInUse:      ms.bg.Backend().SizeInUse(),
 	}
+	resp.CompactingRevision, resp.CompactedUpToRevision = ms.kg.KV().CompactionStatus()
 	if resp.Leader == raft.None {
 		resp.Errors = append(resp.Errors, etcdserver.ErrNoLeader.Error())
 	}
@@ -222,6 +264,14 @@ func (ams *authMaintenanceServer) Defragment(ctx context.Context, sr *pb.Defragm
 	return ams.maintenanceServer.Defragment(ctx, sr)
 }
 
+func (ams *authMaintenanceServer) DefragmentOnline(dr *pb.DefragmentRequest, srv pb.Maintenance_DefragmentOnlineServer) error {
+	if err := ams.isAuthenticated(srv.Context()); err != nil {
+		return err
+	}
+
+	return ams.maintenanceServer.DefragmentOnline(dr, srv)
+}
+
 func (ams *authMaintenanceServer) Snapshot(sr *pb.SnapshotRequest, srv pb.Maintenance_SnapshotServer) error {
 	if err := ams.isAuthenticated(srv.Context()); err != nil {
 		return err
@@ -25,12 +25,20 @@ var (
 	ErrGRPCKeyNotFound   = status.New(codes.InvalidArgument, "etcdserver: key not found").Err()
 	ErrGRPCValueProvided = status.New(codes.InvalidArgument, "etcdserver: value is provided").Err()
 	ErrGRPCLeaseProvided = status.New(codes.InvalidArgument, "etcdserver: lease is provided").Err()
+	ErrGRPCBadStaleness  = status.New(codes.InvalidArgument, "etcdserver: max staleness is negative").Err()
 	ErrGRPCTooManyOps    = status.New(codes.InvalidArgument, "etcdserver: too many operations in txn request").Err()
 	ErrGRPCDuplicateKey  = status.New(codes.InvalidArgument, "etcdserver: duplicate key given in txn request").Err()
 	ErrGRPCCompacted     = status.New(codes.OutOfRange, "etcdserver: mvcc: required revision has been compacted").Err()
 	ErrGRPCFutureRev     = status.New(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision").Err()
 	ErrGRPCNoSpace       = status.New(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded").Err()
 
+	ErrGRPCInvalidPageToken   = status.New(codes.InvalidArgument, "etcdserver: invalid page token").Err()
+	ErrGRPCPageTokenCompacted = status.New(codes.OutOfRange, "etcdserver: page token revision has been compacted; restart the paginated range").Err()
+
+	ErrGRPCInvalidWatchFilter = status.New(codes.InvalidArgument, "etcdserver: invalid watch filter").Err()
+	ErrGRPCInvalidResumeToken = status.New(codes.InvalidArgument, "etcdserver: invalid watch resume token").Err()
+	ErrGRPCWatchNotSupported  = status.New(codes.Unimplemented, "etcdserver: watch option not supported by the grpc proxy").Err()
+
 	ErrGRPCLeaseNotFound    = status.New(codes.NotFound, "etcdserver: requested lease not found").Err()
 	ErrGRPCLeaseExist       = status.New(codes.FailedPrecondition, "etcdserver: lease already exists").Err()
 	ErrGRPCLeaseTTLTooLarge = status.New(codes.OutOfRange, "etcdserver: too large lease TTL").Err()
@@ -40,10 +48,24 @@ var (
 	ErrGRPCMemberNotEnoughStarted = status.New(codes.FailedPrecondition, "etcdserver: re-configuration failed due to not enough started members").Err()
 	ErrGRPCMemberBadURLs          = status.New(codes.InvalidArgument, "etcdserver: given member URLs are invalid").Err()
 	ErrGRPCMemberNotFound         = status.New(codes.NotFound, "etcdserver: member not found").Err()
+	ErrGRPCMemberNotLearner       = status.New(codes.FailedPrecondition, "etcdserver: can only promote a learner member").Err()
+	ErrGRPCLearnerNotReady        = status.New(codes.FailedPrecondition, "etcdserver: can only promote a learner member which is in sync with leader").Err()
+	ErrGRPCTooManyLearners        = status.New(codes.FailedPrecondition, "etcdserver: too many learner members in cluster").Err()
+	ErrGRPCTooManyWitnesses       = status.New(codes.FailedPrecondition, "etcdserver: too many witness members in cluster").Err()
+	ErrGRPCLearnerWitness         = status.New(codes.InvalidArgument, "etcdserver: member cannot be both learner and witness").Err()
+	ErrGRPCWitnessTransferee      = status.New(codes.FailedPrecondition, "etcdserver: cannot transfer leadership to a witness member").Err()
+	ErrGRPCNotSupportedForWitness = status.New(codes.Unavailable, "etcdserver: rpc not supported for witness member").Err()
 
 	ErrGRPCRequestTooLarge        = status.New(codes.InvalidArgument, "etcdserver: request is too large").Err()
 	ErrGRPCRequestTooManyRequests = status.New(codes.ResourceExhausted, "etcdserver: too many requests").Err()
 
+	ErrGRPCTooManyConnWatchers        = status.New(codes.ResourceExhausted, "etcdserver: too many watchers on the connection").Err()
+	ErrGRPCTooManyUserWatchers        = status.New(codes.ResourceExhausted, "etcdserver: too many watchers for the user").Err()
+	ErrGRPCTooManyConnLeaseKeepAlives = status.New(codes.ResourceExhausted, "etcdserver: too many lease keepalive streams on the connection").Err()
+	ErrGRPCTooManyUserLeaseKeepAlives = status.New(codes.ResourceExhausted, "etcdserver: too many lease keepalive streams for the user").Err()
+	ErrGRPCConnWatchBufferFull        = status.New(codes.ResourceExhausted, "etcdserver: too many buffered watch bytes on the connection").Err()
+	ErrGRPCUserWatchBufferFull        = status.New(codes.ResourceExhausted, "etcdserver: too many buffered watch bytes for the user").Err()
+
 	ErrGRPCRootUserNotExist     = status.New(codes.FailedPrecondition, "etcdserver: root user does not exist").Err()
 	ErrGRPCRootRoleNotExist     = status.New(codes.FailedPrecondition, "etcdserver: root user does not have root role").Err()
 	ErrGRPCUserAlreadyExist     = status.New(codes.FailedPrecondition, "etcdserver: user name already exists").Err()
@@ -75,6 +97,7 @@ var (
 		ErrorDesc(ErrGRPCKeyNotFound):   ErrGRPCKeyNotFound,
 		ErrorDesc(ErrGRPCValueProvided): ErrGRPCValueProvided,
 		ErrorDesc(ErrGRPCLeaseProvided): ErrGRPCLeaseProvided,
+		ErrorDesc(ErrGRPCBadStaleness):  ErrGRPCBadStaleness,
 
 		ErrorDesc(ErrGRPCTooManyOps):   ErrGRPCTooManyOps,
 		ErrorDesc(ErrGRPCDuplicateKey): ErrGRPCDuplicateKey,
@@ -82,6 +105,13 @@ var (
 		ErrorDesc(ErrGRPCFutureRev):    ErrGRPCFutureRev,
 		ErrorDesc(ErrGRPCNoSpace):      ErrGRPCNoSpace,
 
+		ErrorDesc(ErrGRPCInvalidPageToken):   ErrGRPCInvalidPageToken,
+		ErrorDesc(ErrGRPCPageTokenCompacted): ErrGRPCPageTokenCompacted,
+
+		ErrorDesc(ErrGRPCInvalidWatchFilter): ErrGRPCInvalidWatchFilter,
+		ErrorDesc(ErrGRPCInvalidResumeToken): ErrGRPCInvalidResumeToken,
+		ErrorDesc(ErrGRPCWatchNotSupported):  ErrGRPCWatchNotSupported,
+
 		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
 		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
 		ErrorDesc(ErrGRPCLeaseTTLTooLarge): ErrGRPCLeaseTTLTooLarge,
@@ -91,10 +121,24 @@ var (
 		ErrorDesc(ErrGRPCMemberNotEnoughStarted): ErrGRPCMemberNotEnoughStarted,
 		ErrorDesc(ErrGRPCMemberBadURLs):          ErrGRPCMemberBadURLs,
 		ErrorDesc(ErrGRPCMemberNotFound):         ErrGRPCMemberNotFound,
+		ErrorDesc(ErrGRPCMemberNotLearner):       ErrGRPCMemberNotLearner,
+		ErrorDesc(ErrGRPCLearnerNotReady):        ErrGRPCLearnerNotReady,
+		ErrorDesc(ErrGRPCTooManyLearners):        ErrGRPCTooManyLearners,
+		ErrorDesc(ErrGRPCTooManyWitnesses):       ErrGRPCTooManyWitnesses,
+		ErrorDesc(ErrGRPCLearnerWitness):         ErrGRPCLearnerWitness,
+		ErrorDesc(ErrGRPCWitnessTransferee):      ErrGRPCWitnessTransferee,
+		ErrorDesc(ErrGRPCNotSupportedForWitness): ErrGRPCNotSupportedForWitness,
 
 		ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
 
+		ErrorDesc(ErrGRPCTooManyConnWatchers):        ErrGRPCTooManyConnWatchers,
+		ErrorDesc(ErrGRPCTooManyUserWatchers):        ErrGRPCTooManyUserWatchers,
+		ErrorDesc(ErrGRPCTooManyConnLeaseKeepAlives): ErrGRPCTooManyConnLeaseKeepAlives,
+		ErrorDesc(ErrGRPCTooManyUserLeaseKeepAlives): ErrGRPCTooManyUserLeaseKeepAlives,
+		ErrorDesc(ErrGRPCConnWatchBufferFull):        ErrGRPCConnWatchBufferFull,
+		ErrorDesc(ErrGRPCUserWatchBufferFull):        ErrGRPCUserWatchBufferFull,
+
 		ErrorDesc(ErrGRPCRootUserNotExist):     ErrGRPCRootUserNotExist,
 		ErrorDesc(ErrGRPCRootRoleNotExist):     ErrGRPCRootRoleNotExist,
 		ErrorDesc(ErrGRPCUserAlreadyExist):     ErrGRPCUserAlreadyExist,
@@ -129,12 +173,20 @@ var (
 	ErrKeyNotFound   = Error(ErrGRPCKeyNotFound)
 	ErrValueProvided = Error(ErrGRPCValueProvided)
 	ErrLeaseProvided = Error(ErrGRPCLeaseProvided)
+	ErrBadStaleness  = Error(ErrGRPCBadStaleness)
 	ErrTooManyOps    = Error(ErrGRPCTooManyOps)
 	ErrDuplicateKey  = Error(ErrGRPCDuplicateKey)
 	ErrCompacted     = Error(ErrGRPCCompacted)
 	ErrFutureRev     = Error(ErrGRPCFutureRev)
 	ErrNoSpace       = Error(ErrGRPCNoSpace)
 
+	ErrInvalidPageToken   = Error(ErrGRPCInvalidPageToken)
+	ErrPageTokenCompacted = Error(ErrGRPCPageTokenCompacted)
+
+	ErrInvalidWatchFilter = Error(ErrGRPCInvalidWatchFilter)
+	ErrInvalidResumeToken = Error(ErrGRPCInvalidResumeToken)
+	ErrWatchNotSupported  = Error(ErrGRPCWatchNotSupported)
+
 	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
 	ErrLeaseTTLTooLarge = Error(ErrGRPCLeaseTTLTooLarge)
@@ -144,10 +196,24 @@ var (
 	ErrMemberNotEnoughStarted = Error(ErrGRPCMemberNotEnoughStarted)
 	ErrMemberBadURLs          = Error(ErrGRPCMemberBadURLs)
 	ErrMemberNotFound         = Error(ErrGRPCMemberNotFound)
+	ErrMemberNotLearner       = Error(ErrGRPCMemberNotLearner)
+	ErrLearnerNotReady        = Error(ErrGRPCLearnerNotReady)
+	ErrTooManyLearners        = Error(ErrGRPCTooManyLearners)
+	ErrTooManyWitnesses       = Error(ErrGRPCTooManyWitnesses)
+	ErrLearnerWitness         = Error(ErrGRPCLearnerWitness)
+	ErrWitnessTransferee      = Error(ErrGRPCWitnessTransferee)
+	ErrNotSupportedForWitness = Error(ErrGRPCNotSupportedForWitness)
 
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
 
+	ErrTooManyConnWatchers        = Error(ErrGRPCTooManyConnWatchers)
+	ErrTooManyUserWatchers        = Error(ErrGRPCTooManyUserWatchers)
+	ErrTooManyConnLeaseKeepAlives = Error(ErrGRPCTooManyConnLeaseKeepAlives)
+	ErrTooManyUserLeaseKeepAlives = Error(ErrGRPCTooManyUserLeaseKeepAlives)
+	ErrConnWatchBufferFull        = Error(ErrGRPCConnWatchBufferFull)
+	ErrUserWatchBufferFull        = Error(ErrGRPCUserWatchBufferFull)
+
 	ErrRootUserNotExist     = Error(ErrGRPCRootUserNotExist)
 	ErrRootRoleNotExist     = Error(ErrGRPCRootRoleNotExist)
 	ErrUserAlreadyExist     = Error(ErrGRPCUserAlreadyExist)
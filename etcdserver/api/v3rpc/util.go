@@ -26,6 +26,7 @@ import (
 	"go.etcd.io/etcd/mvcc"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -34,7 +35,13 @@ var toGRPCErrorMap = map[error]error{
 	membership.ErrIDNotFound:              rpctypes.ErrGRPCMemberNotFound,
 	membership.ErrIDExists:                rpctypes.ErrGRPCMemberExist,
 	membership.ErrPeerURLexists:           rpctypes.ErrGRPCPeerURLExist,
+	membership.ErrMemberNotLearner:        rpctypes.ErrGRPCMemberNotLearner,
+	membership.ErrTooManyLearners:         rpctypes.ErrGRPCTooManyLearners,
+	membership.ErrTooManyWitnesses:        rpctypes.ErrGRPCTooManyWitnesses,
 	etcdserver.ErrNotEnoughStartedMembers: rpctypes.ErrMemberNotEnoughStarted,
+	etcdserver.ErrLearnerNotReady:         rpctypes.ErrGRPCLearnerNotReady,
+	etcdserver.ErrWitnessTransferee:       rpctypes.ErrGRPCWitnessTransferee,
+	etcdserver.ErrNotSupportedForWitness:  rpctypes.ErrGRPCNotSupportedForWitness,
 
 	mvcc.ErrCompacted:             rpctypes.ErrGRPCCompacted,
 	mvcc.ErrFutureRev:             rpctypes.ErrGRPCFutureRev,
@@ -42,6 +49,16 @@ var toGRPCErrorMap = map[error]error{
 	etcdserver.ErrNoSpace:         rpctypes.ErrGRPCNoSpace,
 	etcdserver.ErrTooManyRequests: rpctypes.ErrTooManyRequests,
 
+	etcdserver.ErrInvalidPageToken:   rpctypes.ErrGRPCInvalidPageToken,
+	etcdserver.ErrPageTokenCompacted: rpctypes.ErrGRPCPageTokenCompacted,
+
+	etcdserver.ErrTooManyConnWatchers:        rpctypes.ErrGRPCTooManyConnWatchers,
+	etcdserver.ErrTooManyUserWatchers:        rpctypes.ErrGRPCTooManyUserWatchers,
+	etcdserver.ErrTooManyConnLeaseKeepAlives: rpctypes.ErrGRPCTooManyConnLeaseKeepAlives,
+	etcdserver.ErrTooManyUserLeaseKeepAlives: rpctypes.ErrGRPCTooManyUserLeaseKeepAlives,
+	etcdserver.ErrConnWatchBufferFull:        rpctypes.ErrGRPCConnWatchBufferFull,
+	etcdserver.ErrUserWatchBufferFull:        rpctypes.ErrGRPCUserWatchBufferFull,
+
 	etcdserver.ErrNoLeader:                   rpctypes.ErrGRPCNoLeader,
 	etcdserver.ErrNotLeader:                  rpctypes.ErrGRPCNotLeader,
 	etcdserver.ErrLeaderChanged:              rpctypes.ErrGRPCLeaderChanged,
@@ -73,6 +90,18 @@ var toGRPCErrorMap = map[error]error{
 	auth.ErrInvalidAuthMgmt:      rpctypes.ErrGRPCInvalidAuthMgmt,
 }
 
+// clientOf returns the connection and the authenticated user of the client
+// of a stream, which the client limits apply to.
+func clientOf(ctx context.Context, ag AuthGetter) (conn, user string) {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		conn = p.Addr.String()
+	}
+	if ai, err := ag.AuthInfoFromCtx(ctx); err == nil && ai != nil {
+		user = ai.Username
+	}
+	return conn, user
+}
+
 func togRPCError(err error) error {
 	// let gRPC server convert to codes.Canceled, codes.DeadlineExceeded
 	if err == context.Canceled || err == context.DeadlineExceeded {
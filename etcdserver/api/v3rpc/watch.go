@@ -15,9 +15,12 @@
 package v3rpc
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"math/rand"
+	"path"
+	"regexp"
 	"sync"
 	"time"
 
@@ -42,6 +45,7 @@ type watchServer struct {
 	sg        etcdserver.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+	limits    *etcdserver.ClientLimits
 }
 
 // NewWatchServer returns a new watch server.
@@ -57,6 +61,7 @@ func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
 		sg:        s,
 		watchable: s.Watchable(),
 		ag:        s,
+		limits:    s.ClientLimits(),
 	}
 }
 
@@ -112,11 +117,17 @@ type serverWatchStream struct {
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
 
+	// limits bounds the watchers of the connection and the user of the
+	// stream; buf holds the bytes of its buffered responses in the limits.
+	limits     *etcdserver.ClientLimits
+	conn, user string
+	buf        *watchBuffer
+
 	gRPCStream  pb.Watch_WatchServer
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// mu protects progress, prevKV, fragment, positions
 	mu sync.RWMutex
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
@@ -125,6 +136,9 @@ type serverWatchStream struct {
 	prevKV map[mvcc.WatchID]bool
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// tracks the position of each watch ID to issue resume tokens; each
+	// watch in it holds a watcher of the client limits
+	positions map[mvcc.WatchID]watchPosition
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -146,8 +160,9 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		watchable: ws.watchable,
 		ag:        ws.ag,
 
-		gRPCStream:  stream,
-		watchStream: ws.watchable.NewWatchStream(),
+		limits: ws.limits,
+
+		gRPCStream: stream,
 		// chan for sending control response like watcher created and canceled.
 		ctrlStream: make(chan *pb.WatchResponse, ctrlStreamBufLen),
 
@@ -155,9 +170,19 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		prevKV:   make(map[mvcc.WatchID]bool),
 		fragment: make(map[mvcc.WatchID]bool),
 
+		positions: make(map[mvcc.WatchID]watchPosition),
+
 		closec: make(chan struct{}),
 	}
 
+	if sws.limits != nil {
+		sws.conn, sws.user = clientOf(stream.Context(), sws.ag)
+		sws.buf = &watchBuffer{limits: sws.limits, conn: sws.conn, user: sws.user, errc: make(chan error, 1)}
+		sws.watchStream = ws.watchable.NewLimitedWatchStream(sws.buf)
+	} else {
+		sws.watchStream = ws.watchable.NewWatchStream()
+	}
+
 	sws.wg.Add(1)
 	go func() {
 		sws.sendLoop()
@@ -189,10 +214,22 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		}
 	}()
 
+	// receives the error of the first response over the limits
+	var limitc <-chan error
+	if sws.buf != nil {
+		limitc = sws.buf.errc
+	}
+
 	select {
 	case err = <-errc:
 		close(sws.ctrlStream)
 
+	case err = <-limitc:
+		// the send loop may be blocked on the client falling behind, which
+		// only ending the stream unblocks, so release the stream after it
+		go sws.release()
+		return err
+
 	case <-stream.Context().Done():
 		err = stream.Context().Err()
 		// the only server-side cancellation is noleader for now.
@@ -201,10 +238,100 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		}
 	}
 
-	sws.close()
+	sws.release()
 	return err
 }
 
+// release closes the stream and returns the watchers and the buffered bytes
+// it holds in the client limits.
+func (sws *serverWatchStream) release() {
+	sws.close()
+	sws.buf.close()
+
+	sws.mu.Lock()
+	sws.limits.Release(sws.conn, sws.user, etcdserver.ClientWatchers, int64(len(sws.positions)))
+	sws.mu.Unlock()
+}
+
+// watchBuffer holds the bytes of the responses a watch stream buffers in the
+// client limits, from when the store produces them or a control response is
+// queued, until they are sent. A nil watchBuffer holds nothing.
+type watchBuffer struct {
+	limits     *etcdserver.ClientLimits
+	conn, user string
+
+	mu     sync.Mutex
+	held   int64
+	closed bool
+	// errc receives the error of the first response over the limits,
+	// which ends the stream
+	errc chan error
+}
+
+func (b *watchBuffer) Acquire(n int64) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return false
+	}
+	if err := b.limits.Acquire(b.conn, b.user, etcdserver.ClientWatchBytes, n); err != nil {
+		select {
+		case b.errc <- togRPCError(err):
+		default:
+		}
+		return false
+	}
+	b.held += n
+	return true
+}
+
+func (b *watchBuffer) Release(n int64) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.limits.Release(b.conn, b.user, etcdserver.ClientWatchBytes, n)
+		b.held -= n
+	}
+}
+
+// close releases the bytes of the responses the stream never sent, and
+// refuses any more.
+func (b *watchBuffer) close() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.limits.Release(b.conn, b.user, etcdserver.ClientWatchBytes, b.held)
+	b.held = 0
+}
+
+// sendCtrl queues a control response, holding its bytes until it is sent.
+// It returns false if the stream is closed or over its limits.
+func (sws *serverWatchStream) sendCtrl(wr *pb.WatchResponse) bool {
+	var n int64
+	if sws.buf != nil {
+		n = int64(wr.Size())
+	}
+	if !sws.buf.Acquire(n) {
+		return false
+	}
+	select {
+	case sws.ctrlStream <- wr:
+		return true
+	case <-sws.closec:
+		sws.buf.Release(n)
+		return false
+	}
+}
+
 func (sws *serverWatchStream) isWatchPermitted(wcr *pb.WatchCreateRequest) bool {
 	authInfo, err := sws.ag.AuthInfoFromCtx(sws.gRPCStream.Context())
 	if err != nil {
@@ -257,23 +384,69 @@ func (sws *serverWatchStream) recvLoop() error {
 					CancelReason: rpctypes.ErrGRPCPermissionDenied.Error(),
 				}
 
-				select {
-				case sws.ctrlStream <- wr:
-				case <-sws.closec:
-				}
+				sws.sendCtrl(wr)
 				return nil
 			}
 
-			filters := FiltersFromRequest(creq)
+			filters, err := FiltersFromRequest(creq)
+			var pos watchPosition
+			if err == nil && len(creq.ResumeToken) != 0 {
+				pos, err = parseResumeToken(creq.ResumeToken)
+			}
+			if err == nil {
+				if lerr := sws.limits.Acquire(sws.conn, sws.user, etcdserver.ClientWatchers, 1); lerr != nil {
+					err = togRPCError(lerr)
+				}
+			}
+			if err != nil {
+				wr := &pb.WatchResponse{
+					Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+					WatchId:      creq.WatchId,
+					Canceled:     true,
+					Created:      true,
+					CancelReason: rpctypes.ErrorDesc(err),
+				}
+
+				// keep serving the other watches of the stream
+				if !sws.sendCtrl(wr) {
+					return nil
+				}
+				continue
+			}
 
 			wsrev := sws.watchStream.Rev()
 			rev := creq.StartRevision
 			if rev == 0 {
 				rev = wsrev + 1
 			}
-			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
-			if err == nil {
+			initial := creq.InitialState && pos.rev == 0
+			if pos.rev != 0 {
+				rev = pos.rev
+			} else {
+				pos.rev = rev
+			}
+			var id mvcc.WatchID
+			if initial {
+				var irev int64
+				id, irev, err = sws.watchStream.WatchWithInitialState(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, creq.StartRevision, filters...)
+				if err == nil {
+					// the events after the initial state follow it
+					wsrev, pos.rev = irev, irev+1
+				}
+			} else {
+				id, err = sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
+			}
+			if err != nil {
+				sws.limits.Release(sws.conn, sws.user, etcdserver.ClientWatchers, 1)
+			} else {
 				sws.mu.Lock()
+				select {
+				case <-sws.closec:
+					// the watchers of the closed stream are released
+					sws.limits.Release(sws.conn, sws.user, etcdserver.ClientWatchers, 1)
+				default:
+					sws.positions[id] = pos
+				}
 				if creq.ProgressNotify {
 					sws.progress[id] = true
 				}
@@ -293,10 +466,11 @@ func (sws *serverWatchStream) recvLoop() error {
 			}
 			if err != nil {
 				wr.CancelReason = err.Error()
+			} else if !initial {
+				// a watch cannot resume in the middle of its initial state
+				wr.ResumeToken = pos.token()
 			}
-			select {
-			case sws.ctrlStream <- wr:
-			case <-sws.closec:
+			if !sws.sendCtrl(wr) {
 				return nil
 			}
 
@@ -305,24 +479,25 @@ func (sws *serverWatchStream) recvLoop() error {
 				id := uv.CancelRequest.WatchId
 				err := sws.watchStream.Cancel(mvcc.WatchID(id))
 				if err == nil {
-					sws.ctrlStream <- &pb.WatchResponse{
+					sws.sendCtrl(&pb.WatchResponse{
 						Header:   sws.newResponseHeader(sws.watchStream.Rev()),
 						WatchId:  id,
 						Canceled: true,
-					}
+					})
 					sws.mu.Lock()
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
 					delete(sws.fragment, mvcc.WatchID(id))
+					sws.forgetPosition(mvcc.WatchID(id))
 					sws.mu.Unlock()
 				}
 			}
 		case *pb.WatchRequest_ProgressRequest:
 			if uv.ProgressRequest != nil {
-				sws.ctrlStream <- &pb.WatchResponse{
+				sws.sendCtrl(&pb.WatchResponse{
 					Header:  sws.newResponseHeader(sws.watchStream.Rev()),
 					WatchId: -1, // response is not associated with any WatchId and will be broadcast to all watch channels
-				}
+				})
 			}
 		default:
 			// we probably should not shutdown the entire stream when
@@ -338,6 +513,8 @@ func (sws *serverWatchStream) sendLoop() {
 	ids := make(map[mvcc.WatchID]struct{})
 	// watch responses pending on a watch id creation message
 	pending := make(map[mvcc.WatchID][]*pb.WatchResponse)
+	// bytes of the pending responses of each watch id held in the limits
+	pendingBytes := make(map[mvcc.WatchID]int64)
 
 	interval := GetProgressReportInterval()
 	progressTicker := time.NewTicker(interval)
@@ -353,6 +530,7 @@ func (sws *serverWatchStream) sendLoop() {
 				mvcc.ReportEventReceived(len(ws.Events))
 			}
 		}
+		// the bytes of the unsent responses are released on closing buf
 	}()
 
 	for {
@@ -389,27 +567,28 @@ func (sws *serverWatchStream) sendLoop() {
 				CompactRevision: wresp.CompactRevision,
 				Canceled:        canceled,
 			}
+			if canceled {
+				// the store removed the watcher
+				sws.mu.Lock()
+				sws.forgetPosition(wresp.WatchID)
+				sws.mu.Unlock()
+			}
+
+			// the store took the bytes of the response on producing it
+			n := wresp.Bytes
 
 			if _, okID := ids[wresp.WatchID]; !okID {
 				// buffer if id not yet announced
 				wrs := append(pending[wresp.WatchID], wr)
 				pending[wresp.WatchID] = wrs
+				pendingBytes[wresp.WatchID] += n
 				continue
 			}
 
 			mvcc.ReportEventReceived(len(evs))
 
-			sws.mu.RLock()
-			fragmented, ok := sws.fragment[wresp.WatchID]
-			sws.mu.RUnlock()
-
-			var serr error
-			if !fragmented && !ok {
-				serr = sws.gRPCStream.Send(wr)
-			} else {
-				serr = sendFragments(wr, sws.maxRequestBytes, sws.gRPCStream.Send)
-			}
-
+			serr := sws.sendWatchResponse(wr)
+			sws.buf.Release(n)
 			if serr != nil {
 				if isClientCtxErr(sws.gRPCStream.Context().Err(), serr) {
 					if sws.lg != nil {
@@ -457,6 +636,9 @@ func (sws *serverWatchStream) sendLoop() {
 				}
 				return
 			}
+			if sws.buf != nil {
+				sws.buf.Release(int64(c.Size()))
+			}
 
 			// track id creation
 			wid := mvcc.WatchID(c.WatchId)
@@ -469,7 +651,7 @@ func (sws *serverWatchStream) sendLoop() {
 				ids[wid] = struct{}{}
 				for _, v := range pending[wid] {
 					mvcc.ReportEventReceived(len(v.Events))
-					if err := sws.gRPCStream.Send(v); err != nil {
+					if err := sws.sendWatchResponse(v); err != nil {
 						if isClientCtxErr(sws.gRPCStream.Context().Err(), err) {
 							if sws.lg != nil {
 								sws.lg.Debug("failed to send pending watch response to gRPC stream", zap.Error(err))
@@ -488,6 +670,8 @@ func (sws *serverWatchStream) sendLoop() {
 					}
 				}
 				delete(pending, wid)
+				sws.buf.Release(pendingBytes[wid])
+				delete(pendingBytes, wid)
 			}
 
 		case <-progressTicker.C:
@@ -506,6 +690,59 @@ func (sws *serverWatchStream) sendLoop() {
 	}
 }
 
+// forgetPosition stops tracking the position of a watch, releasing its
+// watcher in the client limits. sws.mu must be held.
+func (sws *serverWatchStream) forgetPosition(id mvcc.WatchID) {
+	if _, ok := sws.positions[id]; ok {
+		delete(sws.positions, id)
+		sws.limits.Release(sws.conn, sws.user, etcdserver.ClientWatchers, 1)
+	}
+}
+
+// sendWatchResponse sends a response to a watch, in fragments if the watch
+// asked for them. Each response carries the resume token of the position
+// of the watch after it, except the responses of the initial state.
+func (sws *serverWatchStream) sendWatchResponse(wr *pb.WatchResponse) error {
+	id := mvcc.WatchID(wr.WatchId)
+	sws.mu.RLock()
+	fragmented := sws.fragment[id]
+	pos, tracked := sws.positions[id]
+	sws.mu.RUnlock()
+
+	send := sws.gRPCStream.Send
+	if tracked && wr.CompactRevision == 0 {
+		n := len(wr.Events)
+		if wr.Events = pos.unsent(wr.Events); n != 0 && len(wr.Events) == 0 {
+			// all sent before the watch resumed
+			return nil
+		}
+		send = func(r *pb.WatchResponse) error {
+			if n := len(r.Events); n != 0 && r.Events[n-1].Kv.ModRevision < pos.rev {
+				// part of the initial state, which cannot be resumed from
+				return sws.gRPCStream.Send(r)
+			}
+			pos = pos.after(r.Events, r.Header.Revision)
+			r.ResumeToken = pos.token()
+			return sws.gRPCStream.Send(r)
+		}
+	}
+
+	var err error
+	if fragmented {
+		err = sendFragments(wr, sws.maxRequestBytes, send)
+	} else {
+		err = send(wr)
+	}
+	if err == nil && tracked {
+		sws.mu.Lock()
+		if _, ok := sws.positions[id]; ok {
+			sws.positions[id] = pos
+		}
+		sws.mu.Unlock()
+	}
+	return err
+}
+
 func sendFragments(
 	wr *pb.WatchResponse,
 	maxRequestBytes int,
@@ -568,8 +805,17 @@ func filterNoPut(e mvccpb.Event) bool {
 	return e.Type == mvccpb.PUT
 }
 
+func filterNoCreate(e mvccpb.Event) bool {
+	return e.Type == mvccpb.PUT && e.Kv.Version == 1
+}
+
+func filterNoModify(e mvccpb.Event) bool {
+	return e.Type == mvccpb.PUT && e.Kv.Version > 1
+}
+
 // FiltersFromRequest returns "mvcc.FilterFunc" from a given watch create request.
-func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
+// It returns ErrGRPCInvalidWatchFilter if the key glob or regex is malformed.
+func FiltersFromRequest(creq *pb.WatchCreateRequest) ([]mvcc.FilterFunc, error) {
 	filters := make([]mvcc.FilterFunc, 0, len(creq.Filters))
 	for _, ft := range creq.Filters {
 		switch ft {
@@ -577,8 +823,42 @@ func FiltersFromRequest(creq *pb.WatchCreateRequest) []mvcc.FilterFunc {
 			filters = append(filters, filterNoPut)
 		case pb.WatchCreateRequest_NODELETE:
 			filters = append(filters, filterNoDelete)
+		case pb.WatchCreateRequest_NOCREATE:
+			filters = append(filters, filterNoCreate)
+		case pb.WatchCreateRequest_NOMODIFY:
+			filters = append(filters, filterNoModify)
 		default:
 		}
 	}
-	return filters
+	if glob := creq.KeyGlob; glob != "" {
+		// matching any name reports a malformed pattern
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, rpctypes.ErrGRPCInvalidWatchFilter
+		}
+		filters = append(filters, func(e mvccpb.Event) bool {
+			ok, _ := path.Match(glob, string(e.Kv.Key))
+			return !ok
+		})
+	}
+	if creq.KeyRegex != "" {
+		re, err := regexp.Compile(creq.KeyRegex)
+		if err != nil {
+			return nil, rpctypes.ErrGRPCInvalidWatchFilter
+		}
+		filters = append(filters, func(e mvccpb.Event) bool {
+			return !re.Match(e.Kv.Key)
+		})
+	}
+	// deletions carry neither value nor lease, so only puts are matched
+	if prefix := creq.ValuePrefix; len(prefix) != 0 {
+		filters = append(filters, func(e mvccpb.Event) bool {
+			return e.Type == mvccpb.PUT && !bytes.HasPrefix(e.Kv.Value, prefix)
+		})
+	}
+	if lease := creq.Lease; lease != 0 {
+		filters = append(filters, func(e mvccpb.Event) bool {
+			return e.Type == mvccpb.PUT && e.Kv.Lease != lease
+		})
+	}
+	return filters, nil
 }
@@ -0,0 +1,92 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"encoding/binary"
+
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+)
+
+// resumeTokenVersion is the first byte of the resume tokens, to change
+// their encoding later.
+const resumeTokenVersion byte = 1
+
+// watchPosition is the position of a watch in the event history: the
+// events of the revisions before rev and the first n events of rev were
+// sent. Events of a revision are ordered the same way on every member, and
+// a watch gets all the events of a revision in a single response, so the
+// position resumes the watch on any member.
+type watchPosition struct {
+	rev, n int64
+	// skip is the number of the first events of rev to drop since they
+	// were sent before the watch resumed.
+	skip int64
+}
+
+// parseResumeToken returns the position a resume token was issued at.
+func parseResumeToken(token []byte) (watchPosition, error) {
+	if len(token) == 0 || token[0] != resumeTokenVersion {
+		return watchPosition{}, rpctypes.ErrGRPCInvalidResumeToken
+	}
+	rev, k := binary.Uvarint(token[1:])
+	if k <= 0 || rev == 0 {
+		return watchPosition{}, rpctypes.ErrGRPCInvalidResumeToken
+	}
+	n, l := binary.Uvarint(token[1+k:])
+	if l <= 0 || 1+k+l != len(token) {
+		return watchPosition{}, rpctypes.ErrGRPCInvalidResumeToken
+	}
+	return watchPosition{rev: int64(rev), n: int64(n), skip: int64(n)}, nil
+}
+
+// token returns the resume token of the position.
+func (p watchPosition) token() []byte {
+	b := make([]byte, 1+2*binary.MaxVarintLen64)
+	b[0] = resumeTokenVersion
+	k := 1 + binary.PutUvarint(b[1:], uint64(p.rev))
+	k += binary.PutUvarint(b[k:], uint64(p.n))
+	return b[:k]
+}
+
+// unsent drops the events sent before the watch resumed.
+func (p watchPosition) unsent(evs []*mvccpb.Event) []*mvccpb.Event {
+	i := 0
+	for int64(i) < p.skip && i < len(evs) && evs[i].Kv.ModRevision == p.rev {
+		i++
+	}
+	return evs[i:]
+}
+
+// after returns the position after sending the events, or after a progress
+// notification at the given revision if there are none.
+func (p watchPosition) after(evs []*mvccpb.Event, progressRev int64) watchPosition {
+	if len(evs) == 0 {
+		if progressRev >= p.rev {
+			return watchPosition{rev: progressRev + 1}
+		}
+		return p
+	}
+	for _, ev := range evs {
+		if rev := ev.Kv.ModRevision; rev == p.rev {
+			p.n++
+		} else if rev > p.rev {
+			p.rev, p.n = rev, 1
+		}
+	}
+	p.skip = 0
+	return p
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+)
+
+func TestWatchPosition(t *testing.T) {
+	evs := func(revs ...int64) []*mvccpb.Event {
+		var evs []*mvccpb.Event
+		for _, rev := range revs {
+			evs = append(evs, &mvccpb.Event{Kv: &mvccpb.KeyValue{ModRevision: rev}})
+		}
+		return evs
+	}
+	tests := []struct {
+		p           watchPosition
+		evs         []*mvccpb.Event
+		progressRev int64
+
+		wunsent int
+		wp      watchPosition
+	}{
+		{watchPosition{rev: 5}, evs(5, 5, 6), 0, 3, watchPosition{rev: 6, n: 1}},
+		{watchPosition{rev: 5, n: 1}, evs(6, 6), 0, 2, watchPosition{rev: 6, n: 2}},
+		// resumed after the first two events of 5
+		{watchPosition{rev: 5, n: 2, skip: 2}, evs(5, 5, 5, 6), 0, 2, watchPosition{rev: 6, n: 1}},
+		{watchPosition{rev: 5, n: 2, skip: 2}, evs(5, 5), 0, 0, watchPosition{rev: 5, n: 2, skip: 2}},
+		// progress
+		{watchPosition{rev: 5, n: 2}, nil, 7, 0, watchPosition{rev: 8}},
+		// progress of a member behind the position
+		{watchPosition{rev: 5, n: 2, skip: 2}, nil, 3, 0, watchPosition{rev: 5, n: 2, skip: 2}},
+	}
+	for i, tt := range tests {
+		unsent := tt.p.unsent(tt.evs)
+		if len(unsent) != tt.wunsent {
+			t.Errorf("#%d: unsent %d events, want %d", i, len(unsent), tt.wunsent)
+		}
+		if p := tt.p.after(unsent, tt.progressRev); p != tt.wp {
+			t.Errorf("#%d: position = %+v, want %+v", i, p, tt.wp)
+		}
+	}
+}
+
+func TestResumeToken(t *testing.T) {
+	p := watchPosition{rev: 1 << 40, n: 3}
+	g, err := parseResumeToken(p.token())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := (watchPosition{rev: 1 << 40, n: 3, skip: 3}); !reflect.DeepEqual(g, w) {
+		t.Errorf("parsed position = %+v, want %+v", g, w)
+	}
+
+	for _, token := range [][]byte{
+		nil,
+		{2, 5, 0},
+		{resumeTokenVersion, 0, 0},
+		{resumeTokenVersion, 5},
+		append(p.token(), 0),
+	} {
+		if _, err = parseResumeToken(token); err != rpctypes.ErrGRPCInvalidResumeToken {
+			t.Errorf("parse %v: err = %v, want %v", token, err, rpctypes.ErrGRPCInvalidResumeToken)
+		}
+	}
+}
@@ -17,8 +17,10 @@ package v3rpc
 import (
 	"bytes"
 	"math"
+	"reflect"
 	"testing"
 
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
 	"go.etcd.io/etcd/mvcc/mvccpb"
 )
@@ -82,6 +84,65 @@ func TestSendFragment(t *testing.T) {
 	}
 }
 
+func TestFiltersFromRequest(t *testing.T) {
+	evs := []mvccpb.Event{
+		{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("/a/x"), Value: []byte("v1"), Version: 1, Lease: 5}},
+		{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("/a/y"), Value: []byte("w2"), Version: 2}},
+		{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("/a/b/x"), Value: []byte("v3"), Version: 3, Lease: 5}},
+		{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("/a/x")}},
+	}
+	tests := []struct {
+		creq *pb.WatchCreateRequest
+
+		wkept []int
+	}{
+		{&pb.WatchCreateRequest{}, []int{0, 1, 2, 3}},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOPUT}}, []int{3}},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NODELETE}}, []int{0, 1, 2}},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOCREATE}}, []int{1, 2, 3}},
+		{&pb.WatchCreateRequest{Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NOMODIFY}}, []int{0, 3}},
+		{&pb.WatchCreateRequest{KeyGlob: "/a/?"}, []int{0, 1, 3}},
+		{&pb.WatchCreateRequest{KeyGlob: "/a/*/x"}, []int{2}},
+		{&pb.WatchCreateRequest{KeyRegex: "x$"}, []int{0, 2, 3}},
+		{&pb.WatchCreateRequest{KeyRegex: "^/a/[^/]+$"}, []int{0, 1, 3}},
+		{&pb.WatchCreateRequest{ValuePrefix: []byte("v")}, []int{0, 2, 3}},
+		{&pb.WatchCreateRequest{Lease: 5}, []int{0, 2, 3}},
+		{
+			&pb.WatchCreateRequest{
+				Filters: []pb.WatchCreateRequest_FilterType{pb.WatchCreateRequest_NODELETE},
+				KeyGlob: "/a/?",
+				Lease:   5,
+			},
+			[]int{0},
+		},
+	}
+	for i, tt := range tests {
+		fs, err := FiltersFromRequest(tt.creq)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		var kept []int
+	next:
+		for j, ev := range evs {
+			for _, f := range fs {
+				if f(ev) {
+					continue next
+				}
+			}
+			kept = append(kept, j)
+		}
+		if !reflect.DeepEqual(kept, tt.wkept) {
+			t.Errorf("#%d: kept events %v, want %v", i, kept, tt.wkept)
+		}
+	}
+
+	for _, creq := range []*pb.WatchCreateRequest{{KeyGlob: "/a/["}, {KeyRegex: "/a/("}} {
+		if _, err := FiltersFromRequest(creq); err != rpctypes.ErrGRPCInvalidWatchFilter {
+			t.Errorf("%+v: err = %v, want %v", creq, err, rpctypes.ErrGRPCInvalidWatchFilter)
+		}
+	}
+}
+
 func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	resp = &pb.WatchResponse{Events: make([]*mvccpb.Event, events)}
 	for i := range resp.Events {
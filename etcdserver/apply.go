@@ -256,8 +256,7 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 
 	limit := r.Limit
 	if r.SortOrder != pb.RangeRequest_NONE ||
-		r.MinModRevision != 0 || r.MaxModRevision != 0 ||
-		r.MinCreateRevision != 0 || r.MaxCreateRevision != 0 {
+		r.MaxModRevision != 0 || r.MaxCreateRevision != 0 {
 		// fetch everything; sort and truncate afterwards
 		limit = 0
 	}
@@ -266,14 +265,26 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 		limit = limit + 1
 	}
 
+	key, rev, err := rangeStart(r)
+	if err != nil {
+		return nil, err
+	}
+
 	ro := mvcc.RangeOptions{
 		Limit: limit,
-		Rev:   r.Revision,
+		Rev:   rev,
 		Count: r.CountOnly,
+		// the minimum revisions are filtered by mvcc before the limit
+		MinModRev:     r.MinModRevision,
+		MinCreateRev:  r.MinCreateRevision,
+		CountFiltered: r.CountFiltered,
 	}
 
-	rr, err := txn.Range(r.Key, mkGteRange(r.RangeEnd), ro)
+	rr, err := txn.Range(key, mkGteRange(r.RangeEnd), ro)
 	if err != nil {
+		if err == mvcc.ErrCompacted && len(r.PageToken) != 0 {
+			return nil, ErrPageTokenCompacted
+		}
 		return nil, err
 	}
 
@@ -281,18 +292,10 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 		f := func(kv *mvccpb.KeyValue) bool { return kv.ModRevision > r.MaxModRevision }
 		pruneKVs(rr, f)
 	}
-	if r.MinModRevision != 0 {
-		f := func(kv *mvccpb.KeyValue) bool { return kv.ModRevision < r.MinModRevision }
-		pruneKVs(rr, f)
-	}
 	if r.MaxCreateRevision != 0 {
 		f := func(kv *mvccpb.KeyValue) bool { return kv.CreateRevision > r.MaxCreateRevision }
 		pruneKVs(rr, f)
 	}
-	if r.MinCreateRevision != 0 {
-		f := func(kv *mvccpb.KeyValue) bool { return kv.CreateRevision < r.MinCreateRevision }
-		pruneKVs(rr, f)
-	}
 
 	sortOrder := r.SortOrder
 	if r.SortTarget != pb.RangeRequest_KEY && sortOrder == pb.RangeRequest_NONE {
@@ -326,6 +329,13 @@ func (a *applierV3backend) Range(txn mvcc.TxnRead, r *pb.RangeRequest) (*pb.Rang
 	if r.Limit > 0 && len(rr.KVs) > int(r.Limit) {
 		rr.KVs = rr.KVs[:r.Limit]
 		resp.More = true
+		if isPageable(r) {
+			// later pages are read at the revision of the first
+			if rev == 0 {
+				rev = rr.Rev
+			}
+			resp.NextPageToken = nextPageToken(rev, rr.KVs[len(rr.KVs)-1].Key)
+		}
 	}
 
 	resp.Header.Revision = rr.Rev
@@ -560,7 +570,11 @@ func (a *applierV3backend) applyTxn(txn mvcc.TxnWrite, rt *pb.TxnRequest, txnPat
 func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.CompactionResponse, <-chan struct{}, error) {
 	resp := &pb.CompactionResponse{}
 	resp.Header = &pb.ResponseHeader{}
-	ch, err := a.s.KV().Compact(compaction.Revision)
+	var retain []mvcc.PrefixRetention
+	for _, r := range compaction.Retain {
+		retain = append(retain, mvcc.PrefixRetention{Prefix: r.Prefix, Revision: r.Revision})
+	}
+	ch, err := a.s.KV().CompactRetaining(compaction.Revision, retain)
 	if err != nil {
 		return nil, ch, err
 	}
@@ -943,12 +957,19 @@ func (a *applierV3backend) checkRequestRange(rv mvcc.ReadView, reqOp *pb.Request
 		return nil
 	}
 	req := tv.RequestRange
+	_, rev, err := rangeStart(req)
+	if err != nil {
+		return err
+	}
 	switch {
-	case req.Revision == 0:
+	case rev == 0:
 		return nil
-	case req.Revision > rv.Rev():
+	case rev > rv.Rev():
 		return mvcc.ErrFutureRev
-	case req.Revision < rv.FirstRev():
+	case rev < rv.FirstRev():
+		if len(req.PageToken) != 0 {
+			return ErrPageTokenCompacted
+		}
 		return mvcc.ErrCompacted
 	}
 	return nil
@@ -0,0 +1,286 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/pkg/types"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+)
+
+// monitorDefrag lets the leader defragment the backends of the other members
+// once they waste too much space. The leader defragments one member at a time
+// and waits for it to finish, so that at most one member of the cluster is
+// defragmenting while the leadership is stable. The leader never
+// defragments itself; its backend is left to the next leader.
+func (s *EtcdServer) monitorDefrag() {
+	t := s.Cfg.AutoDefragCheckInterval
+	if t == 0 {
+		return
+	}
+
+	lg := s.getLogger()
+	if lg != nil {
+		lg.Info(
+			"enabled automatic defragmentation",
+			zap.String("local-member-id", s.ID().String()),
+			zap.Duration("interval", t),
+			zap.Float64("wasted-ratio", s.Cfg.AutoDefragRatio),
+			zap.Uint64("wasted-bytes", s.Cfg.AutoDefragMinBytes),
+		)
+	} else {
+		plog.Infof("enabled automatic defragmentation with %s interval", t)
+	}
+
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-time.After(t):
+		}
+		if !s.isLeader() {
+			continue
+		}
+		// a member the previous leader asked to defragment may still be
+		// at it; give it an entire interval to finish
+		s.leadTimeMu.RLock()
+		elected := s.leadElectedTime
+		s.leadTimeMu.RUnlock()
+		if time.Since(elected) < t {
+			continue
+		}
+		s.autoDefrag()
+	}
+}
+
+type peerDBSize struct {
+	id  types.ID
+	eps []string
+
+	size      int64
+	sizeInUse int64
+}
+
+func (s *EtcdServer) autoDefrag() {
+	lg := s.getLogger()
+
+	p, ok := defragCandidate(s.getPeerDBSizes(), s.Cfg.AutoDefragRatio, s.Cfg.AutoDefragMinBytes)
+	if !ok {
+		return
+	}
+	if lg != nil {
+		lg.Info(
+			"defragmenting member with too much wasted space",
+			zap.String("local-member-id", s.ID().String()),
+			zap.String("remote-peer-id", p.id.String()),
+			zap.String("db-size", humanize.Bytes(uint64(p.size))),
+			zap.String("db-size-in-use", humanize.Bytes(uint64(p.sizeInUse))),
+		)
+	} else {
+		plog.Infof("%s defragments %s (size %d bytes, in use %d bytes)", s.ID(), p.id, p.size, p.sizeInUse)
+	}
+
+	start := time.Now()
+	err := s.defragPeer(p)
+	if err != nil {
+		autoDefrags.WithLabelValues("failure").Inc()
+		if lg != nil {
+			lg.Warn(
+				"failed to defragment member",
+				zap.String("local-member-id", s.ID().String()),
+				zap.String("remote-peer-id", p.id.String()),
+				zap.Error(err),
+			)
+		} else {
+			plog.Warningf("%s failed to defragment %s (%v)", s.ID(), p.id, err)
+		}
+		return
+	}
+	autoDefrags.WithLabelValues("success").Inc()
+	if lg != nil {
+		lg.Info(
+			"defragmented member",
+			zap.String("local-member-id", s.ID().String()),
+			zap.String("remote-peer-id", p.id.String()),
+			zap.Duration("took", time.Since(start)),
+		)
+	} else {
+		plog.Infof("%s defragmented %s in %v", s.ID(), p.id, time.Since(start))
+	}
+}
+
+// PeerDefragPath is the peer URL path at which the leader asks a member to
+// defragment its backend.
+const PeerDefragPath = "/members/defrag"
+
+// defragPeer defragments the member over its first peer URL that responds.
+// It waits for the defragmentation to finish, however long that takes.
+func (s *EtcdServer) defragPeer(p *peerDBSize) (err error) {
+	cc := &http.Client{Transport: s.peerRt}
+	for _, ep := range p.eps {
+		err = defragPeerHTTP(s.ctx, cc, ep, s.cluster.ID().String())
+		if err == nil || s.ctx.Err() != nil {
+			break
+		}
+	}
+	return err
+}
+
+func defragPeerHTTP(ctx context.Context, cc *http.Client, url, cid string) error {
+	req, err := http.NewRequest("POST", url+PeerDefragPath, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Etcd-Cluster-ID", cid)
+	resp, err := cc.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("defragment: unknown error(%s)", string(b))
+	}
+	return nil
+}
+
+// DefragHandler returns the handler that defragments the backend online
+// when the leader asks for it. It is only served to peers, so it needs no
+// user credentials when authentication is enabled.
+func (s *EtcdServer) DefragHandler() http.Handler { return &defragHandler{s: s} }
+
+type defragHandler struct {
+	s *EtcdServer
+}
+
+func (h *defragHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if gcid := r.Header.Get("X-Etcd-Cluster-ID"); gcid != h.s.cluster.ID().String() {
+		http.Error(w, "cluster ID mismatch", http.StatusPreconditionFailed)
+		return
+	}
+
+	lg := h.s.getLogger()
+	if lg != nil {
+		lg.Info("starting online defragment requested by leader", zap.String("local-member-id", h.s.ID().String()))
+	} else {
+		plog.Noticef("starting to defragment the storage backend online for the leader...")
+	}
+	if err := h.s.Backend().DefragOnline(nil); err != nil {
+		if lg != nil {
+			lg.Warn("failed to defragment online", zap.String("local-member-id", h.s.ID().String()), zap.Error(err))
+		} else {
+			plog.Errorf("failed to defragment the storage backend online (%v)", err)
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if lg != nil {
+		lg.Info("finished online defragment requested by leader", zap.String("local-member-id", h.s.ID().String()))
+	} else {
+		plog.Noticef("finished defragmenting the storage backend online for the leader")
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// getPeerDBSizes returns the backend sizes of the other members that keep
+// the keyspace and respond in time. Witnesses are skipped.
+func (s *EtcdServer) getPeerDBSizes() (sizes []*peerDBSize) {
+	lg := s.getLogger()
+
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.ID() || m.IsWitness || len(m.PeerURLs) == 0 {
+			continue
+		}
+		cli, cerr := clientv3.New(clientv3.Config{
+			DialTimeout: s.Cfg.ReqTimeout(),
+			Endpoints:   m.PeerURLs,
+		})
+		if cerr != nil {
+			if lg != nil {
+				lg.Warn(
+					"failed to create client to peer URL",
+					zap.String("local-member-id", s.ID().String()),
+					zap.String("remote-peer-id", m.ID.String()),
+					zap.Strings("remote-peer-endpoints", m.PeerURLs),
+					zap.Error(cerr),
+				)
+			} else {
+				plog.Warningf("%s failed to create client to peer %q for defragmentation (%q)", s.ID(), m.PeerURLs, cerr.Error())
+			}
+			continue
+		}
+
+		for _, c := range cli.Endpoints() {
+			ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+			resp, err := cli.Status(ctx, c)
+			cancel()
+			if err == nil {
+				// the member may have been elected since the membership was read
+				if resp.Leader != uint64(m.ID) {
+					sizes = append(sizes, &peerDBSize{id: m.ID, eps: m.PeerURLs, size: resp.DbSize, sizeInUse: resp.DbSizeInUse})
+				}
+				break
+			}
+			if lg != nil {
+				lg.Warn(
+					"failed status request",
+					zap.String("local-member-id", s.ID().String()),
+					zap.String("remote-peer-endpoint", c),
+					zap.Error(err),
+				)
+			} else {
+				plog.Warningf("%s status error %q on peer %q", s.ID(), err.Error(), c)
+			}
+		}
+		cli.Close()
+	}
+	return sizes
+}
+
+// defragCandidate chooses the member that wastes the most space, among those
+// that waste at least the given ratio of their backend and at least minBytes.
+// It returns false, if no member qualifies.
+func defragCandidate(sizes []*peerDBSize, ratio float64, minBytes uint64) (*peerDBSize, bool) {
+	var best *peerDBSize
+	for _, p := range sizes {
+		wasted := p.size - p.sizeInUse
+		if p.size <= 0 || wasted <= 0 || uint64(wasted) < minBytes {
+			continue
+		}
+		if float64(wasted)/float64(p.size) < ratio {
+			continue
+		}
+		if best == nil || wasted > best.size-best.sizeInUse {
+			best = p
+		}
+	}
+	return best, best != nil
+}
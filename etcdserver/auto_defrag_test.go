@@ -0,0 +1,69 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+
+	"go.etcd.io/etcd/pkg/types"
+)
+
+func TestDefragCandidate(t *testing.T) {
+	tests := []struct {
+		sizes    []*peerDBSize
+		ratio    float64
+		minBytes uint64
+
+		wid types.ID
+		wok bool
+	}{
+		// no members
+		{nil, 0.5, 0, 0, false},
+		// wastes too small a ratio
+		{[]*peerDBSize{{id: 1, size: 100, sizeInUse: 60}}, 0.5, 0, 0, false},
+		// wastes too few bytes
+		{[]*peerDBSize{{id: 1, size: 100, sizeInUse: 10}}, 0.5, 100, 0, false},
+		// wastes nothing
+		{[]*peerDBSize{{id: 1, size: 0, sizeInUse: 0}}, 0.5, 0, 0, false},
+		{[]*peerDBSize{{id: 1, size: 100, sizeInUse: 10}}, 0.5, 90, 1, true},
+		// the member wasting the most bytes wins
+		{
+			[]*peerDBSize{
+				{id: 1, size: 100, sizeInUse: 10},
+				{id: 2, size: 1000, sizeInUse: 400},
+				{id: 3, size: 1000, sizeInUse: 600},
+			},
+			0.5, 0, 2, true,
+		},
+		// even if another one wastes a higher ratio
+		{
+			[]*peerDBSize{
+				{id: 1, size: 1000, sizeInUse: 100},
+				{id: 2, size: 10000, sizeInUse: 4000},
+			},
+			0.5, 0, 2, true,
+		},
+	}
+	for i, tt := range tests {
+		p, ok := defragCandidate(tt.sizes, tt.ratio, tt.minBytes)
+		if ok != tt.wok {
+			t.Errorf("#%d: ok = %v, want %v", i, ok, tt.wok)
+			continue
+		}
+		if ok && p.id != tt.wid {
+			t.Errorf("#%d: candidate = %s, want %s", i, p.id, tt.wid)
+		}
+	}
+}
@@ -20,7 +20,6 @@ import (
 	"time"
 
 	"go.etcd.io/etcd/etcdserver/api/snap"
-	"go.etcd.io/etcd/lease"
 	"go.etcd.io/etcd/mvcc"
 	"go.etcd.io/etcd/mvcc/backend"
 	"go.etcd.io/etcd/raft/raftpb"
@@ -43,6 +42,9 @@ func newBackend(cfg ServerConfig) backend.Backend {
 			cfg.Logger.Info("setting backend batch interval", zap.Duration("batch interval", cfg.BackendBatchInterval))
 		}
 	}
+	if cfg.BackendEngine != "" {
+		bcfg.Engine = cfg.BackendEngine
+	}
 	bcfg.Logger = cfg.Logger
 	if cfg.QuotaBackendBytes > 0 && cfg.QuotaBackendBytes != DefaultQuotaBytes {
 		// permit 10% excess over quota for disarm
@@ -63,6 +65,17 @@ func openSnapshotBackend(cfg ServerConfig, ss *snap.Snapshotter, snapshot raftpb
 	return openBackend(cfg), nil
 }
 
+// openWitnessBackend returns the backend of a witness. A witness keeps no
+// keyspace, so its backend is volatile and no db file is opened.
+func openWitnessBackend(cfg ServerConfig) backend.Backend {
+	if cfg.Logger != nil {
+		cfg.Logger.Info("opened volatile backend for witness member")
+	} else {
+		plog.Info("opened volatile backend for witness member")
+	}
+	return backend.NewVolatileBackend(cfg.Logger)
+}
+
 // openBackend returns a backend using the current etcd db.
 func openBackend(cfg ServerConfig) backend.Backend {
 	fn := cfg.backendPath()
@@ -100,10 +113,7 @@ func openBackend(cfg ServerConfig) backend.Backend {
 // violating the invariant snapshot.Metadata.Index < db.consistentIndex. In this
 // case, replace the db with the snapshot db sent by the leader.
 func recoverSnapshotBackend(cfg ServerConfig, oldbe backend.Backend, snapshot raftpb.Snapshot) (backend.Backend, error) {
-	var cIndex consistentIndex
-	kv := mvcc.New(cfg.Logger, oldbe, &lease.FakeLessor{}, &cIndex)
-	defer kv.Close()
-	if snapshot.Metadata.Index <= kv.ConsistentIndex() {
+	if snapshot.Metadata.Index <= mvcc.ReadConsistentIndex(oldbe) {
 		return oldbe, nil
 	}
 	oldbe.Close()
@@ -0,0 +1,149 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import "sync"
+
+// ClientResource is a resource the clients of the member hold.
+type ClientResource int
+
+const (
+	// ClientWatchers is the number of watchers.
+	ClientWatchers ClientResource = iota
+	// ClientLeaseKeepAlives is the number of lease keepalive streams.
+	ClientLeaseKeepAlives
+	// ClientWatchBytes is the number of bytes of watch responses buffered
+	// for sending.
+	ClientWatchBytes
+
+	numClientResources
+)
+
+var clientResourceNames = [numClientResources]string{"watchers", "lease_keepalive_streams", "watch_buffered_bytes"}
+
+// clientLimitErrors are the errors of exceeding the limits of each resource
+// on a connection and for a user.
+var clientLimitErrors = [numClientResources][2]error{
+	{ErrTooManyConnWatchers, ErrTooManyUserWatchers},
+	{ErrTooManyConnLeaseKeepAlives, ErrTooManyUserLeaseKeepAlives},
+	{ErrConnWatchBufferFull, ErrUserWatchBufferFull},
+}
+
+type clientUsage [numClientResources]int64
+
+func (u *clientUsage) get(r ClientResource) int64 {
+	if u == nil {
+		return 0
+	}
+	return u[r]
+}
+
+// ClientLimits bounds the resources held by each client connection and each
+// authenticated user across the gRPC servers of the member.
+type ClientLimits struct {
+	maxConn, maxUser clientUsage
+
+	mu    sync.Mutex
+	conns map[string]*clientUsage
+	users map[string]*clientUsage
+}
+
+// newClientLimits returns the limits of the configuration, or nil if it sets
+// none.
+func newClientLimits(cfg ServerConfig) *ClientLimits {
+	l := &ClientLimits{
+		maxConn: clientUsage{cfg.MaxWatchersPerConnection, cfg.MaxLeaseKeepAlivesPerConnection, cfg.MaxWatchBufferedBytesPerConnection},
+		maxUser: clientUsage{cfg.MaxWatchersPerUser, cfg.MaxLeaseKeepAlivesPerUser, cfg.MaxWatchBufferedBytesPerUser},
+	}
+	if l.maxConn == (clientUsage{}) && l.maxUser == (clientUsage{}) {
+		return nil
+	}
+	l.conns = make(map[string]*clientUsage)
+	l.users = make(map[string]*clientUsage)
+	return l
+}
+
+// Acquire takes n of the resource for the connection and the user, or returns
+// the error of the limit it would exceed. An empty connection or user is not
+// limited; the user is empty if the client is not authenticated.
+func (l *ClientLimits) Acquire(conn, user string, r ClientResource, n int64) error {
+	if l == nil || n == 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cu, uu := l.conns[conn], l.users[user]
+	var err error
+	if max := l.maxConn[r]; max > 0 && conn != "" && cu.get(r)+n > max {
+		err = clientLimitErrors[r][0]
+		clientLimitsExceeded.WithLabelValues(clientResourceNames[r], "connection", user).Inc()
+	} else if max := l.maxUser[r]; max > 0 && user != "" && uu.get(r)+n > max {
+		err = clientLimitErrors[r][1]
+		clientLimitsExceeded.WithLabelValues(clientResourceNames[r], "user", user).Inc()
+	}
+	if err != nil {
+		return err
+	}
+
+	if conn != "" {
+		if cu == nil {
+			cu = new(clientUsage)
+			l.conns[conn] = cu
+		}
+		cu[r] += n
+	}
+	if user != "" {
+		if uu == nil {
+			uu = new(clientUsage)
+			l.users[user] = uu
+		}
+		uu[r] += n
+		userResources.WithLabelValues(user, clientResourceNames[r]).Add(float64(n))
+	}
+	return nil
+}
+
+// Release returns n of the resource taken by Acquire.
+func (l *ClientLimits) Release(conn, user string, r ClientResource, n int64) {
+	if l == nil || n == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	release(l.conns, conn, r, n)
+	if release(l.users, user, r, n) {
+		userResources.WithLabelValues(user, clientResourceNames[r]).Sub(float64(n))
+	}
+}
+
+// release returns n of the resource held by the client of the given key, and
+// forgets the client once it holds nothing.
+func release(usage map[string]*clientUsage, key string, r ClientResource, n int64) bool {
+	u, ok := usage[key]
+	if !ok {
+		return false
+	}
+	u[r] -= n
+	if *u == (clientUsage{}) {
+		delete(usage, key)
+	}
+	return true
+}
+
+// ClientLimits returns the limits of the resources held by the clients of
+// the server, or nil if they are unlimited.
+func (s *EtcdServer) ClientLimits() *ClientLimits { return s.clientLimits }
@@ -0,0 +1,86 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import "testing"
+
+func TestNewClientLimitsUnset(t *testing.T) {
+	l := newClientLimits(ServerConfig{})
+	if l != nil {
+		t.Fatalf("limits = %+v, want nil", l)
+	}
+	// nil limits allow everything
+	if err := l.Acquire("conn", "user", ClientWatchers, 1<<20); err != nil {
+		t.Fatalf("acquire error = %v, want nil", err)
+	}
+	l.Release("conn", "user", ClientWatchers, 1<<20)
+}
+
+func TestClientLimitsAcquireRelease(t *testing.T) {
+	l := newClientLimits(ServerConfig{
+		MaxWatchersPerConnection:     2,
+		MaxWatchersPerUser:           3,
+		MaxWatchBufferedBytesPerUser: 100,
+	})
+
+	tests := []struct {
+		conn, user string
+		r          ClientResource
+		n          int64
+
+		werr error
+	}{
+		{"a", "alice", ClientWatchers, 1, nil},
+		{"a", "alice", ClientWatchers, 1, nil},
+		// the connection holds 2 watchers
+		{"a", "alice", ClientWatchers, 1, ErrTooManyConnWatchers},
+		{"b", "alice", ClientWatchers, 1, nil},
+		// the user holds 3 watchers
+		{"b", "alice", ClientWatchers, 1, ErrTooManyUserWatchers},
+		{"c", "bob", ClientWatchers, 1, nil},
+		// clients that are not authenticated are only bound by the connection
+		{"d", "", ClientWatchers, 2, nil},
+		{"d", "", ClientWatchers, 1, ErrTooManyConnWatchers},
+		// other resources are counted on their own
+		{"a", "alice", ClientLeaseKeepAlives, 10, nil},
+		{"a", "alice", ClientWatchBytes, 100, nil},
+		{"b", "alice", ClientWatchBytes, 1, ErrUserWatchBufferFull},
+	}
+	for i, tt := range tests {
+		if err := l.Acquire(tt.conn, tt.user, tt.r, tt.n); err != tt.werr {
+			t.Errorf("#%d: acquire error = %v, want %v", i, err, tt.werr)
+		}
+	}
+
+	l.Release("a", "alice", ClientWatchers, 1)
+	if err := l.Acquire("b", "alice", ClientWatchers, 1); err != nil {
+		t.Fatalf("acquire error = %v, want nil", err)
+	}
+	l.Release("a", "alice", ClientWatchBytes, 100)
+	if err := l.Acquire("b", "alice", ClientWatchBytes, 100); err != nil {
+		t.Fatalf("acquire error = %v, want nil", err)
+	}
+
+	// release everything; the clients are forgotten once they hold nothing
+	l.Release("a", "alice", ClientWatchers, 1)
+	l.Release("a", "alice", ClientLeaseKeepAlives, 10)
+	l.Release("b", "alice", ClientWatchers, 2)
+	l.Release("b", "alice", ClientWatchBytes, 100)
+	l.Release("c", "bob", ClientWatchers, 1)
+	l.Release("d", "", ClientWatchers, 2)
+	if len(l.conns) != 0 || len(l.users) != 0 {
+		t.Fatalf("usage = %v, %v, want none", l.conns, l.users)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"go.etcd.io/etcd/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/pkg/netutil"
 	"go.etcd.io/etcd/pkg/transport"
 	"go.etcd.io/etcd/pkg/types"
@@ -52,6 +53,15 @@ type ServerConfig struct {
 	// WARNING: only change this for tests. Always use "DefaultSnapshotCatchUpEntries"
 	SnapshotCatchUpEntries uint64
 
+	// SnapshotBytes is the aggregate byte size of the entries applied since
+	// the last snapshot that triggers a snapshot, regardless of
+	// SnapshotCount. Zero disables size based snapshots.
+	SnapshotBytes uint64
+	// SnapshotCatchUpBytes limits the aggregate byte size of the entries
+	// that are kept in memory for slow followers after a snapshot, in
+	// addition to SnapshotCatchUpEntries. Zero means no limit.
+	SnapshotCatchUpBytes uint64
+
 	MaxSnapFiles uint
 	MaxWALFiles  uint
 
@@ -59,6 +69,8 @@ type ServerConfig struct {
 	BackendBatchInterval time.Duration
 	// BackendBatchLimit is the maximum operations before commit the backend transaction.
 	BackendBatchLimit int
+	// BackendEngine is the storage engine the backend is kept in.
+	BackendEngine string
 
 	InitialPeerURLsMap  types.URLsMap
 	InitialClusterToken string
@@ -130,6 +142,86 @@ type ServerConfig struct {
 	// PreVote is true to enable Raft Pre-Vote.
 	PreVote bool
 
+	// LeaseRead is true to serve linearizable reads on the leader from its
+	// check-quorum-backed lease, without confirming the leadership with a
+	// round of heartbeats.
+	LeaseRead bool
+	// LeaseReadClockDrift is the maximum amount by which the clocks of the
+	// other members may run ahead of the leader's over one election timeout.
+	// The lease is shortened by it.
+	LeaseReadClockDrift time.Duration
+
+	// MaxUncommittedEntriesBytes limits the aggregate byte size of the
+	// uncommitted tail of the leader's raft log. Proposals that would exceed
+	// it are rejected with ErrTooManyRequests. Zero means no limit.
+	MaxUncommittedEntriesBytes uint64
+
+	// LeaderPriority is the priority of the local member to become the
+	// leader. It is published with the member attributes.
+	LeaderPriority int
+	// LeaderBalanceInterval is the wait duration between checks of the
+	// leader for a healthy member with a higher priority to transfer its
+	// leadership to. Zero disables leadership balancing.
+	LeaderBalanceInterval time.Duration
+
+	// RevisionIndex keeps the keys ordered by the revisions they were
+	// modified at, to serve ranges filtered by a minimum modification or
+	// creation revision without visiting every key in the range.
+	RevisionIndex bool
+
+	// AutoDefragCheckInterval is the wait duration between checks of the
+	// leader for a member to defragment. Zero disables automatic
+	// defragmentation.
+	AutoDefragCheckInterval time.Duration
+	// AutoDefragRatio is the minimum ratio of its backend size a member must
+	// waste to be defragmented automatically.
+	AutoDefragRatio float64
+	// AutoDefragMinBytes is the minimum number of bytes a member must waste
+	// to be defragmented automatically.
+	AutoDefragMinBytes uint64
+
+	// IndexCheckpoint saves the key index next to the backend on every
+	// snapshot, so that a restart only scans the backend for the revisions
+	// made since.
+	IndexCheckpoint bool
+
+	// CompactionBatchLimit is the maximum number of revisions a compaction
+	// visits in the backend per batch.
+	CompactionBatchLimit int
+	// CompactionMaxPause bounds how long a batch of a compaction holds the
+	// backend. Zero does not bound it.
+	CompactionMaxPause time.Duration
+	// CompactionKeysPerSecond and CompactionBytesPerSecond limit the rate a
+	// compaction deletes revisions at. Zero is unlimited; without either, a
+	// compaction waits 100ms between batches.
+	CompactionKeysPerSecond  int64
+	CompactionBytesPerSecond int64
+	// CompactionPrefixRetention keeps the history of the keys under its
+	// prefixes past the compactions the member proposes, for a number of
+	// revisions or a window of time.
+	CompactionPrefixRetention []v3compactor.PrefixRetention
+
+	// ValueCompression is the compression of the values the member puts in
+	// its backend; see mvcc.StoreConfig.
+	ValueCompression string
+
+	// WatchCacheRevisions is the number of most recent revisions whose
+	// events are held in memory for watchers to catch up from; see
+	// mvcc.StoreConfig.
+	WatchCacheRevisions int64
+
+	// MaxWatchersPerConnection, MaxLeaseKeepAlivesPerConnection and
+	// MaxWatchBufferedBytesPerConnection bound the watchers, the lease
+	// keepalive streams and the bytes of watch responses buffered for
+	// sending of each client connection. The PerUser limits bound them for
+	// each authenticated user across its connections. Zero is unlimited.
+	MaxWatchersPerConnection           int64
+	MaxWatchersPerUser                 int64
+	MaxLeaseKeepAlivesPerConnection    int64
+	MaxLeaseKeepAlivesPerUser          int64
+	MaxWatchBufferedBytesPerConnection int64
+	MaxWatchBufferedBytesPerUser       int64
+
 	// Logger logs server-side operations.
 	// If not nil, it disables "capnslog" and uses the given logger.
 	Logger *zap.Logger
@@ -269,6 +361,15 @@ func (c *ServerConfig) electionTimeout() time.Duration {
 	return time.Duration(c.ElectionTicks*int(c.TickMs)) * time.Millisecond
 }
 
+// leaseClockDriftTicks returns LeaseReadClockDrift in ticks, rounded up.
+func (c *ServerConfig) leaseClockDriftTicks() int {
+	tick := time.Duration(c.TickMs) * time.Millisecond
+	if tick <= 0 {
+		return 0
+	}
+	return int((c.LeaseReadClockDrift + tick - 1) / tick)
+}
+
 func (c *ServerConfig) peerDialTimeout() time.Duration {
 	// 1s for queue wait and election timeout
 	return time.Second + time.Duration(c.ElectionTicks*int(c.TickMs))*time.Millisecond
@@ -296,3 +397,5 @@ func (c *ServerConfig) bootstrapTimeout() time.Duration {
 }
 
 func (c *ServerConfig) backendPath() string { return filepath.Join(c.SnapDir(), "db") }
+
+func (c *ServerConfig) indexCheckpointPath() string { return c.backendPath() + ".index" }
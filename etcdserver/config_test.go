@@ -17,6 +17,7 @@ package etcdserver
 import (
 	"net/url"
 	"testing"
+	"time"
 
 	"go.etcd.io/etcd/pkg/types"
 
@@ -210,3 +211,25 @@ func TestShouldDiscover(t *testing.T) {
 		}
 	}
 }
+
+func TestLeaseClockDriftTicks(t *testing.T) {
+	tests := []struct {
+		drift time.Duration
+		w     int
+	}{
+		{0, 0},
+		{time.Millisecond, 1},
+		{100 * time.Millisecond, 1},
+		{101 * time.Millisecond, 2},
+	}
+	for i, tt := range tests {
+		cfg := ServerConfig{
+			TickMs:              100,
+			LeaseReadClockDrift: tt.drift,
+			Logger:              zap.NewExample(),
+		}
+		if g := cfg.leaseClockDriftTicks(); g != tt.w {
+			t.Errorf("#%d: leaseClockDriftTicks()=%d, want=%d", i, g, tt.w)
+		}
+	}
+}
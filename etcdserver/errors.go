@@ -37,6 +37,17 @@ var (
 	ErrUnhealthy                  = errors.New("etcdserver: unhealthy cluster")
 	ErrKeyNotFound                = errors.New("etcdserver: key not found")
 	ErrCorrupt                    = errors.New("etcdserver: corrupt cluster")
+	ErrLearnerNotReady            = errors.New("etcdserver: can only promote a learner member which is in sync with leader")
+	ErrWitnessTransferee          = errors.New("etcdserver: cannot transfer leadership to a witness member")
+	ErrNotSupportedForWitness     = errors.New("etcdserver: rpc not supported for witness member")
+	ErrInvalidPageToken           = errors.New("etcdserver: invalid page token")
+	ErrPageTokenCompacted         = errors.New("etcdserver: page token revision has been compacted; restart the paginated range")
+	ErrTooManyConnWatchers        = errors.New("etcdserver: too many watchers on the connection")
+	ErrTooManyUserWatchers        = errors.New("etcdserver: too many watchers for the user")
+	ErrTooManyConnLeaseKeepAlives = errors.New("etcdserver: too many lease keepalive streams on the connection")
+	ErrTooManyUserLeaseKeepAlives = errors.New("etcdserver: too many lease keepalive streams for the user")
+	ErrConnWatchBufferFull        = errors.New("etcdserver: too many buffered watch bytes on the connection")
+	ErrUserWatchBufferFull        = errors.New("etcdserver: too many buffered watch bytes for the user")
 )
 
 type DiscoveryError struct {
@@ -170,22 +170,30 @@ const (
 	WatchCreateRequest_NOPUT WatchCreateRequest_FilterType = 0
 	// filter out delete event.
 	WatchCreateRequest_NODELETE WatchCreateRequest_FilterType = 1
+	// filter out put event creating a key.
+	WatchCreateRequest_NOCREATE WatchCreateRequest_FilterType = 2
+	// filter out put event modifying an existing key.
+	WatchCreateRequest_NOMODIFY WatchCreateRequest_FilterType = 3
 )
 
 var WatchCreateRequest_FilterType_name = map[int32]string{
 	0: "NOPUT",
 	1: "NODELETE",
+	2: "NOCREATE",
+	3: "NOMODIFY",
 }
 var WatchCreateRequest_FilterType_value = map[string]int32{
 	"NOPUT":    0,
 	"NODELETE": 1,
+	"NOCREATE": 2,
+	"NOMODIFY": 3,
 }
 
 func (x WatchCreateRequest_FilterType) String() string {
 	return proto.EnumName(WatchCreateRequest_FilterType_name, int32(x))
 }
 func (WatchCreateRequest_FilterType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{21, 0}
+	return fileDescriptorRpc, []int{22, 0}
 }
 
 type AlarmRequest_AlarmAction int32
@@ -211,7 +219,7 @@ func (x AlarmRequest_AlarmAction) String() string {
 	return proto.EnumName(AlarmRequest_AlarmAction_name, int32(x))
 }
 func (AlarmRequest_AlarmAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{52, 0}
+	return fileDescriptorRpc, []int{56, 0}
 }
 
 type ResponseHeader struct {
@@ -304,6 +312,22 @@ type RangeRequest struct {
 	// max_create_revision is the upper bound for returned key create revisions; all keys with
 	// greater create revisions will be filtered away.
 	MaxCreateRevision int64 `protobuf:"varint,13,opt,name=max_create_revision,json=maxCreateRevision,proto3" json:"max_create_revision,omitempty"`
+	// max_staleness sets the range request to use bounded-staleness reads. It is the number of
+	// raft entries by which the serving member's applied index may trail the leader's commit
+	// index at the time of the request. The member still learns the commit index from the
+	// leader, but answers as soon as it has applied enough entries instead of waiting to catch
+	// up fully. Zero means a linearizable read. It is ignored for serializable requests.
+	MaxStaleness int64 `protobuf:"varint,14,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+	// page_token resumes a paginated range from the next_page_token of the previous page.
+	// The range continues after the last key of that page, at the revision of the first page.
+	// The other fields must stay the same across pages; revision may be left zero. If the
+	// revision of the first page has been compacted since, the range fails.
+	PageToken []byte `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// count_filtered when set makes count the number of keys in the range that pass
+	// min_mod_revision and min_create_revision, instead of the number of all keys in the range.
+	// Members started with --experimental-revision-index can then answer the range without
+	// visiting every key in it.
+	CountFiltered bool `protobuf:"varint,16,opt,name=count_filtered,json=countFiltered,proto3" json:"count_filtered,omitempty"`
 }
 
 func (m *RangeRequest) Reset()                    { *m = RangeRequest{} }
@@ -402,6 +426,27 @@ func (m *RangeRequest) GetMaxCreateRevision() int64 {
 	return 0
 }
 
+func (m *RangeRequest) GetMaxStaleness() int64 {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+func (m *RangeRequest) GetPageToken() []byte {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
+func (m *RangeRequest) GetCountFiltered() bool {
+	if m != nil {
+		return m.CountFiltered
+	}
+	return false
+}
+
 type RangeResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	// kvs is the list of key-value pairs matched by the range request.
@@ -409,8 +454,12 @@ type RangeResponse struct {
 	Kvs []*mvccpb.KeyValue `protobuf:"bytes,2,rep,name=kvs" json:"kvs,omitempty"`
 	// more indicates if there are more keys to return in the requested range.
 	More bool `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
-	// count is set to the number of keys within the range when requested.
+	// count is set to the number of keys within the range when requested. If count_filtered
+	// is set, it is the number of keys within the range that pass the minimum revisions.
 	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// next_page_token is set when more is set and the keys are sorted by key in ascending
+	// order. Passing it as the page_token of the same range request returns the next page.
+	NextPageToken []byte `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (m *RangeResponse) Reset()                    { *m = RangeResponse{} }
@@ -446,6 +495,13 @@ func (m *RangeResponse) GetCount() int64 {
 	return 0
 }
 
+func (m *RangeResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 type PutRequest struct {
 	// key is the key, in bytes, to put into the key-value store.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -1312,6 +1368,10 @@ type CompactionRequest struct {
 	// applied to the local database such that compacted entries are totally
 	// removed from the backend database.
 	Physical bool `protobuf:"varint,2,opt,name=physical,proto3" json:"physical,omitempty"`
+	// retain lists the key prefixes whose history is kept past the
+	// compaction revision. If empty, the server's configured retention
+	// policy applies.
+	Retain []*CompactionRetention `protobuf:"bytes,3,rep,name=retain" json:"retain,omitempty"`
 }
 
 func (m *CompactionRequest) Reset()                    { *m = CompactionRequest{} }
@@ -1333,6 +1393,41 @@ func (m *CompactionRequest) GetPhysical() bool {
 	return false
 }
 
+func (m *CompactionRequest) GetRetain() []*CompactionRetention {
+	if m != nil {
+		return m.Retain
+	}
+	return nil
+}
+
+type CompactionRetention struct {
+	// prefix is the prefix of the keys whose history is retained.
+	Prefix []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// revision is the revision the keys under the prefix are compacted up to.
+	// Revisions the keys were compacted past earlier are not brought back,
+	// and a revision not older than the compaction revision retains nothing.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *CompactionRetention) Reset()                    { *m = CompactionRetention{} }
+func (m *CompactionRetention) String() string            { return proto.CompactTextString(m) }
+func (*CompactionRetention) ProtoMessage()               {}
+func (*CompactionRetention) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
+
+func (m *CompactionRetention) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *CompactionRetention) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 type CompactionResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 }
@@ -1340,7 +1435,7 @@ type CompactionResponse struct {
 func (m *CompactionResponse) Reset()                    { *m = CompactionResponse{} }
 func (m *CompactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*CompactionResponse) ProtoMessage()               {}
-func (*CompactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
+func (*CompactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
 
 func (m *CompactionResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1355,7 +1450,7 @@ type HashRequest struct {
 func (m *HashRequest) Reset()                    { *m = HashRequest{} }
 func (m *HashRequest) String() string            { return proto.CompactTextString(m) }
 func (*HashRequest) ProtoMessage()               {}
-func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
+func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{15} }
 
 type HashKVRequest struct {
 	// revision is the key-value store revision for the hash operation.
@@ -1365,7 +1460,7 @@ type HashKVRequest struct {
 func (m *HashKVRequest) Reset()                    { *m = HashKVRequest{} }
 func (m *HashKVRequest) String() string            { return proto.CompactTextString(m) }
 func (*HashKVRequest) ProtoMessage()               {}
-func (*HashKVRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{15} }
+func (*HashKVRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
 
 func (m *HashKVRequest) GetRevision() int64 {
 	if m != nil {
@@ -1385,7 +1480,7 @@ type HashKVResponse struct {
 func (m *HashKVResponse) Reset()                    { *m = HashKVResponse{} }
 func (m *HashKVResponse) String() string            { return proto.CompactTextString(m) }
 func (*HashKVResponse) ProtoMessage()               {}
-func (*HashKVResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
+func (*HashKVResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
 
 func (m *HashKVResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1417,7 +1512,7 @@ type HashResponse struct {
 func (m *HashResponse) Reset()                    { *m = HashResponse{} }
 func (m *HashResponse) String() string            { return proto.CompactTextString(m) }
 func (*HashResponse) ProtoMessage()               {}
-func (*HashResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
+func (*HashResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
 
 func (m *HashResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1439,7 +1534,7 @@ type SnapshotRequest struct {
 func (m *SnapshotRequest) Reset()                    { *m = SnapshotRequest{} }
 func (m *SnapshotRequest) String() string            { return proto.CompactTextString(m) }
 func (*SnapshotRequest) ProtoMessage()               {}
-func (*SnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
+func (*SnapshotRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
 
 type SnapshotResponse struct {
 	// header has the current key-value store information. The first header in the snapshot
@@ -1454,7 +1549,7 @@ type SnapshotResponse struct {
 func (m *SnapshotResponse) Reset()                    { *m = SnapshotResponse{} }
 func (m *SnapshotResponse) String() string            { return proto.CompactTextString(m) }
 func (*SnapshotResponse) ProtoMessage()               {}
-func (*SnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
+func (*SnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
 
 func (m *SnapshotResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1662,12 +1757,34 @@ type WatchCreateRequest struct {
 	WatchId int64 `protobuf:"varint,7,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
 	// fragment enables splitting large revisions into multiple watch responses.
 	Fragment bool `protobuf:"varint,8,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// key_glob, if set, filters out the events of keys not matching the glob pattern,
+	// with the syntax of Go's path.Match: '*' matches any sequence of bytes other than '/'.
+	KeyGlob string `protobuf:"bytes,9,opt,name=key_glob,json=keyGlob,proto3" json:"key_glob,omitempty"`
+	// key_regex, if set, filters out the events of keys not matching the regular
+	// expression, in RE2 syntax. It matches anywhere in the key unless anchored.
+	KeyRegex string `protobuf:"bytes,10,opt,name=key_regex,json=keyRegex,proto3" json:"key_regex,omitempty"`
+	// value_prefix, if set, filters out the put events whose values do not start with it.
+	// Delete events carry no value and are not filtered by it.
+	ValuePrefix []byte `protobuf:"bytes,11,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
+	// lease, if set, filters out the put events of keys not attached to the lease.
+	// Delete events carry no lease and are not filtered by it.
+	Lease int64 `protobuf:"varint,12,opt,name=lease,proto3" json:"lease,omitempty"`
+	// resume_token, if set, resumes the watch right after the position of a previous
+	// watch with the same key, range_end and filters, as given by the resume_token
+	// of its last response. The watch then receives each event exactly once even
+	// if it resumes on another member, and start_revision is ignored.
+	ResumeToken []byte `protobuf:"bytes,13,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// initial_state, if set, first sends the key-values in the range at the revision
+	// before start_revision, or at the current revision if start_revision is not set, as
+	// put events in responses at that revision, then the events after it. It is ignored
+	// when the watch resumes from a resume_token.
+	InitialState bool `protobuf:"varint,14,opt,name=initial_state,json=initialState,proto3" json:"initial_state,omitempty"`
 }
 
 func (m *WatchCreateRequest) Reset()                    { *m = WatchCreateRequest{} }
 func (m *WatchCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*WatchCreateRequest) ProtoMessage()               {}
-func (*WatchCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
+func (*WatchCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
 
 func (m *WatchCreateRequest) GetKey() []byte {
 	if m != nil {
@@ -1725,6 +1842,48 @@ func (m *WatchCreateRequest) GetFragment() bool {
 	return false
 }
 
+func (m *WatchCreateRequest) GetKeyGlob() string {
+	if m != nil {
+		return m.KeyGlob
+	}
+	return ""
+}
+
+func (m *WatchCreateRequest) GetKeyRegex() string {
+	if m != nil {
+		return m.KeyRegex
+	}
+	return ""
+}
+
+func (m *WatchCreateRequest) GetValuePrefix() []byte {
+	if m != nil {
+		return m.ValuePrefix
+	}
+	return nil
+}
+
+func (m *WatchCreateRequest) GetLease() int64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
+func (m *WatchCreateRequest) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (m *WatchCreateRequest) GetInitialState() bool {
+	if m != nil {
+		return m.InitialState
+	}
+	return false
+}
+
 type WatchCancelRequest struct {
 	// watch_id is the watcher id to cancel so that no more events are transmitted.
 	WatchId int64 `protobuf:"varint,1,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
@@ -1733,7 +1892,7 @@ type WatchCancelRequest struct {
 func (m *WatchCancelRequest) Reset()                    { *m = WatchCancelRequest{} }
 func (m *WatchCancelRequest) String() string            { return proto.CompactTextString(m) }
 func (*WatchCancelRequest) ProtoMessage()               {}
-func (*WatchCancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+func (*WatchCancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
 
 func (m *WatchCancelRequest) GetWatchId() int64 {
 	if m != nil {
@@ -1750,7 +1909,7 @@ type WatchProgressRequest struct {
 func (m *WatchProgressRequest) Reset()                    { *m = WatchProgressRequest{} }
 func (m *WatchProgressRequest) String() string            { return proto.CompactTextString(m) }
 func (*WatchProgressRequest) ProtoMessage()               {}
-func (*WatchProgressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+func (*WatchProgressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
 
 type WatchResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -1776,14 +1935,18 @@ type WatchResponse struct {
 	// cancel_reason indicates the reason for canceling the watcher.
 	CancelReason string `protobuf:"bytes,6,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
 	// framgment is true if large watch response was split over multiple responses.
-	Fragment bool            `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
-	Events   []*mvccpb.Event `protobuf:"bytes,11,rep,name=events" json:"events,omitempty"`
+	Fragment bool `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// resume_token is the opaque position of the watcher in the event history after
+	// this response. It does not depend on the member and can resume the watch on any
+	// member through resume_token of WatchCreateRequest.
+	ResumeToken []byte          `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Events      []*mvccpb.Event `protobuf:"bytes,11,rep,name=events" json:"events,omitempty"`
 }
 
 func (m *WatchResponse) Reset()                    { *m = WatchResponse{} }
 func (m *WatchResponse) String() string            { return proto.CompactTextString(m) }
 func (*WatchResponse) ProtoMessage()               {}
-func (*WatchResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
+func (*WatchResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
 
 func (m *WatchResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1834,6 +1997,13 @@ func (m *WatchResponse) GetFragment() bool {
 	return false
 }
 
+func (m *WatchResponse) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
 func (m *WatchResponse) GetEvents() []*mvccpb.Event {
 	if m != nil {
 		return m.Events
@@ -1851,7 +2021,7 @@ type LeaseGrantRequest struct {
 func (m *LeaseGrantRequest) Reset()                    { *m = LeaseGrantRequest{} }
 func (m *LeaseGrantRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseGrantRequest) ProtoMessage()               {}
-func (*LeaseGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+func (*LeaseGrantRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
 
 func (m *LeaseGrantRequest) GetTTL() int64 {
 	if m != nil {
@@ -1879,7 +2049,7 @@ type LeaseGrantResponse struct {
 func (m *LeaseGrantResponse) Reset()                    { *m = LeaseGrantResponse{} }
 func (m *LeaseGrantResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseGrantResponse) ProtoMessage()               {}
-func (*LeaseGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
+func (*LeaseGrantResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
 
 func (m *LeaseGrantResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1917,7 +2087,7 @@ type LeaseRevokeRequest struct {
 func (m *LeaseRevokeRequest) Reset()                    { *m = LeaseRevokeRequest{} }
 func (m *LeaseRevokeRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseRevokeRequest) ProtoMessage()               {}
-func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
+func (*LeaseRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
 
 func (m *LeaseRevokeRequest) GetID() int64 {
 	if m != nil {
@@ -1933,7 +2103,7 @@ type LeaseRevokeResponse struct {
 func (m *LeaseRevokeResponse) Reset()                    { *m = LeaseRevokeResponse{} }
 func (m *LeaseRevokeResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseRevokeResponse) ProtoMessage()               {}
-func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
+func (*LeaseRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
 
 func (m *LeaseRevokeResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -1952,7 +2122,7 @@ type LeaseCheckpoint struct {
 func (m *LeaseCheckpoint) Reset()                    { *m = LeaseCheckpoint{} }
 func (m *LeaseCheckpoint) String() string            { return proto.CompactTextString(m) }
 func (*LeaseCheckpoint) ProtoMessage()               {}
-func (*LeaseCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+func (*LeaseCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
 
 func (m *LeaseCheckpoint) GetID() int64 {
 	if m != nil {
@@ -1975,7 +2145,7 @@ type LeaseCheckpointRequest struct {
 func (m *LeaseCheckpointRequest) Reset()                    { *m = LeaseCheckpointRequest{} }
 func (m *LeaseCheckpointRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseCheckpointRequest) ProtoMessage()               {}
-func (*LeaseCheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+func (*LeaseCheckpointRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
 
 func (m *LeaseCheckpointRequest) GetCheckpoints() []*LeaseCheckpoint {
 	if m != nil {
@@ -1991,7 +2161,7 @@ type LeaseCheckpointResponse struct {
 func (m *LeaseCheckpointResponse) Reset()                    { *m = LeaseCheckpointResponse{} }
 func (m *LeaseCheckpointResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseCheckpointResponse) ProtoMessage()               {}
-func (*LeaseCheckpointResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
+func (*LeaseCheckpointResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
 
 func (m *LeaseCheckpointResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2008,7 +2178,7 @@ type LeaseKeepAliveRequest struct {
 func (m *LeaseKeepAliveRequest) Reset()                    { *m = LeaseKeepAliveRequest{} }
 func (m *LeaseKeepAliveRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseKeepAliveRequest) ProtoMessage()               {}
-func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+func (*LeaseKeepAliveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
 
 func (m *LeaseKeepAliveRequest) GetID() int64 {
 	if m != nil {
@@ -2028,7 +2198,7 @@ type LeaseKeepAliveResponse struct {
 func (m *LeaseKeepAliveResponse) Reset()                    { *m = LeaseKeepAliveResponse{} }
 func (m *LeaseKeepAliveResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseKeepAliveResponse) ProtoMessage()               {}
-func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+func (*LeaseKeepAliveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
 
 func (m *LeaseKeepAliveResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2061,7 +2231,7 @@ type LeaseTimeToLiveRequest struct {
 func (m *LeaseTimeToLiveRequest) Reset()                    { *m = LeaseTimeToLiveRequest{} }
 func (m *LeaseTimeToLiveRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseTimeToLiveRequest) ProtoMessage()               {}
-func (*LeaseTimeToLiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
+func (*LeaseTimeToLiveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
 
 func (m *LeaseTimeToLiveRequest) GetID() int64 {
 	if m != nil {
@@ -2092,7 +2262,7 @@ type LeaseTimeToLiveResponse struct {
 func (m *LeaseTimeToLiveResponse) Reset()                    { *m = LeaseTimeToLiveResponse{} }
 func (m *LeaseTimeToLiveResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseTimeToLiveResponse) ProtoMessage()               {}
-func (*LeaseTimeToLiveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
+func (*LeaseTimeToLiveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
 
 func (m *LeaseTimeToLiveResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2135,7 +2305,7 @@ type LeaseLeasesRequest struct {
 func (m *LeaseLeasesRequest) Reset()                    { *m = LeaseLeasesRequest{} }
 func (m *LeaseLeasesRequest) String() string            { return proto.CompactTextString(m) }
 func (*LeaseLeasesRequest) ProtoMessage()               {}
-func (*LeaseLeasesRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+func (*LeaseLeasesRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
 
 type LeaseStatus struct {
 	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -2144,7 +2314,7 @@ type LeaseStatus struct {
 func (m *LeaseStatus) Reset()                    { *m = LeaseStatus{} }
 func (m *LeaseStatus) String() string            { return proto.CompactTextString(m) }
 func (*LeaseStatus) ProtoMessage()               {}
-func (*LeaseStatus) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
+func (*LeaseStatus) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
 
 func (m *LeaseStatus) GetID() int64 {
 	if m != nil {
@@ -2161,7 +2331,7 @@ type LeaseLeasesResponse struct {
 func (m *LeaseLeasesResponse) Reset()                    { *m = LeaseLeasesResponse{} }
 func (m *LeaseLeasesResponse) String() string            { return proto.CompactTextString(m) }
 func (*LeaseLeasesResponse) ProtoMessage()               {}
-func (*LeaseLeasesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
+func (*LeaseLeasesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
 
 func (m *LeaseLeasesResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2186,12 +2356,16 @@ type Member struct {
 	PeerURLs []string `protobuf:"bytes,3,rep,name=peerURLs" json:"peerURLs,omitempty"`
 	// clientURLs is the list of URLs the member exposes to clients for communication. If the member is not started, clientURLs will be empty.
 	ClientURLs []string `protobuf:"bytes,4,rep,name=clientURLs" json:"clientURLs,omitempty"`
+	// isLearner indicates if the member is raft learner.
+	IsLearner bool `protobuf:"varint,5,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// isWitness indicates if the member is raft witness.
+	IsWitness bool `protobuf:"varint,6,opt,name=isWitness,proto3" json:"isWitness,omitempty"`
 }
 
 func (m *Member) Reset()                    { *m = Member{} }
 func (m *Member) String() string            { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()               {}
-func (*Member) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
+func (*Member) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
 
 func (m *Member) GetID() uint64 {
 	if m != nil {
@@ -2221,15 +2395,33 @@ func (m *Member) GetClientURLs() []string {
 	return nil
 }
 
+func (m *Member) GetIsLearner() bool {
+	if m != nil {
+		return m.IsLearner
+	}
+	return false
+}
+
+func (m *Member) GetIsWitness() bool {
+	if m != nil {
+		return m.IsWitness
+	}
+	return false
+}
+
 type MemberAddRequest struct {
 	// peerURLs is the list of URLs the added member will use to communicate with the cluster.
 	PeerURLs []string `protobuf:"bytes,1,rep,name=peerURLs" json:"peerURLs,omitempty"`
+	// isLearner indicates if the added member is raft learner.
+	IsLearner bool `protobuf:"varint,2,opt,name=isLearner,proto3" json:"isLearner,omitempty"`
+	// isWitness indicates if the added member is raft witness.
+	IsWitness bool `protobuf:"varint,3,opt,name=isWitness,proto3" json:"isWitness,omitempty"`
 }
 
 func (m *MemberAddRequest) Reset()                    { *m = MemberAddRequest{} }
 func (m *MemberAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberAddRequest) ProtoMessage()               {}
-func (*MemberAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
+func (*MemberAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
 
 func (m *MemberAddRequest) GetPeerURLs() []string {
 	if m != nil {
@@ -2238,6 +2430,20 @@ func (m *MemberAddRequest) GetPeerURLs() []string {
 	return nil
 }
 
+func (m *MemberAddRequest) GetIsLearner() bool {
+	if m != nil {
+		return m.IsLearner
+	}
+	return false
+}
+
+func (m *MemberAddRequest) GetIsWitness() bool {
+	if m != nil {
+		return m.IsWitness
+	}
+	return false
+}
+
 type MemberAddResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	// member is the member information for the added member.
@@ -2249,7 +2455,7 @@ type MemberAddResponse struct {
 func (m *MemberAddResponse) Reset()                    { *m = MemberAddResponse{} }
 func (m *MemberAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberAddResponse) ProtoMessage()               {}
-func (*MemberAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
+func (*MemberAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
 
 func (m *MemberAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2280,7 +2486,7 @@ type MemberRemoveRequest struct {
 func (m *MemberRemoveRequest) Reset()                    { *m = MemberRemoveRequest{} }
 func (m *MemberRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberRemoveRequest) ProtoMessage()               {}
-func (*MemberRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+func (*MemberRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
 
 func (m *MemberRemoveRequest) GetID() uint64 {
 	if m != nil {
@@ -2298,7 +2504,7 @@ type MemberRemoveResponse struct {
 func (m *MemberRemoveResponse) Reset()                    { *m = MemberRemoveResponse{} }
 func (m *MemberRemoveResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberRemoveResponse) ProtoMessage()               {}
-func (*MemberRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+func (*MemberRemoveResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
 
 func (m *MemberRemoveResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2324,7 +2530,7 @@ type MemberUpdateRequest struct {
 func (m *MemberUpdateRequest) Reset()                    { *m = MemberUpdateRequest{} }
 func (m *MemberUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberUpdateRequest) ProtoMessage()               {}
-func (*MemberUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+func (*MemberUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
 
 func (m *MemberUpdateRequest) GetID() uint64 {
 	if m != nil {
@@ -2349,7 +2555,7 @@ type MemberUpdateResponse struct {
 func (m *MemberUpdateResponse) Reset()                    { *m = MemberUpdateResponse{} }
 func (m *MemberUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberUpdateResponse) ProtoMessage()               {}
-func (*MemberUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+func (*MemberUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
 
 func (m *MemberUpdateResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2371,7 +2577,7 @@ type MemberListRequest struct {
 func (m *MemberListRequest) Reset()                    { *m = MemberListRequest{} }
 func (m *MemberListRequest) String() string            { return proto.CompactTextString(m) }
 func (*MemberListRequest) ProtoMessage()               {}
-func (*MemberListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+func (*MemberListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
 
 type MemberListResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -2382,7 +2588,7 @@ type MemberListResponse struct {
 func (m *MemberListResponse) Reset()                    { *m = MemberListResponse{} }
 func (m *MemberListResponse) String() string            { return proto.CompactTextString(m) }
 func (*MemberListResponse) ProtoMessage()               {}
-func (*MemberListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+func (*MemberListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
 
 func (m *MemberListResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2398,13 +2604,55 @@ func (m *MemberListResponse) GetMembers() []*Member {
 	return nil
 }
 
+type MemberPromoteRequest struct {
+	// ID is the member ID of the member to promote.
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (m *MemberPromoteRequest) Reset()                    { *m = MemberPromoteRequest{} }
+func (m *MemberPromoteRequest) String() string            { return proto.CompactTextString(m) }
+func (*MemberPromoteRequest) ProtoMessage()               {}
+func (*MemberPromoteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+
+func (m *MemberPromoteRequest) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+type MemberPromoteResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// members is a list of all members after promoting the member.
+	Members []*Member `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
+}
+
+func (m *MemberPromoteResponse) Reset()                    { *m = MemberPromoteResponse{} }
+func (m *MemberPromoteResponse) String() string            { return proto.CompactTextString(m) }
+func (*MemberPromoteResponse) ProtoMessage()               {}
+func (*MemberPromoteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+
+func (m *MemberPromoteResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *MemberPromoteResponse) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 type DefragmentRequest struct {
 }
 
 func (m *DefragmentRequest) Reset()                    { *m = DefragmentRequest{} }
 func (m *DefragmentRequest) String() string            { return proto.CompactTextString(m) }
 func (*DefragmentRequest) ProtoMessage()               {}
-func (*DefragmentRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+func (*DefragmentRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
 
 type DefragmentResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -2413,7 +2661,7 @@ type DefragmentResponse struct {
 func (m *DefragmentResponse) Reset()                    { *m = DefragmentResponse{} }
 func (m *DefragmentResponse) String() string            { return proto.CompactTextString(m) }
 func (*DefragmentResponse) ProtoMessage()               {}
-func (*DefragmentResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+func (*DefragmentResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
 
 func (m *DefragmentResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2422,6 +2670,59 @@ func (m *DefragmentResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+type DefragmentProgressResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// copiedKeys is the number of keys copied to the defragmented database so far.
+	CopiedKeys int64 `protobuf:"varint,2,opt,name=copiedKeys,proto3" json:"copiedKeys,omitempty"`
+	// replayedWrites is the number of writes made during the copy that have
+	// been replayed onto the defragmented database so far.
+	ReplayedWrites int64 `protobuf:"varint,3,opt,name=replayedWrites,proto3" json:"replayedWrites,omitempty"`
+	// done is set on the last response, once the member uses the defragmented database.
+	Done bool `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	// dbSize is the size of the defragmented database in bytes, set on the last response.
+	DbSize int64 `protobuf:"varint,5,opt,name=dbSize,proto3" json:"dbSize,omitempty"`
+}
+
+func (m *DefragmentProgressResponse) Reset()                    { *m = DefragmentProgressResponse{} }
+func (m *DefragmentProgressResponse) String() string            { return proto.CompactTextString(m) }
+func (*DefragmentProgressResponse) ProtoMessage()               {}
+func (*DefragmentProgressResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
+
+func (m *DefragmentProgressResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DefragmentProgressResponse) GetCopiedKeys() int64 {
+	if m != nil {
+		return m.CopiedKeys
+	}
+	return 0
+}
+
+func (m *DefragmentProgressResponse) GetReplayedWrites() int64 {
+	if m != nil {
+		return m.ReplayedWrites
+	}
+	return 0
+}
+
+func (m *DefragmentProgressResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *DefragmentProgressResponse) GetDbSize() int64 {
+	if m != nil {
+		return m.DbSize
+	}
+	return 0
+}
+
 type MoveLeaderRequest struct {
 	// targetID is the node ID for the new leader.
 	TargetID uint64 `protobuf:"varint,1,opt,name=targetID,proto3" json:"targetID,omitempty"`
@@ -2430,7 +2731,7 @@ type MoveLeaderRequest struct {
 func (m *MoveLeaderRequest) Reset()                    { *m = MoveLeaderRequest{} }
 func (m *MoveLeaderRequest) String() string            { return proto.CompactTextString(m) }
 func (*MoveLeaderRequest) ProtoMessage()               {}
-func (*MoveLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+func (*MoveLeaderRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
 
 func (m *MoveLeaderRequest) GetTargetID() uint64 {
 	if m != nil {
@@ -2446,7 +2747,7 @@ type MoveLeaderResponse struct {
 func (m *MoveLeaderResponse) Reset()                    { *m = MoveLeaderResponse{} }
 func (m *MoveLeaderResponse) String() string            { return proto.CompactTextString(m) }
 func (*MoveLeaderResponse) ProtoMessage()               {}
-func (*MoveLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+func (*MoveLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
 
 func (m *MoveLeaderResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2470,7 +2771,7 @@ type AlarmRequest struct {
 func (m *AlarmRequest) Reset()                    { *m = AlarmRequest{} }
 func (m *AlarmRequest) String() string            { return proto.CompactTextString(m) }
 func (*AlarmRequest) ProtoMessage()               {}
-func (*AlarmRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
+func (*AlarmRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
 
 func (m *AlarmRequest) GetAction() AlarmRequest_AlarmAction {
 	if m != nil {
@@ -2503,7 +2804,7 @@ type AlarmMember struct {
 func (m *AlarmMember) Reset()                    { *m = AlarmMember{} }
 func (m *AlarmMember) String() string            { return proto.CompactTextString(m) }
 func (*AlarmMember) ProtoMessage()               {}
-func (*AlarmMember) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
+func (*AlarmMember) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
 
 func (m *AlarmMember) GetMemberID() uint64 {
 	if m != nil {
@@ -2528,7 +2829,7 @@ type AlarmResponse struct {
 func (m *AlarmResponse) Reset()                    { *m = AlarmResponse{} }
 func (m *AlarmResponse) String() string            { return proto.CompactTextString(m) }
 func (*AlarmResponse) ProtoMessage()               {}
-func (*AlarmResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
+func (*AlarmResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
 
 func (m *AlarmResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2550,7 +2851,7 @@ type StatusRequest struct {
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (m *StatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
 
 type StatusResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -2570,12 +2871,16 @@ type StatusResponse struct {
 	Errors []string `protobuf:"bytes,8,rep,name=errors" json:"errors,omitempty"`
 	// dbSizeInUse is the size of the backend database logically in use, in bytes, of the responding member.
 	DbSizeInUse int64 `protobuf:"varint,9,opt,name=dbSizeInUse,proto3" json:"dbSizeInUse,omitempty"`
+	// compactingRevision is the revision the responding member is compacting its backend to, or 0 if it is not compacting.
+	CompactingRevision int64 `protobuf:"varint,10,opt,name=compactingRevision,proto3" json:"compactingRevision,omitempty"`
+	// compactedUpToRevision is the revision the running compaction of the responding member has compacted its backend up to.
+	CompactedUpToRevision int64 `protobuf:"varint,11,opt,name=compactedUpToRevision,proto3" json:"compactedUpToRevision,omitempty"`
 }
 
 func (m *StatusResponse) Reset()                    { *m = StatusResponse{} }
 func (m *StatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()               {}
-func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
+func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
 
 func (m *StatusResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2640,13 +2945,27 @@ func (m *StatusResponse) GetDbSizeInUse() int64 {
 	return 0
 }
 
+func (m *StatusResponse) GetCompactingRevision() int64 {
+	if m != nil {
+		return m.CompactingRevision
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetCompactedUpToRevision() int64 {
+	if m != nil {
+		return m.CompactedUpToRevision
+	}
+	return 0
+}
+
 type AuthEnableRequest struct {
 }
 
 func (m *AuthEnableRequest) Reset()                    { *m = AuthEnableRequest{} }
 func (m *AuthEnableRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthEnableRequest) ProtoMessage()               {}
-func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
+func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
 
 type AuthDisableRequest struct {
 }
@@ -2654,7 +2973,7 @@ type AuthDisableRequest struct {
 func (m *AuthDisableRequest) Reset()                    { *m = AuthDisableRequest{} }
 func (m *AuthDisableRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthDisableRequest) ProtoMessage()               {}
-func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
+func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
 
 type AuthenticateRequest struct {
 	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2664,7 +2983,7 @@ type AuthenticateRequest struct {
 func (m *AuthenticateRequest) Reset()                    { *m = AuthenticateRequest{} }
 func (m *AuthenticateRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthenticateRequest) ProtoMessage()               {}
-func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
 
 func (m *AuthenticateRequest) GetName() string {
 	if m != nil {
@@ -2688,7 +3007,7 @@ type AuthUserAddRequest struct {
 func (m *AuthUserAddRequest) Reset()                    { *m = AuthUserAddRequest{} }
 func (m *AuthUserAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserAddRequest) ProtoMessage()               {}
-func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
+func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
 
 func (m *AuthUserAddRequest) GetName() string {
 	if m != nil {
@@ -2711,7 +3030,7 @@ type AuthUserGetRequest struct {
 func (m *AuthUserGetRequest) Reset()                    { *m = AuthUserGetRequest{} }
 func (m *AuthUserGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGetRequest) ProtoMessage()               {}
-func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
+func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
 
 func (m *AuthUserGetRequest) GetName() string {
 	if m != nil {
@@ -2728,7 +3047,7 @@ type AuthUserDeleteRequest struct {
 func (m *AuthUserDeleteRequest) Reset()                    { *m = AuthUserDeleteRequest{} }
 func (m *AuthUserDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserDeleteRequest) ProtoMessage()               {}
-func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
+func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
 
 func (m *AuthUserDeleteRequest) GetName() string {
 	if m != nil {
@@ -2748,7 +3067,7 @@ func (m *AuthUserChangePasswordRequest) Reset()         { *m = AuthUserChangePas
 func (m *AuthUserChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordRequest) ProtoMessage()    {}
 func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{63}
+	return fileDescriptorRpc, []int{67}
 }
 
 func (m *AuthUserChangePasswordRequest) GetName() string {
@@ -2775,7 +3094,7 @@ type AuthUserGrantRoleRequest struct {
 func (m *AuthUserGrantRoleRequest) Reset()                    { *m = AuthUserGrantRoleRequest{} }
 func (m *AuthUserGrantRoleRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGrantRoleRequest) ProtoMessage()               {}
-func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
+func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
 
 func (m *AuthUserGrantRoleRequest) GetUser() string {
 	if m != nil {
@@ -2799,7 +3118,7 @@ type AuthUserRevokeRoleRequest struct {
 func (m *AuthUserRevokeRoleRequest) Reset()                    { *m = AuthUserRevokeRoleRequest{} }
 func (m *AuthUserRevokeRoleRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserRevokeRoleRequest) ProtoMessage()               {}
-func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
+func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
 
 func (m *AuthUserRevokeRoleRequest) GetName() string {
 	if m != nil {
@@ -2823,7 +3142,7 @@ type AuthRoleAddRequest struct {
 func (m *AuthRoleAddRequest) Reset()                    { *m = AuthRoleAddRequest{} }
 func (m *AuthRoleAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleAddRequest) ProtoMessage()               {}
-func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
+func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
 
 func (m *AuthRoleAddRequest) GetName() string {
 	if m != nil {
@@ -2839,7 +3158,7 @@ type AuthRoleGetRequest struct {
 func (m *AuthRoleGetRequest) Reset()                    { *m = AuthRoleGetRequest{} }
 func (m *AuthRoleGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGetRequest) ProtoMessage()               {}
-func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{67} }
+func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{71} }
 
 func (m *AuthRoleGetRequest) GetRole() string {
 	if m != nil {
@@ -2854,7 +3173,7 @@ type AuthUserListRequest struct {
 func (m *AuthUserListRequest) Reset()                    { *m = AuthUserListRequest{} }
 func (m *AuthUserListRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserListRequest) ProtoMessage()               {}
-func (*AuthUserListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
+func (*AuthUserListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{72} }
 
 type AuthRoleListRequest struct {
 }
@@ -2862,7 +3181,7 @@ type AuthRoleListRequest struct {
 func (m *AuthRoleListRequest) Reset()                    { *m = AuthRoleListRequest{} }
 func (m *AuthRoleListRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleListRequest) ProtoMessage()               {}
-func (*AuthRoleListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
+func (*AuthRoleListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
 
 type AuthRoleDeleteRequest struct {
 	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -2871,7 +3190,7 @@ type AuthRoleDeleteRequest struct {
 func (m *AuthRoleDeleteRequest) Reset()                    { *m = AuthRoleDeleteRequest{} }
 func (m *AuthRoleDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteRequest) ProtoMessage()               {}
-func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
+func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
 
 func (m *AuthRoleDeleteRequest) GetRole() string {
 	if m != nil {
@@ -2891,7 +3210,7 @@ func (m *AuthRoleGrantPermissionRequest) Reset()         { *m = AuthRoleGrantPer
 func (m *AuthRoleGrantPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleGrantPermissionRequest) ProtoMessage()    {}
 func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{71}
+	return fileDescriptorRpc, []int{75}
 }
 
 func (m *AuthRoleGrantPermissionRequest) GetName() string {
@@ -2918,7 +3237,7 @@ func (m *AuthRoleRevokePermissionRequest) Reset()         { *m = AuthRoleRevokeP
 func (m *AuthRoleRevokePermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleRevokePermissionRequest) ProtoMessage()    {}
 func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{72}
+	return fileDescriptorRpc, []int{76}
 }
 
 func (m *AuthRoleRevokePermissionRequest) GetRole() string {
@@ -2949,7 +3268,7 @@ type AuthEnableResponse struct {
 func (m *AuthEnableResponse) Reset()                    { *m = AuthEnableResponse{} }
 func (m *AuthEnableResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthEnableResponse) ProtoMessage()               {}
-func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
+func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
 
 func (m *AuthEnableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2965,7 +3284,7 @@ type AuthDisableResponse struct {
 func (m *AuthDisableResponse) Reset()                    { *m = AuthDisableResponse{} }
 func (m *AuthDisableResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthDisableResponse) ProtoMessage()               {}
-func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
+func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
 
 func (m *AuthDisableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2983,7 +3302,7 @@ type AuthenticateResponse struct {
 func (m *AuthenticateResponse) Reset()                    { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()               {}
-func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{75} }
+func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{79} }
 
 func (m *AuthenticateResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3006,7 +3325,7 @@ type AuthUserAddResponse struct {
 func (m *AuthUserAddResponse) Reset()                    { *m = AuthUserAddResponse{} }
 func (m *AuthUserAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserAddResponse) ProtoMessage()               {}
-func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
+func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{80} }
 
 func (m *AuthUserAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3023,7 +3342,7 @@ type AuthUserGetResponse struct {
 func (m *AuthUserGetResponse) Reset()                    { *m = AuthUserGetResponse{} }
 func (m *AuthUserGetResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGetResponse) ProtoMessage()               {}
-func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
+func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{81} }
 
 func (m *AuthUserGetResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3046,7 +3365,7 @@ type AuthUserDeleteResponse struct {
 func (m *AuthUserDeleteResponse) Reset()                    { *m = AuthUserDeleteResponse{} }
 func (m *AuthUserDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserDeleteResponse) ProtoMessage()               {}
-func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
+func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{82} }
 
 func (m *AuthUserDeleteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3063,7 +3382,7 @@ func (m *AuthUserChangePasswordResponse) Reset()         { *m = AuthUserChangePa
 func (m *AuthUserChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordResponse) ProtoMessage()    {}
 func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{79}
+	return fileDescriptorRpc, []int{83}
 }
 
 func (m *AuthUserChangePasswordResponse) GetHeader() *ResponseHeader {
@@ -3080,7 +3399,7 @@ type AuthUserGrantRoleResponse struct {
 func (m *AuthUserGrantRoleResponse) Reset()                    { *m = AuthUserGrantRoleResponse{} }
 func (m *AuthUserGrantRoleResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGrantRoleResponse) ProtoMessage()               {}
-func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{80} }
+func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{84} }
 
 func (m *AuthUserGrantRoleResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3096,7 +3415,7 @@ type AuthUserRevokeRoleResponse struct {
 func (m *AuthUserRevokeRoleResponse) Reset()                    { *m = AuthUserRevokeRoleResponse{} }
 func (m *AuthUserRevokeRoleResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserRevokeRoleResponse) ProtoMessage()               {}
-func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{81} }
+func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{85} }
 
 func (m *AuthUserRevokeRoleResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3112,7 +3431,7 @@ type AuthRoleAddResponse struct {
 func (m *AuthRoleAddResponse) Reset()                    { *m = AuthRoleAddResponse{} }
 func (m *AuthRoleAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleAddResponse) ProtoMessage()               {}
-func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{82} }
+func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{86} }
 
 func (m *AuthRoleAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3129,7 +3448,7 @@ type AuthRoleGetResponse struct {
 func (m *AuthRoleGetResponse) Reset()                    { *m = AuthRoleGetResponse{} }
 func (m *AuthRoleGetResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGetResponse) ProtoMessage()               {}
-func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{83} }
+func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{87} }
 
 func (m *AuthRoleGetResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3153,7 +3472,7 @@ type AuthRoleListResponse struct {
 func (m *AuthRoleListResponse) Reset()                    { *m = AuthRoleListResponse{} }
 func (m *AuthRoleListResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleListResponse) ProtoMessage()               {}
-func (*AuthRoleListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{84} }
+func (*AuthRoleListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{88} }
 
 func (m *AuthRoleListResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3177,7 +3496,7 @@ type AuthUserListResponse struct {
 func (m *AuthUserListResponse) Reset()                    { *m = AuthUserListResponse{} }
 func (m *AuthUserListResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserListResponse) ProtoMessage()               {}
-func (*AuthUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{85} }
+func (*AuthUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{89} }
 
 func (m *AuthUserListResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3200,7 +3519,7 @@ type AuthRoleDeleteResponse struct {
 func (m *AuthRoleDeleteResponse) Reset()                    { *m = AuthRoleDeleteResponse{} }
 func (m *AuthRoleDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteResponse) ProtoMessage()               {}
-func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{86} }
+func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{90} }
 
 func (m *AuthRoleDeleteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3217,7 +3536,7 @@ func (m *AuthRoleGrantPermissionResponse) Reset()         { *m = AuthRoleGrantPe
 func (m *AuthRoleGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleGrantPermissionResponse) ProtoMessage()    {}
 func (*AuthRoleGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{87}
+	return fileDescriptorRpc, []int{91}
 }
 
 func (m *AuthRoleGrantPermissionResponse) GetHeader() *ResponseHeader {
@@ -3235,7 +3554,7 @@ func (m *AuthRoleRevokePermissionResponse) Reset()         { *m = AuthRoleRevoke
 func (m *AuthRoleRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleRevokePermissionResponse) ProtoMessage()    {}
 func (*AuthRoleRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{88}
+	return fileDescriptorRpc, []int{92}
 }
 
 func (m *AuthRoleRevokePermissionResponse) GetHeader() *ResponseHeader {
@@ -3259,6 +3578,7 @@ func init() {
 	proto.RegisterType((*TxnRequest)(nil), "etcdserverpb.TxnRequest")
 	proto.RegisterType((*TxnResponse)(nil), "etcdserverpb.TxnResponse")
 	proto.RegisterType((*CompactionRequest)(nil), "etcdserverpb.CompactionRequest")
+	proto.RegisterType((*CompactionRetention)(nil), "etcdserverpb.CompactionRetention")
 	proto.RegisterType((*CompactionResponse)(nil), "etcdserverpb.CompactionResponse")
 	proto.RegisterType((*HashRequest)(nil), "etcdserverpb.HashRequest")
 	proto.RegisterType((*HashKVRequest)(nil), "etcdserverpb.HashKVRequest")
@@ -3294,8 +3614,11 @@ func init() {
 	proto.RegisterType((*MemberUpdateResponse)(nil), "etcdserverpb.MemberUpdateResponse")
 	proto.RegisterType((*MemberListRequest)(nil), "etcdserverpb.MemberListRequest")
 	proto.RegisterType((*MemberListResponse)(nil), "etcdserverpb.MemberListResponse")
+	proto.RegisterType((*MemberPromoteRequest)(nil), "etcdserverpb.MemberPromoteRequest")
+	proto.RegisterType((*MemberPromoteResponse)(nil), "etcdserverpb.MemberPromoteResponse")
 	proto.RegisterType((*DefragmentRequest)(nil), "etcdserverpb.DefragmentRequest")
 	proto.RegisterType((*DefragmentResponse)(nil), "etcdserverpb.DefragmentResponse")
+	proto.RegisterType((*DefragmentProgressResponse)(nil), "etcdserverpb.DefragmentProgressResponse")
 	proto.RegisterType((*MoveLeaderRequest)(nil), "etcdserverpb.MoveLeaderRequest")
 	proto.RegisterType((*MoveLeaderResponse)(nil), "etcdserverpb.MoveLeaderResponse")
 	proto.RegisterType((*AlarmRequest)(nil), "etcdserverpb.AlarmRequest")
@@ -3938,6 +4261,8 @@ type ClusterClient interface {
 	MemberUpdate(ctx context.Context, in *MemberUpdateRequest, opts ...grpc.CallOption) (*MemberUpdateResponse, error)
 	// MemberList lists all the members in the cluster.
 	MemberList(ctx context.Context, in *MemberListRequest, opts ...grpc.CallOption) (*MemberListResponse, error)
+	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
+	MemberPromote(ctx context.Context, in *MemberPromoteRequest, opts ...grpc.CallOption) (*MemberPromoteResponse, error)
 }
 
 type clusterClient struct {
@@ -3984,6 +4309,15 @@ func (c *clusterClient) MemberList(ctx context.Context, in *MemberListRequest, o
 	return out, nil
 }
 
+func (c *clusterClient) MemberPromote(ctx context.Context, in *MemberPromoteRequest, opts ...grpc.CallOption) (*MemberPromoteResponse, error) {
+	out := new(MemberPromoteResponse)
+	err := grpc.Invoke(ctx, "/etcdserverpb.Cluster/MemberPromote", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cluster service
 
 type ClusterServer interface {
@@ -3995,6 +4329,8 @@ type ClusterServer interface {
 	MemberUpdate(context.Context, *MemberUpdateRequest) (*MemberUpdateResponse, error)
 	// MemberList lists all the members in the cluster.
 	MemberList(context.Context, *MemberListRequest) (*MemberListResponse, error)
+	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
+	MemberPromote(context.Context, *MemberPromoteRequest) (*MemberPromoteResponse, error)
 }
 
 func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
@@ -4073,6 +4409,24 @@ func _Cluster_MemberList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_MemberPromote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberPromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).MemberPromote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Cluster/MemberPromote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).MemberPromote(ctx, req.(*MemberPromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cluster_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Cluster",
 	HandlerType: (*ClusterServer)(nil),
@@ -4093,6 +4447,10 @@ var _Cluster_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MemberList",
 			Handler:    _Cluster_MemberList_Handler,
 		},
+		{
+			MethodName: "MemberPromote",
+			Handler:    _Cluster_MemberPromote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -4121,6 +4479,9 @@ type MaintenanceClient interface {
 	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (Maintenance_SnapshotClient, error)
 	// MoveLeader requests current leader node to transfer its leadership to transferee.
 	MoveLeader(ctx context.Context, in *MoveLeaderRequest, opts ...grpc.CallOption) (*MoveLeaderResponse, error)
+	// DefragmentOnline defragments a member's backend database like Defragment,
+	// while the member keeps serving reads and writes, and streams its progress.
+	DefragmentOnline(ctx context.Context, in *DefragmentRequest, opts ...grpc.CallOption) (Maintenance_DefragmentOnlineClient, error)
 }
 
 type maintenanceClient struct {
@@ -4217,6 +4578,38 @@ func (c *maintenanceClient) MoveLeader(ctx context.Context, in *MoveLeaderReques
 	return out, nil
 }
 
+func (c *maintenanceClient) DefragmentOnline(ctx context.Context, in *DefragmentRequest, opts ...grpc.CallOption) (Maintenance_DefragmentOnlineClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Maintenance_serviceDesc.Streams[1], c.cc, "/etcdserverpb.Maintenance/DefragmentOnline", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &maintenanceDefragmentOnlineClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Maintenance_DefragmentOnlineClient interface {
+	Recv() (*DefragmentProgressResponse, error)
+	grpc.ClientStream
+}
+
+type maintenanceDefragmentOnlineClient struct {
+	grpc.ClientStream
+}
+
+func (x *maintenanceDefragmentOnlineClient) Recv() (*DefragmentProgressResponse, error) {
+	m := new(DefragmentProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Maintenance service
 
 type MaintenanceServer interface {
@@ -4240,6 +4633,9 @@ type MaintenanceServer interface {
 	Snapshot(*SnapshotRequest, Maintenance_SnapshotServer) error
 	// MoveLeader requests current leader node to transfer its leadership to transferee.
 	MoveLeader(context.Context, *MoveLeaderRequest) (*MoveLeaderResponse, error)
+	// DefragmentOnline defragments a member's backend database like Defragment,
+	// while the member keeps serving reads and writes, and streams its progress.
+	DefragmentOnline(*DefragmentRequest, Maintenance_DefragmentOnlineServer) error
 }
 
 func RegisterMaintenanceServer(s *grpc.Server, srv MaintenanceServer) {
@@ -4375,6 +4771,27 @@ func _Maintenance_MoveLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Maintenance_DefragmentOnline_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DefragmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MaintenanceServer).DefragmentOnline(m, &maintenanceDefragmentOnlineServer{stream})
+}
+
+type Maintenance_DefragmentOnlineServer interface {
+	Send(*DefragmentProgressResponse) error
+	grpc.ServerStream
+}
+
+type maintenanceDefragmentOnlineServer struct {
+	grpc.ServerStream
+}
+
+func (x *maintenanceDefragmentOnlineServer) Send(m *DefragmentProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Maintenance_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Maintenance",
 	HandlerType: (*MaintenanceServer)(nil),
@@ -4410,6 +4827,11 @@ var _Maintenance_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Maintenance_Snapshot_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DefragmentOnline",
+			Handler:       _Maintenance_DefragmentOnline_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -5140,6 +5562,29 @@ func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.MaxCreateRevision))
 	}
+	if m.MaxStaleness != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.MaxStaleness))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.CountFiltered {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.CountFiltered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -5195,6 +5640,12 @@ func (m *RangeResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Count))
 	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
 	return i, nil
 }
 
@@ -5769,10 +6220,22 @@ func (m *CompactionRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Retain) > 0 {
+		for _, msg := range m.Retain {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *CompactionResponse) Marshal() (dAtA []byte, err error) {
+func (m *CompactionRetention) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5782,20 +6245,49 @@ func (m *CompactionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CompactionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactionRetention) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.Prefix) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n17, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Revision))
+	}
+	return i, nil
+}
+
+func (m *CompactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
+		n17, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	return i, nil
 }
@@ -6120,6 +6612,45 @@ func (m *WatchCreateRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.KeyGlob) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.KeyGlob)))
+		i += copy(dAtA[i:], m.KeyGlob)
+	}
+	if len(m.KeyRegex) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.KeyRegex)))
+		i += copy(dAtA[i:], m.KeyRegex)
+	}
+	if len(m.ValuePrefix) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ValuePrefix)))
+		i += copy(dAtA[i:], m.ValuePrefix)
+	}
+	if m.Lease != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Lease))
+	}
+	if len(m.ResumeToken) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ResumeToken)))
+		i += copy(dAtA[i:], m.ResumeToken)
+	}
+	if m.InitialState {
+		dAtA[i] = 0x70
+		i++
+		if m.InitialState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -6235,6 +6766,12 @@ func (m *WatchResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.ResumeToken) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ResumeToken)))
+		i += copy(dAtA[i:], m.ResumeToken)
+	}
 	if len(m.Events) > 0 {
 		for _, msg := range m.Events {
 			dAtA[i] = 0x5a
@@ -6741,6 +7278,26 @@ func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.IsLearner {
+		dAtA[i] = 0x28
+		i++
+		if m.IsLearner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.IsWitness {
+		dAtA[i] = 0x30
+		i++
+		if m.IsWitness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -6774,6 +7331,26 @@ func (m *MemberAddRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.IsLearner {
+		dAtA[i] = 0x10
+		i++
+		if m.IsLearner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.IsWitness {
+		dAtA[i] = 0x18
+		i++
+		if m.IsWitness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -7026,6 +7603,69 @@ func (m *MemberListResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MemberPromoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberPromoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.ID))
+	}
+	return i, nil
+}
+
+func (m *MemberPromoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberPromoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
+		n39, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpc(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *DefragmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7063,11 +7703,64 @@ func (m *DefragmentResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n39, err := m.Header.MarshalTo(dAtA[i:])
+		n40, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
+	}
+	return i, nil
+}
+
+func (m *DefragmentProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DefragmentProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
+		n41, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.CopiedKeys != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.CopiedKeys))
+	}
+	if m.ReplayedWrites != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.ReplayedWrites))
+	}
+	if m.Done {
+		dAtA[i] = 0x20
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DbSize != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.DbSize))
 	}
 	return i, nil
 }
@@ -7114,11 +7807,11 @@ func (m *MoveLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n40, err := m.Header.MarshalTo(dAtA[i:])
+		n42, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n42
 	}
 	return i, nil
 }
@@ -7203,11 +7896,11 @@ func (m *AlarmResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n41, err := m.Header.MarshalTo(dAtA[i:])
+		n43, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n43
 	}
 	if len(m.Alarms) > 0 {
 		for _, msg := range m.Alarms {
@@ -7261,11 +7954,11 @@ func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n42, err := m.Header.MarshalTo(dAtA[i:])
+		n44, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n44
 	}
 	if len(m.Version) > 0 {
 		dAtA[i] = 0x12
@@ -7318,6 +8011,16 @@ func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.DbSizeInUse))
 	}
+	if m.CompactingRevision != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.CompactingRevision))
+	}
+	if m.CompactedUpToRevision != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.CompactedUpToRevision))
+	}
 	return i, nil
 }
 
@@ -7688,11 +8391,11 @@ func (m *AuthRoleGrantPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Perm.Size()))
-		n43, err := m.Perm.MarshalTo(dAtA[i:])
+		n45, err := m.Perm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	return i, nil
 }
@@ -7752,11 +8455,11 @@ func (m *AuthEnableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n44, err := m.Header.MarshalTo(dAtA[i:])
+		n46, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n46
 	}
 	return i, nil
 }
@@ -7780,11 +8483,11 @@ func (m *AuthDisableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n45, err := m.Header.MarshalTo(dAtA[i:])
+		n47, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	return i, nil
 }
@@ -7808,11 +8511,11 @@ func (m *AuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n46, err := m.Header.MarshalTo(dAtA[i:])
+		n48, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n48
 	}
 	if len(m.Token) > 0 {
 		dAtA[i] = 0x12
@@ -7842,11 +8545,11 @@ func (m *AuthUserAddResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n47, err := m.Header.MarshalTo(dAtA[i:])
+		n49, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n49
 	}
 	return i, nil
 }
@@ -7870,11 +8573,11 @@ func (m *AuthUserGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n48, err := m.Header.MarshalTo(dAtA[i:])
+		n50, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n50
 	}
 	if len(m.Roles) > 0 {
 		for _, s := range m.Roles {
@@ -7913,11 +8616,11 @@ func (m *AuthUserDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n49, err := m.Header.MarshalTo(dAtA[i:])
+		n51, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n51
 	}
 	return i, nil
 }
@@ -7941,11 +8644,11 @@ func (m *AuthUserChangePasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n50, err := m.Header.MarshalTo(dAtA[i:])
+		n52, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n52
 	}
 	return i, nil
 }
@@ -7969,11 +8672,11 @@ func (m *AuthUserGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n51, err := m.Header.MarshalTo(dAtA[i:])
+		n53, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n53
 	}
 	return i, nil
 }
@@ -7997,11 +8700,11 @@ func (m *AuthUserRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n52, err := m.Header.MarshalTo(dAtA[i:])
+		n54, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n54
 	}
 	return i, nil
 }
@@ -8025,11 +8728,11 @@ func (m *AuthRoleAddResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n53, err := m.Header.MarshalTo(dAtA[i:])
+		n55, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n55
 	}
 	return i, nil
 }
@@ -8053,11 +8756,11 @@ func (m *AuthRoleGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n54, err := m.Header.MarshalTo(dAtA[i:])
+		n56, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n56
 	}
 	if len(m.Perm) > 0 {
 		for _, msg := range m.Perm {
@@ -8093,11 +8796,11 @@ func (m *AuthRoleListResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n55, err := m.Header.MarshalTo(dAtA[i:])
+		n57, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n57
 	}
 	if len(m.Roles) > 0 {
 		for _, s := range m.Roles {
@@ -8136,11 +8839,11 @@ func (m *AuthUserListResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n56, err := m.Header.MarshalTo(dAtA[i:])
+		n58, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n58
 	}
 	if len(m.Users) > 0 {
 		for _, s := range m.Users {
@@ -8179,11 +8882,11 @@ func (m *AuthRoleDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n57, err := m.Header.MarshalTo(dAtA[i:])
+		n59, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n59
 	}
 	return i, nil
 }
@@ -8207,11 +8910,11 @@ func (m *AuthRoleGrantPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n58, err := m.Header.MarshalTo(dAtA[i:])
+		n60, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n60
 	}
 	return i, nil
 }
@@ -8235,11 +8938,11 @@ func (m *AuthRoleRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n59, err := m.Header.MarshalTo(dAtA[i:])
+		n61, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n61
 	}
 	return i, nil
 }
@@ -8315,6 +9018,16 @@ func (m *RangeRequest) Size() (n int) {
 	if m.MaxCreateRevision != 0 {
 		n += 1 + sovRpc(uint64(m.MaxCreateRevision))
 	}
+	if m.MaxStaleness != 0 {
+		n += 1 + sovRpc(uint64(m.MaxStaleness))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.CountFiltered {
+		n += 3
+	}
 	return n
 }
 
@@ -8337,6 +9050,10 @@ func (m *RangeResponse) Size() (n int) {
 	if m.Count != 0 {
 		n += 1 + sovRpc(uint64(m.Count))
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	return n
 }
 
@@ -8614,6 +9331,25 @@ func (m *CompactionRequest) Size() (n int) {
 	if m.Physical {
 		n += 2
 	}
+	if len(m.Retain) > 0 {
+		for _, e := range m.Retain {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactionRetention) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpc(uint64(m.Revision))
+	}
 	return n
 }
 
@@ -8763,6 +9499,28 @@ func (m *WatchCreateRequest) Size() (n int) {
 	if m.Fragment {
 		n += 2
 	}
+	l = len(m.KeyGlob)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.KeyRegex)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.ValuePrefix)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovRpc(uint64(m.Lease))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.InitialState {
+		n += 2
+	}
 	return n
 }
 
@@ -8807,6 +9565,10 @@ func (m *WatchResponse) Size() (n int) {
 	if m.Fragment {
 		n += 2
 	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	if len(m.Events) > 0 {
 		for _, e := range m.Events {
 			l = e.Size()
@@ -9016,6 +9778,12 @@ func (m *Member) Size() (n int) {
 			n += 1 + l + sovRpc(uint64(l))
 		}
 	}
+	if m.IsLearner {
+		n += 2
+	}
+	if m.IsWitness {
+		n += 2
+	}
 	return n
 }
 
@@ -9028,6 +9796,12 @@ func (m *MemberAddRequest) Size() (n int) {
 			n += 1 + l + sovRpc(uint64(l))
 		}
 	}
+	if m.IsLearner {
+		n += 2
+	}
+	if m.IsWitness {
+		n += 2
+	}
 	return n
 }
 
@@ -9129,6 +9903,31 @@ func (m *MemberListResponse) Size() (n int) {
 	return n
 }
 
+func (m *MemberPromoteRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpc(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MemberPromoteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DefragmentRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -9145,6 +9944,28 @@ func (m *DefragmentResponse) Size() (n int) {
 	return n
 }
 
+func (m *DefragmentProgressResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.CopiedKeys != 0 {
+		n += 1 + sovRpc(uint64(m.CopiedKeys))
+	}
+	if m.ReplayedWrites != 0 {
+		n += 1 + sovRpc(uint64(m.ReplayedWrites))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.DbSize != 0 {
+		n += 1 + sovRpc(uint64(m.DbSize))
+	}
+	return n
+}
+
 func (m *MoveLeaderRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -9248,6 +10069,12 @@ func (m *StatusResponse) Size() (n int) {
 	if m.DbSizeInUse != 0 {
 		n += 1 + sovRpc(uint64(m.DbSizeInUse))
 	}
+	if m.CompactingRevision != 0 {
+		n += 1 + sovRpc(uint64(m.CompactingRevision))
+	}
+	if m.CompactedUpToRevision != 0 {
+		n += 1 + sovRpc(uint64(m.CompactedUpToRevision))
+	}
 	return n
 }
 
@@ -10057,6 +10884,76 @@ func (m *RangeRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = append(m.PageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.PageToken == nil {
+				m.PageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountFiltered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountFiltered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -10210,6 +11107,37 @@ func (m *RangeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -11764,59 +12692,9 @@ func (m *CompactionRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Physical = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CompactionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11840,10 +12718,8 @@ func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Retain = append(m.Retain, &CompactionRetention{})
+			if err := m.Retain[len(m.Retain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11868,7 +12744,7 @@ func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HashRequest) Unmarshal(dAtA []byte) error {
+func (m *CompactionRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11891,14 +12767,197 @@ func (m *HashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactionRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactionRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -12790,61 +13849,11 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Fragment = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WatchCancelRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyGlob", wireType)
 			}
-			m.WatchId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpc
@@ -12854,27 +13863,236 @@ func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WatchId |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			m.KeyGlob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuePrefix = append(m.ValuePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValuePrefix == nil {
+				m.ValuePrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InitialState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchId", wireType)
+			}
+			m.WatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchId |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -13119,6 +14337,37 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Fragment = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
@@ -14629,17 +15878,57 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientURLs = append(m.ClientURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpc
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLearner = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWitness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsWitness = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -14708,6 +15997,46 @@ func (m *MemberAddRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLearner = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsWitness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsWitness = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -14945,7 +16274,383 @@ func (m *MemberRemoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberRemoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14968,10 +16673,10 @@ func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberRemoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15059,7 +16764,7 @@ func (m *MemberRemoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *MemberPromoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15082,10 +16787,10 @@ func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberPromoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberPromoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15107,35 +16812,6 @@ func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -15157,7 +16833,7 @@ func (m *MemberUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberPromoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15180,10 +16856,10 @@ func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberUpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberPromoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberPromoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15271,7 +16947,7 @@ func (m *MemberUpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
+func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15294,10 +16970,10 @@ func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15321,7 +16997,7 @@ func (m *MemberListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
+func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15344,10 +17020,10 @@ func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15383,87 +17059,6 @@ func (m *MemberListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DefragmentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefragmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -15485,7 +17080,7 @@ func (m *DefragmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
+func (m *DefragmentProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15508,10 +17103,10 @@ func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DefragmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefragmentProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefragmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefragmentProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15547,6 +17142,83 @@ func (m *DefragmentResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CopiedKeys", wireType)
+			}
+			m.CopiedKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CopiedKeys |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayedWrites", wireType)
+			}
+			m.ReplayedWrites = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplayedWrites |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbSize", wireType)
+			}
+			m.DbSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -16313,6 +17985,44 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactingRevision", wireType)
+			}
+			m.CompactingRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactingRevision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactedUpToRevision", wireType)
+			}
+			m.CompactedUpToRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactedUpToRevision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -19305,245 +21015,271 @@ var (
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 3836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xdd, 0x6f, 0x23, 0xc9,
-	0x71, 0xd7, 0x90, 0xe2, 0x57, 0xf1, 0x43, 0x54, 0xeb, 0x63, 0x29, 0xee, 0xae, 0x56, 0xd7, 0xbb,
-	0x7b, 0xab, 0xdb, 0xbd, 0x13, 0x6d, 0xd9, 0x4e, 0x80, 0x4d, 0xe2, 0x58, 0x2b, 0xf1, 0x56, 0x3a,
-	0x69, 0x45, 0xdd, 0x88, 0xda, 0xfb, 0x80, 0x11, 0x61, 0x44, 0xf6, 0x4a, 0x13, 0x91, 0x33, 0xf4,
-	0xcc, 0x90, 0x2b, 0x5d, 0x82, 0x38, 0x30, 0x9c, 0x00, 0xc9, 0xa3, 0x0d, 0x04, 0xc9, 0x43, 0x9e,
-	0x82, 0x20, 0xf0, 0x43, 0x80, 0xbc, 0x05, 0xc8, 0x5f, 0x90, 0xb7, 0x24, 0xc8, 0x3f, 0x10, 0x5c,
-	0xfc, 0x92, 0xff, 0x22, 0xe8, 0xaf, 0x99, 0x9e, 0x2f, 0x69, 0x6d, 0xfa, 0xfc, 0x22, 0x4d, 0x57,
-	0x57, 0x57, 0x55, 0x57, 0x77, 0x57, 0x55, 0xff, 0x66, 0x08, 0x25, 0x67, 0xd4, 0xdb, 0x18, 0x39,
-	0xb6, 0x67, 0xa3, 0x0a, 0xf1, 0x7a, 0x7d, 0x97, 0x38, 0x13, 0xe2, 0x8c, 0xce, 0x9a, 0x8b, 0xe7,
-	0xf6, 0xb9, 0xcd, 0x3a, 0x5a, 0xf4, 0x89, 0xf3, 0x34, 0x57, 0x28, 0x4f, 0x6b, 0x38, 0xe9, 0xf5,
-	0xd8, 0x9f, 0xd1, 0x59, 0xeb, 0x72, 0x22, 0xba, 0xee, 0xb2, 0x2e, 0x63, 0xec, 0x5d, 0xb0, 0x3f,
-	0xa3, 0x33, 0xf6, 0x4f, 0x74, 0xde, 0x3b, 0xb7, 0xed, 0xf3, 0x01, 0x69, 0x19, 0x23, 0xb3, 0x65,
-	0x58, 0x96, 0xed, 0x19, 0x9e, 0x69, 0x5b, 0x2e, 0xef, 0xc5, 0x7f, 0xa1, 0x41, 0x4d, 0x27, 0xee,
-	0xc8, 0xb6, 0x5c, 0xb2, 0x4b, 0x8c, 0x3e, 0x71, 0xd0, 0x7d, 0x80, 0xde, 0x60, 0xec, 0x7a, 0xc4,
-	0x39, 0x35, 0xfb, 0x0d, 0x6d, 0x4d, 0x5b, 0x9f, 0xd5, 0x4b, 0x82, 0xb2, 0xd7, 0x47, 0x77, 0xa1,
-	0x34, 0x24, 0xc3, 0x33, 0xde, 0x9b, 0x61, 0xbd, 0x45, 0x4e, 0xd8, 0xeb, 0xa3, 0x26, 0x14, 0x1d,
-	0x32, 0x31, 0x5d, 0xd3, 0xb6, 0x1a, 0xd9, 0x35, 0x6d, 0x3d, 0xab, 0xfb, 0x6d, 0x3a, 0xd0, 0x31,
-	0xde, 0x78, 0xa7, 0x1e, 0x71, 0x86, 0x8d, 0x59, 0x3e, 0x90, 0x12, 0xba, 0xc4, 0x19, 0xe2, 0x9f,
-	0xe6, 0xa0, 0xa2, 0x1b, 0xd6, 0x39, 0xd1, 0xc9, 0x8f, 0xc6, 0xc4, 0xf5, 0x50, 0x1d, 0xb2, 0x97,
-	0xe4, 0x9a, 0xa9, 0xaf, 0xe8, 0xf4, 0x91, 0x8f, 0xb7, 0xce, 0xc9, 0x29, 0xb1, 0xb8, 0xe2, 0x0a,
-	0x1d, 0x6f, 0x9d, 0x93, 0xb6, 0xd5, 0x47, 0x8b, 0x90, 0x1b, 0x98, 0x43, 0xd3, 0x13, 0x5a, 0x79,
-	0x23, 0x64, 0xce, 0x6c, 0xc4, 0x9c, 0x6d, 0x00, 0xd7, 0x76, 0xbc, 0x53, 0xdb, 0xe9, 0x13, 0xa7,
-	0x91, 0x5b, 0xd3, 0xd6, 0x6b, 0x9b, 0x8f, 0x36, 0xd4, 0x85, 0xd8, 0x50, 0x0d, 0xda, 0x38, 0xb6,
-	0x1d, 0xaf, 0x43, 0x79, 0xf5, 0x92, 0x2b, 0x1f, 0xd1, 0xc7, 0x50, 0x66, 0x42, 0x3c, 0xc3, 0x39,
-	0x27, 0x5e, 0x23, 0xcf, 0xa4, 0x3c, 0xbe, 0x45, 0x4a, 0x97, 0x31, 0xeb, 0x4c, 0x3d, 0x7f, 0x46,
-	0x18, 0x2a, 0x2e, 0x71, 0x4c, 0x63, 0x60, 0x7e, 0x65, 0x9c, 0x0d, 0x48, 0xa3, 0xb0, 0xa6, 0xad,
-	0x17, 0xf5, 0x10, 0x8d, 0xce, 0xff, 0x92, 0x5c, 0xbb, 0xa7, 0xb6, 0x35, 0xb8, 0x6e, 0x14, 0x19,
-	0x43, 0x91, 0x12, 0x3a, 0xd6, 0xe0, 0x9a, 0x2d, 0x9a, 0x3d, 0xb6, 0x3c, 0xde, 0x5b, 0x62, 0xbd,
-	0x25, 0x46, 0x61, 0xdd, 0xeb, 0x50, 0x1f, 0x9a, 0xd6, 0xe9, 0xd0, 0xee, 0x9f, 0xfa, 0x0e, 0x01,
-	0xe6, 0x90, 0xda, 0xd0, 0xb4, 0x5e, 0xd9, 0x7d, 0x5d, 0xba, 0x85, 0x72, 0x1a, 0x57, 0x61, 0xce,
-	0xb2, 0xe0, 0x34, 0xae, 0x54, 0xce, 0x0d, 0x58, 0xa0, 0x32, 0x7b, 0x0e, 0x31, 0x3c, 0x12, 0x30,
-	0x57, 0x18, 0xf3, 0xfc, 0xd0, 0xb4, 0xb6, 0x59, 0x4f, 0x88, 0xdf, 0xb8, 0x8a, 0xf1, 0x57, 0x05,
-	0xbf, 0x71, 0x15, 0xe6, 0xc7, 0x1b, 0x50, 0xf2, 0x7d, 0x8e, 0x8a, 0x30, 0x7b, 0xd8, 0x39, 0x6c,
-	0xd7, 0x67, 0x10, 0x40, 0x7e, 0xeb, 0x78, 0xbb, 0x7d, 0xb8, 0x53, 0xd7, 0x50, 0x19, 0x0a, 0x3b,
-	0x6d, 0xde, 0xc8, 0xe0, 0x17, 0x00, 0x81, 0x77, 0x51, 0x01, 0xb2, 0xfb, 0xed, 0x2f, 0xea, 0x33,
-	0x94, 0xe7, 0x75, 0x5b, 0x3f, 0xde, 0xeb, 0x1c, 0xd6, 0x35, 0x3a, 0x78, 0x5b, 0x6f, 0x6f, 0x75,
-	0xdb, 0xf5, 0x0c, 0xe5, 0x78, 0xd5, 0xd9, 0xa9, 0x67, 0x51, 0x09, 0x72, 0xaf, 0xb7, 0x0e, 0x4e,
-	0xda, 0xf5, 0x59, 0xfc, 0x73, 0x0d, 0xaa, 0x62, 0xbd, 0xf8, 0x99, 0x40, 0xdf, 0x85, 0xfc, 0x05,
-	0x3b, 0x17, 0x6c, 0x2b, 0x96, 0x37, 0xef, 0x45, 0x16, 0x37, 0x74, 0x76, 0x74, 0xc1, 0x8b, 0x30,
-	0x64, 0x2f, 0x27, 0x6e, 0x23, 0xb3, 0x96, 0x5d, 0x2f, 0x6f, 0xd6, 0x37, 0xf8, 0x81, 0xdd, 0xd8,
-	0x27, 0xd7, 0xaf, 0x8d, 0xc1, 0x98, 0xe8, 0xb4, 0x13, 0x21, 0x98, 0x1d, 0xda, 0x0e, 0x61, 0x3b,
-	0xb6, 0xa8, 0xb3, 0x67, 0xba, 0x8d, 0xd9, 0xa2, 0x89, 0xdd, 0xca, 0x1b, 0xf8, 0x17, 0x1a, 0xc0,
-	0xd1, 0xd8, 0x4b, 0x3f, 0x1a, 0x8b, 0x90, 0x9b, 0x50, 0xc1, 0xe2, 0x58, 0xf0, 0x06, 0x3b, 0x13,
-	0xc4, 0x70, 0x89, 0x7f, 0x26, 0x68, 0x03, 0xdd, 0x81, 0xc2, 0xc8, 0x21, 0x93, 0xd3, 0xcb, 0x09,
-	0x53, 0x52, 0xd4, 0xf3, 0xb4, 0xb9, 0x3f, 0x41, 0xef, 0x41, 0xc5, 0x3c, 0xb7, 0x6c, 0x87, 0x9c,
-	0x72, 0x59, 0x39, 0xd6, 0x5b, 0xe6, 0x34, 0x66, 0xb7, 0xc2, 0xc2, 0x05, 0xe7, 0x55, 0x96, 0x03,
-	0x4a, 0xc2, 0x16, 0x94, 0x99, 0xa9, 0x53, 0xb9, 0xef, 0x83, 0xc0, 0xc6, 0x0c, 0x1b, 0x16, 0x77,
-	0xa1, 0xb0, 0x1a, 0xff, 0x10, 0xd0, 0x0e, 0x19, 0x10, 0x8f, 0x4c, 0x13, 0x3d, 0x14, 0x9f, 0x64,
-	0x55, 0x9f, 0xe0, 0x9f, 0x69, 0xb0, 0x10, 0x12, 0x3f, 0xd5, 0xb4, 0x1a, 0x50, 0xe8, 0x33, 0x61,
-	0xdc, 0x82, 0xac, 0x2e, 0x9b, 0xe8, 0x19, 0x14, 0x85, 0x01, 0x6e, 0x23, 0x9b, 0xb2, 0x69, 0x0a,
-	0xdc, 0x26, 0x17, 0xff, 0x22, 0x03, 0x25, 0x31, 0xd1, 0xce, 0x08, 0x6d, 0x41, 0xd5, 0xe1, 0x8d,
-	0x53, 0x36, 0x1f, 0x61, 0x51, 0x33, 0x3d, 0x08, 0xed, 0xce, 0xe8, 0x15, 0x31, 0x84, 0x91, 0xd1,
-	0xef, 0x41, 0x59, 0x8a, 0x18, 0x8d, 0x3d, 0xe1, 0xf2, 0x46, 0x58, 0x40, 0xb0, 0xff, 0x76, 0x67,
-	0x74, 0x10, 0xec, 0x47, 0x63, 0x0f, 0x75, 0x61, 0x51, 0x0e, 0xe6, 0xb3, 0x11, 0x66, 0x64, 0x99,
-	0x94, 0xb5, 0xb0, 0x94, 0xf8, 0x52, 0xed, 0xce, 0xe8, 0x48, 0x8c, 0x57, 0x3a, 0x55, 0x93, 0xbc,
-	0x2b, 0x1e, 0xbc, 0x63, 0x26, 0x75, 0xaf, 0xac, 0xb8, 0x49, 0xdd, 0x2b, 0xeb, 0x45, 0x09, 0x0a,
-	0xa2, 0x85, 0xff, 0x35, 0x03, 0x20, 0x57, 0xa3, 0x33, 0x42, 0x3b, 0x50, 0x73, 0x44, 0x2b, 0xe4,
-	0xad, 0xbb, 0x89, 0xde, 0x12, 0x8b, 0x38, 0xa3, 0x57, 0xe5, 0x20, 0x6e, 0xdc, 0xf7, 0xa1, 0xe2,
-	0x4b, 0x09, 0x1c, 0xb6, 0x92, 0xe0, 0x30, 0x5f, 0x42, 0x59, 0x0e, 0xa0, 0x2e, 0xfb, 0x0c, 0x96,
-	0xfc, 0xf1, 0x09, 0x3e, 0x7b, 0xef, 0x06, 0x9f, 0xf9, 0x02, 0x17, 0xa4, 0x04, 0xd5, 0x6b, 0xaa,
-	0x61, 0x81, 0xdb, 0x56, 0x12, 0xdc, 0x16, 0x37, 0x8c, 0x3a, 0x0e, 0x68, 0xbe, 0xe4, 0x4d, 0xfc,
-	0x7f, 0x59, 0x28, 0x6c, 0xdb, 0xc3, 0x91, 0xe1, 0xd0, 0xd5, 0xc8, 0x3b, 0xc4, 0x1d, 0x0f, 0x3c,
-	0xe6, 0xae, 0xda, 0xe6, 0xc3, 0xb0, 0x44, 0xc1, 0x26, 0xff, 0xeb, 0x8c, 0x55, 0x17, 0x43, 0xe8,
-	0x60, 0x91, 0x1e, 0x33, 0xef, 0x30, 0x58, 0x24, 0x47, 0x31, 0x44, 0x1e, 0xe4, 0x6c, 0x70, 0x90,
-	0x9b, 0x50, 0x98, 0x10, 0x27, 0x48, 0xe9, 0xbb, 0x33, 0xba, 0x24, 0xa0, 0x0f, 0x60, 0x2e, 0x9a,
-	0x5e, 0x72, 0x82, 0xa7, 0xd6, 0x0b, 0x67, 0xa3, 0x87, 0x50, 0x09, 0xe5, 0xb8, 0xbc, 0xe0, 0x2b,
-	0x0f, 0x95, 0x14, 0xb7, 0x2c, 0xe3, 0x2a, 0xcd, 0xc7, 0x95, 0xdd, 0x19, 0x19, 0x59, 0x97, 0x65,
-	0x64, 0x2d, 0x8a, 0x51, 0x22, 0xb6, 0x86, 0x82, 0xcc, 0x0f, 0xc2, 0x41, 0x06, 0xff, 0x00, 0xaa,
-	0x21, 0x07, 0xd1, 0xbc, 0xd3, 0xfe, 0xf4, 0x64, 0xeb, 0x80, 0x27, 0xa9, 0x97, 0x2c, 0x2f, 0xe9,
-	0x75, 0x8d, 0xe6, 0xba, 0x83, 0xf6, 0xf1, 0x71, 0x3d, 0x83, 0xaa, 0x50, 0x3a, 0xec, 0x74, 0x4f,
-	0x39, 0x57, 0x16, 0xbf, 0xf4, 0x25, 0x88, 0x24, 0xa7, 0xe4, 0xb6, 0x19, 0x25, 0xb7, 0x69, 0x32,
-	0xb7, 0x65, 0x82, 0xdc, 0xc6, 0xd2, 0xdc, 0x41, 0x7b, 0xeb, 0xb8, 0x5d, 0x9f, 0x7d, 0x51, 0x83,
-	0x0a, 0xf7, 0xef, 0xe9, 0xd8, 0xa2, 0xa9, 0xf6, 0x1f, 0x34, 0x80, 0xe0, 0x34, 0xa1, 0x16, 0x14,
-	0x7a, 0x5c, 0x4f, 0x43, 0x63, 0xc1, 0x68, 0x29, 0x71, 0xc9, 0x74, 0xc9, 0x85, 0xbe, 0x0d, 0x05,
-	0x77, 0xdc, 0xeb, 0x11, 0x57, 0xa6, 0xbc, 0x3b, 0xd1, 0x78, 0x28, 0xa2, 0x95, 0x2e, 0xf9, 0xe8,
-	0x90, 0x37, 0x86, 0x39, 0x18, 0xb3, 0x04, 0x78, 0xf3, 0x10, 0xc1, 0x87, 0xff, 0x4e, 0x83, 0xb2,
-	0xb2, 0x79, 0x7f, 0xcd, 0x20, 0x7c, 0x0f, 0x4a, 0xcc, 0x06, 0xd2, 0x17, 0x61, 0xb8, 0xa8, 0x07,
-	0x04, 0xf4, 0x3b, 0x50, 0x92, 0x27, 0x40, 0x46, 0xe2, 0x46, 0xb2, 0xd8, 0xce, 0x48, 0x0f, 0x58,
-	0xf1, 0x3e, 0xcc, 0x33, 0xaf, 0xf4, 0x68, 0x71, 0x2d, 0xfd, 0xa8, 0x96, 0x9f, 0x5a, 0xa4, 0xfc,
-	0x6c, 0x42, 0x71, 0x74, 0x71, 0xed, 0x9a, 0x3d, 0x63, 0x20, 0xac, 0xf0, 0xdb, 0xf8, 0x13, 0x40,
-	0xaa, 0xb0, 0x69, 0xa6, 0x8b, 0xab, 0x50, 0xde, 0x35, 0xdc, 0x0b, 0x61, 0x12, 0x7e, 0x06, 0x55,
-	0xda, 0xdc, 0x7f, 0xfd, 0x0e, 0x36, 0xb2, 0xcb, 0x81, 0xe4, 0x9e, 0xca, 0xe7, 0x08, 0x66, 0x2f,
-	0x0c, 0xf7, 0x82, 0x4d, 0xb4, 0xaa, 0xb3, 0x67, 0xf4, 0x01, 0xd4, 0x7b, 0x7c, 0x92, 0xa7, 0x91,
-	0x2b, 0xc3, 0x9c, 0xa0, 0xfb, 0x95, 0xe0, 0xe7, 0x50, 0xe1, 0x73, 0xf8, 0x4d, 0x1b, 0x81, 0xe7,
-	0x61, 0xee, 0xd8, 0x32, 0x46, 0xee, 0x85, 0x2d, 0xb3, 0x1b, 0x9d, 0x74, 0x3d, 0xa0, 0x4d, 0xa5,
-	0xf1, 0x09, 0xcc, 0x39, 0x64, 0x68, 0x98, 0x96, 0x69, 0x9d, 0x9f, 0x9e, 0x5d, 0x7b, 0xc4, 0x15,
-	0x17, 0xa6, 0x9a, 0x4f, 0x7e, 0x41, 0xa9, 0xd4, 0xb4, 0xb3, 0x81, 0x7d, 0x26, 0xc2, 0x1c, 0x7b,
-	0xc6, 0x7f, 0x99, 0x81, 0xca, 0x67, 0x86, 0xd7, 0x93, 0x4b, 0x87, 0xf6, 0xa0, 0xe6, 0x07, 0x37,
-	0x46, 0x11, 0xb6, 0x44, 0x52, 0x2c, 0x1b, 0x23, 0x4b, 0x69, 0x99, 0x1d, 0xab, 0x3d, 0x95, 0xc0,
-	0x44, 0x19, 0x56, 0x8f, 0x0c, 0x7c, 0x51, 0x99, 0x74, 0x51, 0x8c, 0x51, 0x15, 0xa5, 0x12, 0x50,
-	0x07, 0xea, 0x23, 0xc7, 0x3e, 0x77, 0x88, 0xeb, 0xfa, 0xc2, 0x78, 0x1a, 0xc3, 0x09, 0xc2, 0x8e,
-	0x04, 0x6b, 0x20, 0x6e, 0x6e, 0x14, 0x26, 0xbd, 0x98, 0x0b, 0xea, 0x19, 0x1e, 0x9c, 0xfe, 0x2b,
-	0x03, 0x28, 0x3e, 0xa9, 0x5f, 0xb5, 0xc4, 0x7b, 0x0c, 0x35, 0xd7, 0x33, 0x9c, 0xd8, 0x66, 0xab,
-	0x32, 0xaa, 0x1f, 0xf1, 0x9f, 0x80, 0x6f, 0xd0, 0xa9, 0x65, 0x7b, 0xe6, 0x9b, 0x6b, 0x51, 0x25,
-	0xd7, 0x24, 0xf9, 0x90, 0x51, 0x51, 0x1b, 0x0a, 0x6f, 0xcc, 0x81, 0x47, 0x1c, 0xb7, 0x91, 0x5b,
-	0xcb, 0xae, 0xd7, 0x36, 0x9f, 0xdd, 0xb6, 0x0c, 0x1b, 0x1f, 0x33, 0xfe, 0xee, 0xf5, 0x88, 0xe8,
-	0x72, 0xac, 0x5a, 0x79, 0xe6, 0x43, 0xd5, 0xf8, 0x0a, 0x14, 0xdf, 0x52, 0x11, 0xf4, 0x96, 0x5d,
-	0xe0, 0xc5, 0x22, 0x6b, 0xf3, 0x4b, 0xf6, 0x1b, 0xc7, 0x38, 0x1f, 0x12, 0xcb, 0x93, 0xf7, 0x40,
-	0xd9, 0xc6, 0x8f, 0x01, 0x02, 0x35, 0x34, 0xe4, 0x1f, 0x76, 0x8e, 0x4e, 0xba, 0xf5, 0x19, 0x54,
-	0x81, 0xe2, 0x61, 0x67, 0xa7, 0x7d, 0xd0, 0xa6, 0xf9, 0x01, 0xb7, 0xa4, 0x4b, 0x43, 0x6b, 0xa9,
-	0xea, 0xd4, 0x42, 0x3a, 0xf1, 0x32, 0x2c, 0x26, 0x2d, 0x20, 0xad, 0x45, 0xab, 0x62, 0x97, 0x4e,
-	0x75, 0x54, 0x54, 0xd5, 0x99, 0xf0, 0x74, 0x1b, 0x50, 0xe0, 0xbb, 0xb7, 0x2f, 0x8a, 0x73, 0xd9,
-	0xa4, 0x8e, 0xe0, 0x9b, 0x91, 0xf4, 0xc5, 0x2a, 0xf9, 0xed, 0xc4, 0xf0, 0x92, 0x4b, 0x0c, 0x2f,
-	0xe8, 0x21, 0x54, 0xfd, 0xd3, 0x60, 0xb8, 0xa2, 0x16, 0x28, 0xe9, 0x15, 0xb9, 0xd1, 0x29, 0x2d,
-	0xe4, 0xf4, 0x42, 0xd8, 0xe9, 0xe8, 0x31, 0xe4, 0xc9, 0x84, 0x58, 0x9e, 0xdb, 0x28, 0xb3, 0x8c,
-	0x51, 0x95, 0xb5, 0x7b, 0x9b, 0x52, 0x75, 0xd1, 0x89, 0xbf, 0x07, 0xf3, 0xec, 0x8e, 0xf4, 0xd2,
-	0x31, 0x2c, 0xf5, 0x32, 0xd7, 0xed, 0x1e, 0x08, 0x77, 0xd3, 0x47, 0x54, 0x83, 0xcc, 0xde, 0x8e,
-	0x70, 0x42, 0x66, 0x6f, 0x07, 0xff, 0x44, 0x03, 0xa4, 0x8e, 0x9b, 0xca, 0xcf, 0x11, 0xe1, 0x52,
-	0x7d, 0x36, 0x50, 0xbf, 0x08, 0x39, 0xe2, 0x38, 0xb6, 0xc3, 0x3c, 0x5a, 0xd2, 0x79, 0x03, 0x3f,
-	0x12, 0x36, 0xe8, 0x64, 0x62, 0x5f, 0xfa, 0x67, 0x90, 0x4b, 0xd3, 0x7c, 0x53, 0xf7, 0x61, 0x21,
-	0xc4, 0x35, 0x55, 0xe6, 0xfa, 0x18, 0xe6, 0x98, 0xb0, 0xed, 0x0b, 0xd2, 0xbb, 0x1c, 0xd9, 0xa6,
-	0x15, 0xd3, 0x47, 0x57, 0x2e, 0x08, 0xb0, 0x74, 0x1e, 0x7c, 0x62, 0x15, 0x9f, 0xd8, 0xed, 0x1e,
-	0xe0, 0x2f, 0x60, 0x39, 0x22, 0x47, 0x9a, 0xff, 0x87, 0x50, 0xee, 0xf9, 0x44, 0x57, 0xd4, 0x3a,
-	0xf7, 0xc3, 0xc6, 0x45, 0x87, 0xaa, 0x23, 0x70, 0x07, 0xee, 0xc4, 0x44, 0x4f, 0x35, 0xe7, 0x27,
-	0xb0, 0xc4, 0x04, 0xee, 0x13, 0x32, 0xda, 0x1a, 0x98, 0x93, 0x54, 0x4f, 0x8f, 0xc4, 0xa4, 0x14,
-	0xc6, 0x6f, 0x76, 0x5f, 0xe0, 0xdf, 0x17, 0x1a, 0xbb, 0xe6, 0x90, 0x74, 0xed, 0x83, 0x74, 0xdb,
-	0x68, 0x36, 0xbb, 0x24, 0xd7, 0xae, 0x28, 0x6b, 0xd8, 0x33, 0xfe, 0x47, 0x4d, 0xb8, 0x4a, 0x1d,
-	0xfe, 0x0d, 0xef, 0xe4, 0x55, 0x80, 0x73, 0x7a, 0x64, 0x48, 0x9f, 0x76, 0x70, 0x44, 0x45, 0xa1,
-	0xf8, 0x76, 0xd2, 0xf8, 0x5d, 0x11, 0x76, 0x2e, 0x8a, 0x7d, 0xce, 0xfe, 0xf8, 0x51, 0xee, 0x3e,
-	0x94, 0x19, 0xe1, 0xd8, 0x33, 0xbc, 0xb1, 0x1b, 0x5b, 0x8c, 0x3f, 0x13, 0xdb, 0x5e, 0x0e, 0x9a,
-	0x6a, 0x5e, 0xdf, 0x86, 0x3c, 0xbb, 0x4c, 0xc8, 0x52, 0x7a, 0x25, 0x61, 0x3f, 0x72, 0x3b, 0x74,
-	0xc1, 0x88, 0x2f, 0x20, 0xff, 0x8a, 0x21, 0xb0, 0x8a, 0x65, 0xb3, 0x72, 0x29, 0x2c, 0x63, 0xc8,
-	0x71, 0xa1, 0x92, 0xce, 0x9e, 0x59, 0xe5, 0x49, 0x88, 0x73, 0xa2, 0x1f, 0xf0, 0x0a, 0xb7, 0xa4,
-	0xfb, 0x6d, 0xea, 0xb2, 0xde, 0xc0, 0x24, 0x96, 0xc7, 0x7a, 0x67, 0x59, 0xaf, 0x42, 0xc1, 0x1b,
-	0x50, 0xe7, 0x9a, 0xb6, 0xfa, 0x7d, 0xa5, 0x82, 0xf4, 0xe5, 0x69, 0x61, 0x79, 0xf8, 0x9f, 0x34,
-	0x98, 0x57, 0x06, 0x4c, 0xe5, 0x98, 0x0f, 0x21, 0xcf, 0x71, 0x66, 0x51, 0xac, 0x2c, 0x86, 0x47,
-	0x71, 0x35, 0xba, 0xe0, 0x41, 0x1b, 0x50, 0xe0, 0x4f, 0xb2, 0x8c, 0x4f, 0x66, 0x97, 0x4c, 0xf8,
-	0x31, 0x2c, 0x08, 0x12, 0x19, 0xda, 0x49, 0x7b, 0x9b, 0x39, 0x14, 0xff, 0x29, 0x2c, 0x86, 0xd9,
-	0xa6, 0x9a, 0x92, 0x62, 0x64, 0xe6, 0x5d, 0x8c, 0xdc, 0x92, 0x46, 0x9e, 0x8c, 0xfa, 0x4a, 0x29,
-	0x14, 0x5d, 0x75, 0x75, 0x45, 0x32, 0x91, 0x15, 0xf1, 0x27, 0x20, 0x45, 0xfc, 0x56, 0x27, 0xb0,
-	0x20, 0xb7, 0xc3, 0x81, 0xe9, 0xfa, 0x15, 0xf7, 0x57, 0x80, 0x54, 0xe2, 0x6f, 0xdb, 0xa0, 0x1d,
-	0x22, 0x13, 0xb9, 0x34, 0xe8, 0x13, 0x40, 0x2a, 0x71, 0xaa, 0x88, 0xde, 0x82, 0xf9, 0x57, 0xf6,
-	0x84, 0x86, 0x06, 0x4a, 0x0d, 0x8e, 0x0c, 0xbf, 0x7f, 0xfb, 0xcb, 0xe6, 0xb7, 0xa9, 0x72, 0x75,
-	0xc0, 0x54, 0xca, 0xff, 0x43, 0x83, 0xca, 0xd6, 0xc0, 0x70, 0x86, 0x52, 0xf1, 0xf7, 0x21, 0xcf,
-	0x6f, 0x95, 0x02, 0xc8, 0x79, 0x3f, 0x2c, 0x46, 0xe5, 0xe5, 0x8d, 0x2d, 0x7e, 0x07, 0x15, 0xa3,
-	0xa8, 0xe1, 0xe2, 0x5d, 0xcf, 0x4e, 0xe4, 0xdd, 0xcf, 0x0e, 0xfa, 0x08, 0x72, 0x06, 0x1d, 0xc2,
-	0x42, 0x70, 0x2d, 0x7a, 0x9f, 0x67, 0xd2, 0x58, 0xed, 0xcb, 0xb9, 0xf0, 0x77, 0xa1, 0xac, 0x68,
-	0x40, 0x05, 0xc8, 0xbe, 0x6c, 0x8b, 0x42, 0x75, 0x6b, 0xbb, 0xbb, 0xf7, 0x9a, 0x03, 0x19, 0x35,
-	0x80, 0x9d, 0xb6, 0xdf, 0xce, 0xe0, 0xcf, 0xc5, 0x28, 0x11, 0xef, 0x54, 0x7b, 0xb4, 0x34, 0x7b,
-	0x32, 0xef, 0x64, 0xcf, 0x15, 0x54, 0xc5, 0xf4, 0xa7, 0x0d, 0xdf, 0x4c, 0x5e, 0x4a, 0xf8, 0x56,
-	0x8c, 0xd7, 0x05, 0x23, 0x9e, 0x83, 0xaa, 0x08, 0xe8, 0x62, 0xff, 0xfd, 0x4b, 0x06, 0x6a, 0x92,
-	0x32, 0x2d, 0xe0, 0x2c, 0xb1, 0x32, 0x9e, 0x01, 0x7c, 0xa4, 0x6c, 0x19, 0xf2, 0xfd, 0xb3, 0x63,
-	0xf3, 0x2b, 0xf9, 0x72, 0x40, 0xb4, 0x28, 0x7d, 0xc0, 0xf5, 0xf0, 0x37, 0x74, 0xa2, 0x85, 0xee,
-	0xf1, 0x97, 0x77, 0x7b, 0x56, 0x9f, 0x5c, 0xb1, 0x3a, 0x7a, 0x56, 0x0f, 0x08, 0x0c, 0x44, 0x10,
-	0x6f, 0xf2, 0x58, 0xf1, 0xac, 0xbc, 0xd9, 0x43, 0x4f, 0xa1, 0x4e, 0x9f, 0xb7, 0x46, 0xa3, 0x81,
-	0x49, 0xfa, 0x5c, 0x40, 0x81, 0xf1, 0xc4, 0xe8, 0x54, 0x3b, 0x2b, 0x37, 0xdd, 0x46, 0x91, 0x85,
-	0x2d, 0xd1, 0x42, 0x6b, 0x50, 0xe6, 0xf6, 0xed, 0x59, 0x27, 0x2e, 0x61, 0xaf, 0xb7, 0xb2, 0xba,
-	0x4a, 0xa2, 0xe7, 0x78, 0x6b, 0xec, 0x5d, 0xb4, 0x2d, 0xe3, 0x6c, 0x20, 0xe3, 0x22, 0x4d, 0xe6,
-	0x94, 0xb8, 0x63, 0xba, 0x2a, 0xb5, 0x0d, 0x0b, 0x94, 0x4a, 0x2c, 0xcf, 0xec, 0x29, 0x41, 0x54,
-	0xa6, 0x4a, 0x2d, 0x92, 0x2a, 0x0d, 0xd7, 0x7d, 0x6b, 0x3b, 0x7d, 0xe1, 0x40, 0xbf, 0x8d, 0x77,
-	0xb8, 0xf0, 0x13, 0x37, 0x94, 0x0c, 0x7f, 0x55, 0x29, 0xeb, 0x81, 0x94, 0x97, 0xc4, 0xbb, 0x41,
-	0x0a, 0x7e, 0x06, 0x4b, 0x92, 0x53, 0x40, 0xbe, 0x37, 0x30, 0x77, 0xe0, 0xbe, 0x64, 0xde, 0xbe,
-	0xa0, 0x57, 0xe0, 0x23, 0xa1, 0xf0, 0xd7, 0xb5, 0xf3, 0x05, 0x34, 0x7c, 0x3b, 0xd9, 0x35, 0xc4,
-	0x1e, 0xa8, 0x06, 0x8c, 0x5d, 0xb1, 0x33, 0x4b, 0x3a, 0x7b, 0xa6, 0x34, 0xc7, 0x1e, 0xf8, 0x85,
-	0x07, 0x7d, 0xc6, 0xdb, 0xb0, 0x22, 0x65, 0x88, 0x0b, 0x42, 0x58, 0x48, 0xcc, 0xa0, 0x24, 0x21,
-	0xc2, 0x61, 0x74, 0xe8, 0xcd, 0x6e, 0x57, 0x39, 0xc3, 0xae, 0x65, 0x32, 0x35, 0x45, 0xe6, 0x12,
-	0xdf, 0x11, 0xd4, 0x30, 0x35, 0x2f, 0x09, 0x32, 0x15, 0xa0, 0x92, 0xc5, 0x42, 0x50, 0x72, 0x6c,
-	0x21, 0x62, 0xa2, 0x7f, 0x08, 0xab, 0xbe, 0x11, 0xd4, 0x6f, 0x47, 0xc4, 0x19, 0x9a, 0xae, 0xab,
-	0x80, 0x84, 0x49, 0x13, 0x7f, 0x1f, 0x66, 0x47, 0x44, 0x44, 0xae, 0xf2, 0x26, 0xda, 0xe0, 0x6f,
-	0xf5, 0x37, 0x94, 0xc1, 0xac, 0x1f, 0xf7, 0xe1, 0x81, 0x94, 0xce, 0x3d, 0x9a, 0x28, 0x3e, 0x6a,
-	0x94, 0x84, 0x4e, 0x32, 0x29, 0xd0, 0x49, 0x36, 0x02, 0x5c, 0x7f, 0xc2, 0x1d, 0x29, 0xcf, 0xd6,
-	0x54, 0x19, 0x69, 0x9f, 0xfb, 0xd4, 0x3f, 0x92, 0x53, 0x09, 0x3b, 0x83, 0xc5, 0xf0, 0x49, 0x9e,
-	0x2a, 0x58, 0x2e, 0x42, 0xce, 0xb3, 0x2f, 0x89, 0x0c, 0x95, 0xbc, 0x21, 0x0d, 0xf6, 0x8f, 0xf9,
-	0x54, 0x06, 0x1b, 0x81, 0x30, 0xb6, 0x25, 0xa7, 0xb5, 0x97, 0xae, 0xa6, 0x2c, 0xf1, 0x78, 0x03,
-	0x1f, 0xc2, 0x72, 0x34, 0x4c, 0x4c, 0x65, 0xf2, 0x6b, 0xbe, 0x81, 0x93, 0x22, 0xc9, 0x54, 0x72,
-	0x3f, 0x0d, 0x82, 0x81, 0x12, 0x50, 0xa6, 0x12, 0xa9, 0x43, 0x33, 0x29, 0xbe, 0xfc, 0x26, 0xf6,
-	0xab, 0x1f, 0x6e, 0xa6, 0x12, 0xe6, 0x06, 0xc2, 0xa6, 0x5f, 0xfe, 0x20, 0x46, 0x64, 0x6f, 0x8c,
-	0x11, 0xe2, 0x90, 0x04, 0x51, 0xec, 0x1b, 0xd8, 0x74, 0x42, 0x47, 0x10, 0x40, 0xa7, 0xd5, 0x41,
-	0x73, 0x88, 0xaf, 0x83, 0x35, 0xe4, 0xc6, 0x56, 0xc3, 0xee, 0x54, 0x8b, 0xf1, 0x59, 0x10, 0x3b,
-	0x63, 0x91, 0x79, 0x2a, 0xc1, 0x9f, 0xc3, 0x5a, 0x7a, 0x50, 0x9e, 0x46, 0xf2, 0xd3, 0x16, 0x94,
-	0xfc, 0xb2, 0x55, 0xf9, 0x22, 0xa6, 0x0c, 0x85, 0xc3, 0xce, 0xf1, 0xd1, 0xd6, 0x76, 0x9b, 0x7f,
-	0x12, 0xb3, 0xdd, 0xd1, 0xf5, 0x93, 0xa3, 0x6e, 0x3d, 0xb3, 0xf9, 0xcb, 0x2c, 0x64, 0xf6, 0x5f,
-	0xa3, 0x2f, 0x20, 0xc7, 0xdf, 0x0f, 0xdf, 0xf0, 0x51, 0x40, 0xf3, 0xa6, 0x57, 0xe0, 0xf8, 0xce,
-	0x4f, 0xfe, 0xfb, 0x97, 0x3f, 0xcf, 0xcc, 0xe3, 0x4a, 0x6b, 0xf2, 0x9d, 0xd6, 0xe5, 0xa4, 0xc5,
-	0x72, 0xc3, 0x73, 0xed, 0x29, 0xfa, 0x14, 0xb2, 0x47, 0x63, 0x0f, 0xa5, 0x7e, 0x2c, 0xd0, 0x4c,
-	0x7f, 0x2b, 0x8e, 0x97, 0x98, 0xd0, 0x39, 0x0c, 0x42, 0xe8, 0x68, 0xec, 0x51, 0x91, 0x3f, 0x82,
-	0xb2, 0xfa, 0x4e, 0xfb, 0xd6, 0x2f, 0x08, 0x9a, 0xb7, 0xbf, 0x2f, 0xc7, 0xf7, 0x99, 0xaa, 0x3b,
-	0x18, 0x09, 0x55, 0xfc, 0xad, 0xbb, 0x3a, 0x8b, 0xee, 0x95, 0x85, 0x52, 0xbf, 0x2f, 0x68, 0xa6,
-	0xbf, 0x42, 0x8f, 0xcd, 0xc2, 0xbb, 0xb2, 0xa8, 0xc8, 0x3f, 0x16, 0x6f, 0xcf, 0x7b, 0x1e, 0x7a,
-	0x90, 0xf0, 0xf6, 0x54, 0x7d, 0x4f, 0xd8, 0x5c, 0x4b, 0x67, 0x10, 0x4a, 0xee, 0x31, 0x25, 0xcb,
-	0x78, 0x5e, 0x28, 0xe9, 0xf9, 0x2c, 0xcf, 0xb5, 0xa7, 0x9b, 0x3d, 0xc8, 0x31, 0x0c, 0x1e, 0x7d,
-	0x29, 0x1f, 0x9a, 0x09, 0x2f, 0x23, 0x52, 0x16, 0x3a, 0x84, 0xde, 0xe3, 0x45, 0xa6, 0xa8, 0x86,
-	0x4b, 0x54, 0x11, 0x43, 0xe0, 0x9f, 0x6b, 0x4f, 0xd7, 0xb5, 0x6f, 0x69, 0x9b, 0xff, 0x9c, 0x83,
-	0x1c, 0x03, 0x9f, 0xd0, 0x25, 0x40, 0x80, 0x47, 0x47, 0x67, 0x17, 0x43, 0xb8, 0xa3, 0xb3, 0x8b,
-	0x43, 0xd9, 0xb8, 0xc9, 0x94, 0x2e, 0xe2, 0x39, 0xaa, 0x94, 0x61, 0x5a, 0x2d, 0x06, 0xd3, 0x51,
-	0x3f, 0xfe, 0x95, 0x26, 0xb0, 0x37, 0x7e, 0x96, 0x50, 0x92, 0xb4, 0x10, 0x28, 0x1d, 0xdd, 0x0e,
-	0x09, 0x80, 0x34, 0xfe, 0x1e, 0x53, 0xd8, 0xc2, 0xf5, 0x40, 0xa1, 0xc3, 0x38, 0x9e, 0x6b, 0x4f,
-	0xbf, 0x6c, 0xe0, 0x05, 0xe1, 0xe5, 0x48, 0x0f, 0xfa, 0x31, 0xd4, 0xc2, 0xa0, 0x2b, 0x7a, 0x98,
-	0xa0, 0x2b, 0x8a, 0xdd, 0x36, 0x1f, 0xdd, 0xcc, 0x24, 0x6c, 0x5a, 0x65, 0x36, 0x09, 0xe5, 0x5c,
-	0xf3, 0x25, 0x21, 0x23, 0x83, 0x32, 0x89, 0x35, 0x40, 0x7f, 0xaf, 0x09, 0x4c, 0x3c, 0x40, 0x51,
-	0x51, 0x92, 0xf4, 0x18, 0x46, 0xdb, 0x7c, 0x7c, 0x0b, 0x97, 0x30, 0xe2, 0x0f, 0x98, 0x11, 0xbf,
-	0x8b, 0x17, 0x03, 0x23, 0x3c, 0x73, 0x48, 0x3c, 0x5b, 0x58, 0xf1, 0xe5, 0x3d, 0x7c, 0x27, 0xe4,
-	0x9c, 0x50, 0x6f, 0xb0, 0x58, 0x1c, 0x09, 0x4d, 0x5c, 0xac, 0x10, 0xb2, 0x9a, 0xb8, 0x58, 0x61,
-	0x18, 0x35, 0x69, 0xb1, 0x38, 0xee, 0x99, 0xb4, 0x58, 0x7e, 0xcf, 0x26, 0xfb, 0x7e, 0x85, 0x7f,
-	0xb5, 0x8a, 0x6c, 0x28, 0xf9, 0x28, 0x24, 0x5a, 0x4d, 0x42, 0x84, 0x82, 0xbb, 0x44, 0xf3, 0x41,
-	0x6a, 0xbf, 0x30, 0xe8, 0x3d, 0x66, 0xd0, 0x5d, 0xbc, 0x4c, 0x35, 0x8b, 0x0f, 0x63, 0x5b, 0x1c,
-	0x76, 0x68, 0x19, 0xfd, 0x3e, 0x75, 0xc4, 0x9f, 0x40, 0x45, 0x85, 0x09, 0xd1, 0x7b, 0x89, 0x28,
-	0x94, 0x8a, 0x34, 0x36, 0xf1, 0x4d, 0x2c, 0x42, 0xf3, 0x23, 0xa6, 0x79, 0x15, 0xaf, 0x24, 0x68,
-	0x76, 0x18, 0x6b, 0x48, 0x39, 0x87, 0xf8, 0x92, 0x95, 0x87, 0x10, 0xc4, 0x64, 0xe5, 0x61, 0x84,
-	0xf0, 0x46, 0xe5, 0x63, 0xc6, 0x4a, 0x95, 0xbb, 0x00, 0x01, 0x98, 0x87, 0x12, 0x7d, 0xa9, 0x5c,
-	0xa6, 0xa2, 0xc1, 0x21, 0x8e, 0x03, 0x62, 0xcc, 0xd4, 0x8a, 0x7d, 0x17, 0x51, 0x3b, 0x30, 0x5d,
-	0x1a, 0x24, 0x36, 0xff, 0x3a, 0x0f, 0xe5, 0x57, 0x86, 0x69, 0x79, 0xc4, 0x32, 0xac, 0x1e, 0x41,
-	0x67, 0x90, 0x63, 0x89, 0x32, 0x1a, 0x07, 0x55, 0x7c, 0x2b, 0x1a, 0x07, 0x43, 0xe0, 0x0f, 0x5e,
-	0x63, 0x5a, 0x9b, 0x78, 0x89, 0x6a, 0x1d, 0x06, 0xa2, 0x5b, 0x0c, 0xb3, 0xa1, 0x13, 0x7d, 0x03,
-	0x79, 0xf1, 0x3a, 0x20, 0x22, 0x28, 0x84, 0xe5, 0x34, 0xef, 0x25, 0x77, 0x26, 0x6d, 0x25, 0x55,
-	0x8d, 0xcb, 0xf8, 0xa8, 0x9e, 0x09, 0x40, 0x00, 0x46, 0x46, 0x1d, 0x1a, 0xc3, 0x2e, 0x9b, 0x6b,
-	0xe9, 0x0c, 0x42, 0xe7, 0x63, 0xa6, 0xf3, 0x01, 0x6e, 0x46, 0x75, 0xf6, 0x7d, 0x5e, 0xaa, 0xf7,
-	0x8f, 0x60, 0x76, 0xd7, 0x70, 0x2f, 0x50, 0x24, 0xf5, 0x29, 0x1f, 0x93, 0x34, 0x9b, 0x49, 0x5d,
-	0x42, 0xcb, 0x03, 0xa6, 0x65, 0x85, 0x47, 0x12, 0x55, 0xcb, 0x85, 0xe1, 0xd2, 0x9c, 0x82, 0xfa,
-	0x90, 0xe7, 0xdf, 0x96, 0x44, 0xfd, 0x17, 0xfa, 0x3e, 0x25, 0xea, 0xbf, 0xf0, 0xe7, 0x28, 0xb7,
-	0x6b, 0x19, 0x41, 0x51, 0x7e, 0xcc, 0x81, 0x22, 0x6f, 0xf6, 0x22, 0x1f, 0x7e, 0x34, 0x57, 0xd3,
-	0xba, 0x85, 0xae, 0x87, 0x4c, 0xd7, 0x7d, 0xdc, 0x88, 0xad, 0x95, 0xe0, 0x7c, 0xae, 0x3d, 0xfd,
-	0x96, 0x86, 0x7e, 0x0c, 0x10, 0xe0, 0xb7, 0xb1, 0x03, 0x10, 0x85, 0x82, 0x63, 0x07, 0x20, 0x06,
-	0xfd, 0xe2, 0x0d, 0xa6, 0x77, 0x1d, 0x3f, 0x8c, 0xea, 0xf5, 0x1c, 0xc3, 0x72, 0xdf, 0x10, 0xe7,
-	0x23, 0x8e, 0xd1, 0xb9, 0x17, 0xe6, 0x88, 0x1e, 0x86, 0x7f, 0x9b, 0x83, 0x59, 0x5a, 0x80, 0xd2,
-	0x3c, 0x1d, 0xdc, 0xdb, 0xa3, 0x96, 0xc4, 0xd0, 0xb2, 0xa8, 0x25, 0xf1, 0x2b, 0x7f, 0x38, 0x4f,
-	0xb3, 0x9f, 0x1b, 0x10, 0xc6, 0x40, 0x1d, 0x6d, 0x43, 0x59, 0xb9, 0xd8, 0xa3, 0x04, 0x61, 0x61,
-	0x18, 0x2e, 0x1a, 0xf9, 0x13, 0x50, 0x01, 0x7c, 0x97, 0xe9, 0x5b, 0xe2, 0x91, 0x9f, 0xe9, 0xeb,
-	0x73, 0x0e, 0xaa, 0xf0, 0x2d, 0x54, 0xd4, 0xcb, 0x3f, 0x4a, 0x90, 0x17, 0x81, 0xf8, 0xa2, 0x51,
-	0x2e, 0x09, 0x3b, 0x08, 0x1f, 0x7c, 0xff, 0x27, 0x15, 0x92, 0x8d, 0x2a, 0x1e, 0x40, 0x41, 0xa0,
-	0x01, 0x49, 0xb3, 0x0c, 0xe3, 0x81, 0x49, 0xb3, 0x8c, 0x40, 0x09, 0xe1, 0xda, 0x8e, 0x69, 0xa4,
-	0x17, 0x1e, 0x99, 0x49, 0x84, 0xb6, 0x97, 0xc4, 0x4b, 0xd3, 0x16, 0x80, 0x5b, 0x69, 0xda, 0x94,
-	0xcb, 0x66, 0x9a, 0xb6, 0x73, 0xe2, 0x89, 0xe3, 0x22, 0x2f, 0x71, 0x28, 0x45, 0x98, 0x1a, 0xbd,
-	0xf1, 0x4d, 0x2c, 0x49, 0xa5, 0x77, 0xa0, 0x50, 0x84, 0x6e, 0x74, 0x05, 0x10, 0x60, 0x15, 0xd1,
-	0x7a, 0x2a, 0x11, 0xf0, 0x8c, 0xd6, 0x53, 0xc9, 0x70, 0x47, 0x38, 0x34, 0x04, 0x7a, 0x79, 0xe5,
-	0x4f, 0x35, 0xff, 0x4c, 0x03, 0x14, 0x87, 0x35, 0xd0, 0xb3, 0x64, 0xe9, 0x89, 0x30, 0x6a, 0xf3,
-	0xc3, 0x77, 0x63, 0x4e, 0x8a, 0xf6, 0x81, 0x49, 0x3d, 0xc6, 0x3d, 0x7a, 0x4b, 0x8d, 0xfa, 0x73,
-	0x0d, 0xaa, 0x21, 0x4c, 0x04, 0xbd, 0x9f, 0xb2, 0xa6, 0x11, 0x14, 0xb6, 0xf9, 0xe4, 0x56, 0xbe,
-	0xa4, 0x42, 0x53, 0xd9, 0x01, 0xb2, 0xe2, 0xfe, 0xa9, 0x06, 0xb5, 0x30, 0x86, 0x82, 0x52, 0x64,
-	0xc7, 0x50, 0xdc, 0xe6, 0xfa, 0xed, 0x8c, 0x37, 0x2f, 0x4f, 0x50, 0x6c, 0x0f, 0xa0, 0x20, 0x50,
-	0x97, 0xa4, 0x8d, 0x1f, 0xc6, 0x7f, 0x93, 0x36, 0x7e, 0x04, 0xb2, 0x49, 0xd8, 0xf8, 0x8e, 0x3d,
-	0x20, 0xca, 0x31, 0x13, 0xb0, 0x4c, 0x9a, 0xb6, 0x9b, 0x8f, 0x59, 0x04, 0xd3, 0x49, 0xd3, 0x16,
-	0x1c, 0x33, 0x89, 0xc7, 0xa0, 0x14, 0x61, 0xb7, 0x1c, 0xb3, 0x28, 0x9c, 0x93, 0x70, 0xcc, 0x98,
-	0x42, 0xe5, 0x98, 0x05, 0xc8, 0x49, 0xd2, 0x31, 0x8b, 0xc1, 0xd9, 0x49, 0xc7, 0x2c, 0x0e, 0xbe,
-	0x24, 0xac, 0x23, 0xd3, 0x1b, 0x3a, 0x66, 0x0b, 0x09, 0x20, 0x0b, 0xfa, 0x30, 0xc5, 0x89, 0x89,
-	0x28, 0x79, 0xf3, 0xa3, 0x77, 0xe4, 0x4e, 0xdd, 0xe3, 0xdc, 0xfd, 0x72, 0x8f, 0xff, 0x8d, 0x06,
-	0x8b, 0x49, 0x00, 0x0d, 0x4a, 0xd1, 0x93, 0x82, 0xae, 0x37, 0x37, 0xde, 0x95, 0xfd, 0x66, 0x6f,
-	0xf9, 0xbb, 0xfe, 0x45, 0xfd, 0xdf, 0xbf, 0x5e, 0xd5, 0xfe, 0xf3, 0xeb, 0x55, 0xed, 0x7f, 0xbe,
-	0x5e, 0xd5, 0xfe, 0xf6, 0x7f, 0x57, 0x67, 0xce, 0xf2, 0xec, 0x87, 0x7a, 0xdf, 0xf9, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0xc3, 0xa2, 0xb2, 0x2f, 0x38, 0x00, 0x00,
+	// 4242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7b, 0x5b, 0x6f, 0x1b, 0x49,
+	0x76, 0xbf, 0x9a, 0x94, 0x78, 0x39, 0xbc, 0x88, 0x2e, 0x49, 0x36, 0xdd, 0xb6, 0x65, 0xa9, 0x7c,
+	0x19, 0x8d, 0x3d, 0x23, 0xee, 0x6a, 0x67, 0xff, 0x7f, 0xc4, 0x49, 0x36, 0x2b, 0x4b, 0x1c, 0x5b,
+	0x23, 0x59, 0xd4, 0xb4, 0x68, 0x7b, 0x66, 0xb0, 0x08, 0xd1, 0x22, 0xcb, 0x54, 0xaf, 0xc8, 0x6e,
+	0x6e, 0x77, 0x53, 0x23, 0x4d, 0x2e, 0x1b, 0x2c, 0x92, 0x05, 0xf2, 0x90, 0x97, 0x0d, 0xb0, 0x48,
+	0x02, 0xe4, 0x29, 0x37, 0xec, 0x43, 0x9e, 0x03, 0x24, 0xef, 0x41, 0x1e, 0x02, 0x24, 0x40, 0xbe,
+	0x40, 0x30, 0xd9, 0x97, 0xe4, 0x25, 0x5f, 0x21, 0xa8, 0x5b, 0x77, 0x75, 0xb3, 0x9b, 0xd6, 0x2e,
+	0x77, 0xe6, 0x85, 0xea, 0x3a, 0xf5, 0xab, 0x73, 0x4e, 0x9d, 0xaa, 0x3a, 0xa7, 0xea, 0x54, 0x09,
+	0x8a, 0xee, 0xa8, 0xbb, 0x39, 0x72, 0x1d, 0xdf, 0x41, 0x65, 0xe2, 0x77, 0x7b, 0x1e, 0x71, 0xcf,
+	0x89, 0x3b, 0x3a, 0xd1, 0x97, 0xfb, 0x4e, 0xdf, 0x61, 0x15, 0x0d, 0xfa, 0xc5, 0x31, 0xfa, 0x4d,
+	0x8a, 0x69, 0x0c, 0xcf, 0xbb, 0x5d, 0xf6, 0x33, 0x3a, 0x69, 0x9c, 0x9d, 0x8b, 0xaa, 0x5b, 0xac,
+	0xca, 0x1c, 0xfb, 0xa7, 0xec, 0x67, 0x74, 0xc2, 0xfe, 0x88, 0xca, 0xdb, 0x7d, 0xc7, 0xe9, 0x0f,
+	0x48, 0xc3, 0x1c, 0x59, 0x0d, 0xd3, 0xb6, 0x1d, 0xdf, 0xf4, 0x2d, 0xc7, 0xf6, 0x78, 0x2d, 0xfe,
+	0x23, 0x0d, 0xaa, 0x06, 0xf1, 0x46, 0x8e, 0xed, 0x91, 0xe7, 0xc4, 0xec, 0x11, 0x17, 0xdd, 0x01,
+	0xe8, 0x0e, 0xc6, 0x9e, 0x4f, 0xdc, 0x8e, 0xd5, 0xab, 0x6b, 0x6b, 0xda, 0xc6, 0xbc, 0x51, 0x14,
+	0x94, 0xbd, 0x1e, 0xba, 0x05, 0xc5, 0x21, 0x19, 0x9e, 0xf0, 0xda, 0x0c, 0xab, 0x2d, 0x70, 0xc2,
+	0x5e, 0x0f, 0xe9, 0x50, 0x70, 0xc9, 0xb9, 0xe5, 0x59, 0x8e, 0x5d, 0xcf, 0xae, 0x69, 0x1b, 0x59,
+	0x23, 0x28, 0xd3, 0x86, 0xae, 0xf9, 0xc6, 0xef, 0xf8, 0xc4, 0x1d, 0xd6, 0xe7, 0x79, 0x43, 0x4a,
+	0x68, 0x13, 0x77, 0x88, 0xff, 0x77, 0x01, 0xca, 0x86, 0x69, 0xf7, 0x89, 0x41, 0x7e, 0x30, 0x26,
+	0x9e, 0x8f, 0x6a, 0x90, 0x3d, 0x23, 0x97, 0x4c, 0x7c, 0xd9, 0xa0, 0x9f, 0xbc, 0xbd, 0xdd, 0x27,
+	0x1d, 0x62, 0x73, 0xc1, 0x65, 0xda, 0xde, 0xee, 0x93, 0xa6, 0xdd, 0x43, 0xcb, 0xb0, 0x30, 0xb0,
+	0x86, 0x96, 0x2f, 0xa4, 0xf2, 0x42, 0x44, 0x9d, 0xf9, 0x98, 0x3a, 0x3b, 0x00, 0x9e, 0xe3, 0xfa,
+	0x1d, 0xc7, 0xed, 0x11, 0xb7, 0xbe, 0xb0, 0xa6, 0x6d, 0x54, 0xb7, 0xee, 0x6f, 0xaa, 0x03, 0xb1,
+	0xa9, 0x2a, 0xb4, 0x79, 0xec, 0xb8, 0x7e, 0x8b, 0x62, 0x8d, 0xa2, 0x27, 0x3f, 0xd1, 0x87, 0x50,
+	0x62, 0x4c, 0x7c, 0xd3, 0xed, 0x13, 0xbf, 0x9e, 0x63, 0x5c, 0x1e, 0xbc, 0x85, 0x4b, 0x9b, 0x81,
+	0x0d, 0xf0, 0x82, 0x6f, 0x84, 0xa1, 0xec, 0x11, 0xd7, 0x32, 0x07, 0xd6, 0x17, 0xe6, 0xc9, 0x80,
+	0xd4, 0xf3, 0x6b, 0xda, 0x46, 0xc1, 0x88, 0xd0, 0x68, 0xff, 0xcf, 0xc8, 0xa5, 0xd7, 0x71, 0xec,
+	0xc1, 0x65, 0xbd, 0xc0, 0x00, 0x05, 0x4a, 0x68, 0xd9, 0x83, 0x4b, 0x36, 0x68, 0xce, 0xd8, 0xf6,
+	0x79, 0x6d, 0x91, 0xd5, 0x16, 0x19, 0x85, 0x55, 0x6f, 0x40, 0x6d, 0x68, 0xd9, 0x9d, 0xa1, 0xd3,
+	0xeb, 0x04, 0x06, 0x01, 0x66, 0x90, 0xea, 0xd0, 0xb2, 0x5f, 0x38, 0x3d, 0x43, 0x9a, 0x85, 0x22,
+	0xcd, 0x8b, 0x28, 0xb2, 0x24, 0x90, 0xe6, 0x85, 0x8a, 0xdc, 0x84, 0x25, 0xca, 0xb3, 0xeb, 0x12,
+	0xd3, 0x27, 0x21, 0xb8, 0xcc, 0xc0, 0xd7, 0x86, 0x96, 0xbd, 0xc3, 0x6a, 0x22, 0x78, 0xf3, 0x62,
+	0x02, 0x5f, 0x11, 0x78, 0xf3, 0x22, 0x86, 0xbf, 0x07, 0x15, 0x8a, 0xf7, 0x7c, 0x73, 0x40, 0x6c,
+	0xe2, 0x79, 0xf5, 0x2a, 0x43, 0x96, 0x87, 0xe6, 0xc5, 0xb1, 0xa4, 0xd1, 0x7e, 0x8f, 0xcc, 0x3e,
+	0xe9, 0xf8, 0xce, 0x19, 0xb1, 0xeb, 0x8b, 0x6c, 0x56, 0x14, 0x29, 0xa5, 0x4d, 0x09, 0xe8, 0x01,
+	0x54, 0xb9, 0x59, 0xde, 0x58, 0x03, 0x9f, 0xb8, 0xa4, 0x57, 0xaf, 0x31, 0xd3, 0x54, 0x18, 0xf5,
+	0x43, 0x41, 0xc4, 0x9b, 0x50, 0x0c, 0x86, 0x17, 0x15, 0x60, 0xfe, 0xb0, 0x75, 0xd8, 0xac, 0xcd,
+	0x21, 0x80, 0xdc, 0xf6, 0xf1, 0x4e, 0xf3, 0x70, 0xb7, 0xa6, 0xa1, 0x12, 0xe4, 0x77, 0x9b, 0xbc,
+	0x90, 0xc1, 0x4f, 0x01, 0xc2, 0x81, 0x44, 0x79, 0xc8, 0xee, 0x37, 0x3f, 0xad, 0xcd, 0x51, 0xcc,
+	0xab, 0xa6, 0x71, 0xbc, 0xd7, 0x3a, 0xac, 0x69, 0xb4, 0xf1, 0x8e, 0xd1, 0xdc, 0x6e, 0x37, 0x6b,
+	0x19, 0x8a, 0x78, 0xd1, 0xda, 0xad, 0x65, 0x51, 0x11, 0x16, 0x5e, 0x6d, 0x1f, 0xbc, 0x6c, 0xd6,
+	0xe6, 0xf1, 0x3f, 0x69, 0x50, 0x11, 0x53, 0x83, 0x2f, 0x3f, 0xf4, 0x01, 0xe4, 0x4e, 0xd9, 0x12,
+	0x64, 0xb3, 0xbe, 0xb4, 0x75, 0x3b, 0x36, 0x8f, 0x22, 0xcb, 0xd4, 0x10, 0x58, 0x84, 0x21, 0x7b,
+	0x76, 0xee, 0xd5, 0x33, 0x6b, 0xd9, 0x8d, 0xd2, 0x56, 0x6d, 0x93, 0xfb, 0x86, 0xcd, 0x7d, 0x72,
+	0xf9, 0xca, 0x1c, 0x8c, 0x89, 0x41, 0x2b, 0x11, 0x82, 0xf9, 0xa1, 0xe3, 0x12, 0xb6, 0x38, 0x0a,
+	0x06, 0xfb, 0xa6, 0x2b, 0x86, 0x19, 0x41, 0x2c, 0x0c, 0x5e, 0x40, 0x0f, 0x61, 0xd1, 0x26, 0x17,
+	0x7e, 0x47, 0x31, 0xea, 0x02, 0x33, 0x6a, 0x85, 0x92, 0x8f, 0xa4, 0x61, 0xf1, 0xcf, 0x34, 0x80,
+	0xa3, 0xb1, 0x9f, 0xbe, 0x5a, 0x97, 0x61, 0xe1, 0x9c, 0x2a, 0x20, 0x56, 0x2a, 0x2f, 0xb0, 0x65,
+	0x4a, 0x4c, 0x8f, 0x04, 0xcb, 0x94, 0x16, 0xd0, 0x0d, 0xc8, 0x8f, 0x5c, 0x72, 0xde, 0x39, 0x3b,
+	0x67, 0xca, 0x14, 0x8c, 0x1c, 0x2d, 0xee, 0x9f, 0xa3, 0x75, 0x28, 0x5b, 0x7d, 0xdb, 0x71, 0x49,
+	0x87, 0xf3, 0x5a, 0x60, 0xb5, 0x25, 0x4e, 0x63, 0xfd, 0x53, 0x20, 0x9c, 0x71, 0x4e, 0x85, 0x1c,
+	0x50, 0x12, 0xb6, 0xa1, 0xc4, 0x54, 0x9d, 0xc9, 0xcc, 0xef, 0x86, 0x3a, 0x66, 0xd6, 0xb4, 0x44,
+	0x53, 0x0b, 0xad, 0xf1, 0xf7, 0x00, 0xed, 0x92, 0x01, 0xf1, 0xc9, 0x2c, 0x0e, 0x4d, 0xb1, 0x49,
+	0x56, 0xb5, 0x09, 0xfe, 0x89, 0x06, 0x4b, 0x11, 0xf6, 0x33, 0x75, 0xab, 0x0e, 0xf9, 0x1e, 0x63,
+	0xc6, 0x35, 0xc8, 0x1a, 0xb2, 0x88, 0x1e, 0x43, 0x41, 0x28, 0xe0, 0xd5, 0xb3, 0x29, 0x93, 0x2b,
+	0xcf, 0x75, 0xf2, 0xf0, 0xcf, 0x32, 0x50, 0x14, 0x1d, 0x6d, 0x8d, 0xd0, 0x36, 0x54, 0x5c, 0x5e,
+	0xe8, 0xb0, 0xfe, 0x08, 0x8d, 0xf4, 0x74, 0xbf, 0xf8, 0x7c, 0xce, 0x28, 0x8b, 0x26, 0x8c, 0x8c,
+	0x7e, 0x1d, 0x4a, 0x92, 0xc5, 0x68, 0xec, 0x0b, 0x93, 0xd7, 0xa3, 0x0c, 0xc2, 0xf9, 0xf7, 0x7c,
+	0xce, 0x00, 0x01, 0x3f, 0x1a, 0xfb, 0xa8, 0x0d, 0xcb, 0xb2, 0x31, 0xef, 0x8d, 0x50, 0x23, 0xcb,
+	0xb8, 0xac, 0x45, 0xb9, 0x4c, 0x0e, 0xd5, 0xf3, 0x39, 0x03, 0x89, 0xf6, 0x4a, 0xa5, 0xaa, 0x92,
+	0x7f, 0xc1, 0xe3, 0xc9, 0x84, 0x4a, 0xed, 0x0b, 0x7b, 0x52, 0xa5, 0xf6, 0x85, 0xfd, 0xb4, 0x08,
+	0x79, 0x51, 0xc2, 0xff, 0x90, 0x01, 0x90, 0xa3, 0xd1, 0x1a, 0xa1, 0x5d, 0xa8, 0xba, 0xa2, 0x14,
+	0xb1, 0xd6, 0xad, 0x44, 0x6b, 0x89, 0x41, 0x9c, 0x33, 0x2a, 0xb2, 0x11, 0x57, 0xee, 0x3b, 0x50,
+	0x0e, 0xb8, 0x84, 0x06, 0xbb, 0x99, 0x60, 0xb0, 0x80, 0x43, 0x49, 0x36, 0xa0, 0x26, 0x7b, 0x0d,
+	0x2b, 0x41, 0xfb, 0x04, 0x9b, 0xad, 0x4f, 0xb1, 0x59, 0xc0, 0x70, 0x49, 0x72, 0x50, 0xad, 0xa6,
+	0x2a, 0x16, 0x9a, 0xed, 0x66, 0x82, 0xd9, 0x26, 0x15, 0xa3, 0x86, 0x03, 0x28, 0xc8, 0x22, 0xfe,
+	0xef, 0x2c, 0xe4, 0x77, 0x9c, 0xe1, 0xc8, 0x74, 0xe9, 0x68, 0xe4, 0x5c, 0xe2, 0x8d, 0x07, 0x3e,
+	0x33, 0x57, 0x75, 0xeb, 0x5e, 0x94, 0xa3, 0x80, 0xc9, 0xbf, 0x06, 0x83, 0x1a, 0xa2, 0x09, 0x6d,
+	0x2c, 0x22, 0x76, 0xe6, 0x0a, 0x8d, 0x45, 0xbc, 0x16, 0x4d, 0xe4, 0x42, 0xce, 0x86, 0x0b, 0x59,
+	0x87, 0xfc, 0x39, 0x71, 0xc3, 0x5d, 0xc6, 0xf3, 0x39, 0x43, 0x12, 0xd0, 0xbb, 0xb0, 0x18, 0x8f,
+	0x78, 0x0b, 0x02, 0x53, 0xed, 0xc6, 0x03, 0x5e, 0x39, 0x12, 0x76, 0x73, 0x02, 0x57, 0x1a, 0x2a,
+	0x51, 0xf7, 0xba, 0xf4, 0xab, 0x74, 0x8b, 0x50, 0x7e, 0x3e, 0x27, 0x3d, 0xeb, 0x75, 0xe9, 0x59,
+	0x0b, 0xa2, 0x15, 0x2f, 0x46, 0x9d, 0xcc, 0x77, 0xa3, 0x4e, 0x06, 0x7f, 0x17, 0x2a, 0x11, 0x03,
+	0xd1, 0xf8, 0xd4, 0xfc, 0xf8, 0xe5, 0xf6, 0x01, 0x0f, 0x66, 0xcf, 0x58, 0xfc, 0x32, 0x6a, 0x1a,
+	0x8d, 0x89, 0x07, 0xcd, 0xe3, 0xe3, 0x5a, 0x06, 0x55, 0xa0, 0x78, 0xd8, 0x6a, 0x77, 0x38, 0x2a,
+	0x8b, 0x9f, 0x41, 0x25, 0x62, 0x25, 0x35, 0x06, 0xce, 0x29, 0x31, 0x50, 0x93, 0x31, 0x30, 0x13,
+	0xc6, 0x40, 0x16, 0x0e, 0x0f, 0x9a, 0xdb, 0xc7, 0xcd, 0xda, 0xfc, 0xd3, 0x2a, 0x94, 0xb9, 0x7d,
+	0x3b, 0x63, 0xdb, 0x72, 0x6c, 0xfc, 0x57, 0x1a, 0x40, 0xb8, 0x9a, 0x50, 0x03, 0xf2, 0x5d, 0x2e,
+	0xa7, 0xae, 0x31, 0x67, 0xb4, 0x92, 0x38, 0x64, 0x86, 0x44, 0xa1, 0x6f, 0x42, 0xde, 0x1b, 0x77,
+	0xbb, 0xc4, 0x93, 0xa1, 0xf1, 0x46, 0xdc, 0x1f, 0x0a, 0x6f, 0x65, 0x48, 0x1c, 0x6d, 0xf2, 0xc6,
+	0xb4, 0x06, 0x63, 0x16, 0x28, 0xa7, 0x37, 0x11, 0x38, 0xfc, 0xe7, 0x1a, 0x94, 0x94, 0xc9, 0xfb,
+	0x4b, 0x3a, 0xe1, 0xdb, 0x50, 0x64, 0x3a, 0x90, 0x9e, 0x70, 0xc3, 0x05, 0x23, 0x24, 0xa0, 0xff,
+	0x07, 0x45, 0xb9, 0x02, 0xa4, 0x27, 0xae, 0x27, 0xb3, 0x6d, 0x8d, 0x8c, 0x10, 0x8a, 0x7f, 0xac,
+	0xc1, 0x35, 0x66, 0x96, 0x2e, 0xdd, 0xf0, 0x4b, 0x43, 0xaa, 0x5b, 0x62, 0x2d, 0xb6, 0x25, 0xd6,
+	0xa1, 0x30, 0x3a, 0xbd, 0xf4, 0xac, 0xae, 0x39, 0x10, 0x6a, 0x04, 0x65, 0xf4, 0x6b, 0x74, 0xbd,
+	0xf9, 0xa6, 0x65, 0x0b, 0x15, 0xd6, 0x13, 0xec, 0x2f, 0x04, 0xf9, 0xc4, 0x66, 0x1f, 0xa2, 0x01,
+	0xde, 0x83, 0xa5, 0x84, 0x6a, 0x74, 0x1d, 0x68, 0x48, 0x7b, 0x63, 0x5d, 0x88, 0x98, 0x28, 0x4a,
+	0x11, 0x0d, 0x33, 0x51, 0x0d, 0xf1, 0x47, 0x80, 0x54, 0x56, 0xb3, 0x58, 0x1d, 0x57, 0xa0, 0xf4,
+	0xdc, 0xf4, 0x4e, 0x85, 0x61, 0xf0, 0x63, 0xa8, 0xd0, 0xe2, 0xfe, 0xab, 0x2b, 0x58, 0x8a, 0x1d,
+	0x9b, 0x24, 0x7a, 0xa6, 0xa1, 0x47, 0x30, 0x7f, 0x6a, 0x7a, 0xa7, 0xac, 0xa3, 0x15, 0x83, 0x7d,
+	0xa3, 0x77, 0xa1, 0xd6, 0xe5, 0x9d, 0xec, 0xc4, 0x0e, 0x53, 0x8b, 0x82, 0x2e, 0xbd, 0x01, 0xfe,
+	0x04, 0xca, 0xbc, 0x0f, 0xbf, 0x6a, 0x25, 0xf0, 0x35, 0x58, 0x3c, 0xb6, 0xcd, 0x91, 0x77, 0xea,
+	0xc8, 0x20, 0x4b, 0x3b, 0x5d, 0x0b, 0x69, 0x33, 0x49, 0x7c, 0x07, 0x16, 0x5d, 0x32, 0x34, 0x2d,
+	0xdb, 0xb2, 0xfb, 0x9d, 0x93, 0x4b, 0x9f, 0x78, 0xe2, 0x28, 0x59, 0x0d, 0xc8, 0x4f, 0x29, 0x95,
+	0xaa, 0x76, 0x32, 0x70, 0x4e, 0x84, 0xb7, 0x65, 0xdf, 0xf8, 0xc7, 0x19, 0x28, 0xbf, 0x36, 0xfd,
+	0xae, 0x1c, 0x3a, 0xb4, 0x07, 0xd5, 0xc0, 0xc7, 0x32, 0x4a, 0x5d, 0x4b, 0x8a, 0xf4, 0xac, 0x8d,
+	0x3c, 0x64, 0xc8, 0x20, 0x5d, 0xe9, 0xaa, 0x04, 0xc6, 0xca, 0xb4, 0xbb, 0x64, 0x10, 0xb0, 0xca,
+	0xa4, 0xb3, 0x62, 0x40, 0x95, 0x95, 0x4a, 0x40, 0x2d, 0xa8, 0x8d, 0x5c, 0xa7, 0xef, 0x12, 0xcf,
+	0x0b, 0x98, 0xf1, 0x68, 0x8a, 0x13, 0x98, 0x1d, 0x09, 0x68, 0xc8, 0x6e, 0x71, 0x14, 0x25, 0x3d,
+	0x5d, 0x0c, 0xb7, 0x55, 0xdc, 0x47, 0xfe, 0xed, 0x3c, 0xa0, 0xc9, 0x4e, 0xfd, 0xa2, 0x3b, 0xcd,
+	0x07, 0x50, 0xf5, 0x7c, 0xd3, 0x9d, 0x98, 0x6c, 0x15, 0x46, 0x0d, 0x02, 0xcf, 0x3b, 0x10, 0x28,
+	0xd4, 0xb1, 0x1d, 0xdf, 0x7a, 0x73, 0x29, 0x36, 0xeb, 0x55, 0x49, 0x3e, 0x64, 0x54, 0xd4, 0x84,
+	0x3c, 0x3f, 0x6d, 0x79, 0xf5, 0x85, 0xb5, 0xec, 0x46, 0x75, 0xeb, 0xf1, 0xdb, 0x86, 0x61, 0x93,
+	0x1f, 0xc4, 0xda, 0x97, 0x23, 0x62, 0xc8, 0xb6, 0xea, 0x06, 0x38, 0x17, 0x39, 0x14, 0xdc, 0x84,
+	0xc2, 0xe7, 0x94, 0x05, 0xcd, 0x3f, 0xe4, 0xf9, 0x9e, 0x95, 0x95, 0x79, 0xfa, 0xe1, 0x8d, 0x6b,
+	0xf6, 0x87, 0xc4, 0xf6, 0xe5, 0x09, 0x59, 0x96, 0x69, 0xb3, 0x33, 0x72, 0xd9, 0xe9, 0xd3, 0xd9,
+	0x44, 0xcf, 0xc7, 0x45, 0x23, 0x7f, 0x46, 0x2e, 0x9f, 0x0d, 0x9c, 0x13, 0x71, 0xb2, 0xee, 0xb8,
+	0xa4, 0x4f, 0x2e, 0xd8, 0xb1, 0xb8, 0xc8, 0x4e, 0xd6, 0x06, 0x2d, 0xd3, 0x03, 0x06, 0x8b, 0xb0,
+	0x1d, 0xe1, 0xac, 0x4a, 0xcc, 0x7c, 0x25, 0x46, 0x3b, 0x62, 0xa4, 0xf0, 0x54, 0x53, 0x56, 0x4f,
+	0x35, 0xeb, 0x6c, 0xe7, 0x33, 0x1e, 0xca, 0x73, 0x54, 0x85, 0x37, 0xe4, 0x34, 0x7e, 0x3c, 0xbd,
+	0x07, 0x15, 0xcb, 0xb6, 0x7c, 0xcb, 0x1c, 0xd0, 0x63, 0xae, 0x4f, 0xd8, 0x11, 0xb7, 0x60, 0x94,
+	0x05, 0xf1, 0x98, 0xd2, 0xf0, 0x36, 0x40, 0x68, 0x1f, 0x1a, 0x32, 0x0f, 0x5b, 0x47, 0x2f, 0xdb,
+	0xb5, 0x39, 0x54, 0x86, 0xc2, 0x61, 0x6b, 0xb7, 0x79, 0xd0, 0x64, 0xf1, 0x95, 0x95, 0x82, 0x13,
+	0x27, 0x2b, 0xbd, 0x68, 0xed, 0xee, 0x7d, 0xf8, 0x69, 0x2d, 0x8b, 0x1b, 0x72, 0x9e, 0x44, 0x26,
+	0xa8, 0x6a, 0x48, 0x2d, 0x62, 0x48, 0x7c, 0x1d, 0x96, 0x93, 0x66, 0x25, 0xfe, 0xd7, 0x0c, 0x54,
+	0xc4, 0xd2, 0x9b, 0x69, 0xfd, 0xab, 0xa2, 0x33, 0xd1, 0x31, 0xac, 0x43, 0x9e, 0x2f, 0xc9, 0x9e,
+	0x38, 0xf8, 0xc8, 0x22, 0x1d, 0x5d, 0xbe, 0xc2, 0x48, 0x4f, 0x4c, 0xbd, 0xa0, 0x9c, 0xe8, 0x33,
+	0x17, 0x12, 0x7d, 0x26, 0x35, 0x7a, 0xb0, 0xc4, 0x4d, 0x4f, 0xec, 0xb3, 0x8a, 0x46, 0x59, 0xae,
+	0x5e, 0x4a, 0x8b, 0xcc, 0xa4, 0x7c, 0x6c, 0x26, 0xc5, 0x07, 0xb6, 0x30, 0x39, 0xb0, 0x0f, 0x20,
+	0x47, 0xce, 0x89, 0xed, 0x7b, 0xf5, 0x12, 0x8b, 0x96, 0x15, 0x79, 0x74, 0x6a, 0x52, 0xaa, 0x21,
+	0x2a, 0xf1, 0xb7, 0xe1, 0x1a, 0x3b, 0xa2, 0x3e, 0x73, 0x4d, 0x5b, 0x3d, 0x4b, 0xb7, 0xdb, 0x07,
+	0x62, 0x44, 0xe8, 0x27, 0xaa, 0x42, 0x66, 0x6f, 0x57, 0xd8, 0x29, 0xb3, 0xb7, 0x8b, 0x7f, 0xa4,
+	0x01, 0x52, 0xdb, 0xcd, 0x34, 0x14, 0x31, 0xe6, 0x52, 0x7c, 0x36, 0x14, 0xbf, 0x0c, 0x0b, 0xc4,
+	0x75, 0x1d, 0x97, 0x19, 0xbd, 0x68, 0xf0, 0x02, 0xbe, 0x2f, 0x74, 0x30, 0xc8, 0xb9, 0x73, 0x16,
+	0xf8, 0x1e, 0xce, 0x4d, 0x0b, 0x54, 0xdd, 0x87, 0xa5, 0x08, 0x6a, 0xa6, 0x88, 0xfd, 0x21, 0x2c,
+	0x32, 0x66, 0x3b, 0xa7, 0xa4, 0x7b, 0x36, 0x72, 0x2c, 0x7b, 0x42, 0x1e, 0x1d, 0xdc, 0x30, 0xb0,
+	0xd0, 0x7e, 0xf0, 0x8e, 0x95, 0x03, 0x62, 0xbb, 0x7d, 0x80, 0x3f, 0x85, 0xeb, 0x31, 0x3e, 0x52,
+	0xfd, 0xdf, 0x82, 0x52, 0x37, 0x20, 0x7a, 0x62, 0xab, 0x79, 0x27, 0xaa, 0x5c, 0xbc, 0xa9, 0xda,
+	0x02, 0xb7, 0xe0, 0xc6, 0x04, 0xeb, 0x99, 0xfa, 0xfc, 0x0e, 0xac, 0x30, 0x86, 0xfb, 0x84, 0x8c,
+	0xb6, 0x07, 0xd6, 0x79, 0xaa, 0xa5, 0x47, 0x70, 0x3d, 0x0e, 0xfc, 0x6a, 0xe7, 0x05, 0xfe, 0x0d,
+	0x21, 0xb1, 0x6d, 0xd1, 0x69, 0x7f, 0x90, 0xae, 0x1b, 0x8d, 0xe2, 0x34, 0x53, 0x29, 0x36, 0x95,
+	0xec, 0x1b, 0xff, 0x8d, 0x06, 0x37, 0x26, 0x9a, 0x7f, 0xc5, 0x33, 0x79, 0x15, 0xa0, 0x4f, 0x97,
+	0x0c, 0xe9, 0xd1, 0x0a, 0x9e, 0xf8, 0x52, 0x28, 0x81, 0x9e, 0x34, 0x6e, 0x95, 0x85, 0x9e, 0xcb,
+	0x62, 0x9e, 0xb3, 0x9f, 0xc0, 0x11, 0xde, 0x81, 0x12, 0x23, 0x50, 0x17, 0x3d, 0xf6, 0x26, 0x06,
+	0xe3, 0xf7, 0xc5, 0xb4, 0x97, 0x8d, 0x66, 0xea, 0xd7, 0x37, 0x21, 0xc7, 0x22, 0x8a, 0x3c, 0xc9,
+	0xdc, 0x4c, 0x98, 0x8f, 0x5c, 0x0f, 0x43, 0x00, 0xf1, 0x5f, 0x6b, 0x90, 0x7b, 0xc1, 0x92, 0xf2,
+	0x8a, 0x6a, 0xf3, 0x72, 0x2c, 0x6c, 0x73, 0xc8, 0xf3, 0x72, 0x45, 0x83, 0x7d, 0xb3, 0x8d, 0x3f,
+	0x21, 0xee, 0x4b, 0xe3, 0x80, 0x9f, 0x30, 0x8a, 0x46, 0x50, 0xa6, 0x36, 0xeb, 0x0e, 0x2c, 0x62,
+	0xfb, 0xac, 0x76, 0x9e, 0xd5, 0x2a, 0x14, 0x7a, 0x78, 0xb1, 0xbc, 0x03, 0x62, 0xba, 0xb6, 0x48,
+	0xa3, 0x17, 0x8c, 0x90, 0xc0, 0x6b, 0x5f, 0x5b, 0x3e, 0x4b, 0xe0, 0xe6, 0x64, 0xad, 0x20, 0xe0,
+	0xef, 0x43, 0x8d, 0x6b, 0xb9, 0xdd, 0xeb, 0x29, 0xdb, 0xee, 0x40, 0x17, 0x2d, 0xa6, 0x4b, 0x44,
+	0x56, 0x66, 0xaa, 0xac, 0x6c, 0x5c, 0xd6, 0xdf, 0x69, 0x70, 0x4d, 0x11, 0x36, 0xd3, 0x88, 0xbc,
+	0x07, 0x39, 0x7e, 0xe5, 0x21, 0x76, 0x87, 0xcb, 0xd1, 0x56, 0x5c, 0x8c, 0x21, 0x30, 0x68, 0x13,
+	0xf2, 0xfc, 0x4b, 0x1e, 0xdf, 0x92, 0xe1, 0x12, 0x84, 0x1f, 0xc0, 0x92, 0x20, 0x91, 0xa1, 0x93,
+	0xb4, 0xa8, 0xd8, 0x40, 0xe2, 0xdf, 0x85, 0xe5, 0x28, 0x6c, 0xa6, 0x2e, 0x29, 0x4a, 0x66, 0xae,
+	0xa2, 0xe4, 0xb6, 0x54, 0xf2, 0xe5, 0xa8, 0x67, 0xfa, 0x69, 0x4a, 0x46, 0x46, 0x33, 0x13, 0x1d,
+	0xcd, 0xb0, 0x03, 0x92, 0xc5, 0xd7, 0xda, 0x81, 0x25, 0x39, 0x1d, 0x0e, 0x2c, 0x2f, 0x38, 0xe2,
+	0x7c, 0x01, 0x48, 0x25, 0x7e, 0xad, 0x0a, 0x3d, 0x94, 0xe6, 0x38, 0x72, 0x9d, 0xa1, 0x93, 0x6a,
+	0x52, 0xfc, 0x7b, 0xb0, 0x12, 0xc3, 0x7d, 0xdd, 0x76, 0xdb, 0x25, 0x72, 0x2f, 0x24, 0xed, 0xf6,
+	0x11, 0x20, 0x95, 0x38, 0x53, 0xc4, 0xfb, 0x67, 0x0d, 0xf4, 0x90, 0x59, 0xb8, 0x03, 0x9d, 0xa9,
+	0x97, 0xd4, 0x8b, 0x39, 0x23, 0x8b, 0xf4, 0xf6, 0x65, 0x1c, 0xca, 0x1a, 0x0a, 0x05, 0x3d, 0xa4,
+	0x59, 0xd8, 0xd1, 0xc0, 0xbc, 0x24, 0xbd, 0xd7, 0xae, 0xe5, 0x13, 0x4f, 0x84, 0x8d, 0x18, 0x95,
+	0x7a, 0xcf, 0x9e, 0x63, 0x13, 0xb1, 0xff, 0x64, 0xdf, 0x34, 0x91, 0xd1, 0x3b, 0x39, 0xb6, 0xbe,
+	0x20, 0x62, 0xc7, 0x29, 0x4a, 0xb8, 0x01, 0xd7, 0x5e, 0x38, 0xe7, 0xe4, 0x80, 0x6b, 0x12, 0xba,
+	0x37, 0x9e, 0xe7, 0x0a, 0xc6, 0x34, 0x28, 0x53, 0x2b, 0xaa, 0x0d, 0x66, 0xb2, 0xe2, 0xbf, 0x69,
+	0x50, 0xde, 0x1e, 0x98, 0xee, 0x50, 0x0a, 0xfe, 0x0e, 0xe4, 0x78, 0xda, 0x44, 0x24, 0x4c, 0x1f,
+	0x46, 0xd9, 0xa8, 0x58, 0x5e, 0xd8, 0xee, 0xf2, 0x2c, 0x0e, 0x6f, 0x45, 0x15, 0x17, 0xd7, 0xbc,
+	0xbb, 0xb1, 0x6b, 0xdf, 0x5d, 0xf4, 0x3e, 0x2c, 0x98, 0xb4, 0x09, 0x33, 0x5a, 0x35, 0x9e, 0x37,
+	0x63, 0xdc, 0xd8, 0xe1, 0x8e, 0xa3, 0xf0, 0x07, 0x50, 0x52, 0x24, 0xd0, 0xcc, 0xe0, 0xb3, 0xa6,
+	0x38, 0xd0, 0x6c, 0xef, 0xb4, 0xf7, 0x5e, 0xf1, 0x84, 0x61, 0x15, 0x60, 0xb7, 0x19, 0x94, 0x33,
+	0xf8, 0x13, 0xd1, 0x4a, 0xc4, 0x35, 0x55, 0x1f, 0x2d, 0x4d, 0x9f, 0xcc, 0x95, 0xf4, 0xb9, 0x80,
+	0x8a, 0xe8, 0xfe, 0xac, 0x71, 0x9a, 0xf1, 0x4b, 0x89, 0xd3, 0x8a, 0xf2, 0x86, 0x00, 0xe2, 0x45,
+	0xa8, 0x88, 0xc8, 0x2d, 0x16, 0xd2, 0x4f, 0xb3, 0x50, 0x95, 0x94, 0x59, 0x2f, 0x76, 0x64, 0x4e,
+	0x9a, 0x47, 0x7a, 0x59, 0x54, 0xa6, 0x6b, 0x56, 0x9d, 0xae, 0x94, 0x3e, 0xe0, 0x72, 0xf8, 0xe5,
+	0xbc, 0x28, 0xd1, 0xb0, 0x4a, 0xaf, 0xe9, 0xf7, 0xec, 0x1e, 0xb9, 0x60, 0x33, 0x7c, 0xde, 0x08,
+	0x09, 0x74, 0x18, 0xe4, 0x25, 0x7e, 0x3d, 0x17, 0xbd, 0xd4, 0x47, 0x8f, 0xa0, 0x46, 0xbf, 0xb7,
+	0x47, 0xa3, 0x81, 0x45, 0x7a, 0x9c, 0x41, 0x9e, 0x61, 0x26, 0xe8, 0x54, 0x3a, 0x3b, 0x57, 0x78,
+	0xf5, 0x02, 0x0b, 0x13, 0xa2, 0x84, 0xd6, 0xa0, 0xc4, 0xf5, 0xdb, 0xb3, 0x5f, 0x7a, 0x84, 0x9d,
+	0xdc, 0xb3, 0x86, 0x4a, 0x42, 0x9b, 0x80, 0xc4, 0x11, 0xcf, 0xb2, 0xfb, 0x46, 0xf4, 0x76, 0x3b,
+	0xa1, 0x06, 0x7d, 0x00, 0x2b, 0x82, 0x4a, 0x7a, 0x2f, 0x47, 0x6d, 0xc7, 0x88, 0x5e, 0x73, 0x27,
+	0x57, 0x52, 0xb7, 0xb7, 0x3d, 0xf6, 0x4f, 0x9b, 0x36, 0xbd, 0x8b, 0x97, 0xa3, 0xb5, 0x0c, 0x88,
+	0x12, 0x77, 0x2d, 0x4f, 0xa5, 0x36, 0x61, 0x89, 0x52, 0x69, 0x9a, 0xb3, 0xab, 0x84, 0x46, 0xb9,
+	0xf1, 0xd2, 0x62, 0x1b, 0x2f, 0xd3, 0xf3, 0x3e, 0x77, 0xdc, 0x9e, 0x18, 0xa6, 0xa0, 0x8c, 0x77,
+	0x39, 0xf3, 0x97, 0x5e, 0x64, 0x7b, 0xf4, 0x8b, 0x72, 0xd9, 0x08, 0xb9, 0x3c, 0x23, 0xfe, 0x14,
+	0x2e, 0xf8, 0x31, 0xac, 0x48, 0xa4, 0xb8, 0xc0, 0x99, 0x02, 0x6e, 0xc1, 0x1d, 0x09, 0xde, 0x39,
+	0xa5, 0x99, 0xa4, 0x23, 0x21, 0xf0, 0x97, 0xd5, 0xf3, 0x29, 0xd4, 0x03, 0x3d, 0xd9, 0xa9, 0xd6,
+	0x19, 0xa8, 0x0a, 0x8c, 0x3d, 0x31, 0xff, 0x8b, 0x06, 0xfb, 0xa6, 0x34, 0xd7, 0x19, 0x04, 0xdb,
+	0x58, 0xfa, 0x8d, 0x77, 0xe0, 0xa6, 0xe4, 0x21, 0xce, 0x9b, 0x51, 0x26, 0x13, 0x0a, 0x25, 0x31,
+	0x11, 0x06, 0xa3, 0x4d, 0xa7, 0x9b, 0x5d, 0x45, 0x46, 0x4d, 0xcb, 0x78, 0x6a, 0x0a, 0xcf, 0x15,
+	0x58, 0x92, 0x8a, 0xa9, 0xbb, 0x0d, 0x41, 0xa6, 0x0c, 0x54, 0xb2, 0x18, 0x08, 0x4a, 0x9e, 0x18,
+	0x88, 0x09, 0xd6, 0xdf, 0x83, 0xd5, 0x40, 0x09, 0x6a, 0xb7, 0x23, 0xe2, 0x0e, 0x2d, 0xcf, 0x53,
+	0x32, 0xfe, 0x49, 0x1d, 0x7f, 0x08, 0xf3, 0x23, 0x22, 0xfc, 0x63, 0x69, 0x0b, 0x6d, 0xf2, 0x67,
+	0x43, 0x9b, 0x4a, 0x63, 0x56, 0x8f, 0x7b, 0x70, 0x57, 0x72, 0xe7, 0x16, 0x4d, 0x64, 0x1f, 0x57,
+	0x4a, 0x66, 0x20, 0x33, 0x29, 0x19, 0xc8, 0x6c, 0xec, 0x1a, 0xea, 0x23, 0x40, 0xea, 0xda, 0x9a,
+	0x29, 0xee, 0xed, 0xc3, 0x52, 0x64, 0x49, 0xce, 0xc4, 0xec, 0x04, 0x96, 0xa3, 0x2b, 0x79, 0x26,
+	0x97, 0xbc, 0x0c, 0x0b, 0x3c, 0x61, 0xc4, 0xa7, 0x1b, 0x2f, 0xe0, 0xfd, 0x70, 0x6e, 0xcc, 0x7c,
+	0x30, 0xc1, 0x66, 0xc8, 0x8c, 0x4d, 0xc9, 0x59, 0xf5, 0xa5, 0xa3, 0x29, 0x37, 0xee, 0xbc, 0x80,
+	0x0f, 0xe1, 0x7a, 0xdc, 0x4d, 0xcc, 0xa4, 0xf2, 0x2b, 0x58, 0x95, 0xfc, 0xe2, 0x9e, 0x64, 0x26,
+	0xbe, 0x1f, 0x87, 0xce, 0x40, 0x71, 0x28, 0x33, 0xb1, 0x34, 0x40, 0x4f, 0xf2, 0x2f, 0xbf, 0x8a,
+	0xf9, 0x1a, 0xb8, 0x9b, 0x99, 0x98, 0x79, 0x21, 0xb3, 0xd9, 0x87, 0x3f, 0xf4, 0x11, 0xd9, 0xa9,
+	0x3e, 0x42, 0x2c, 0x92, 0xd0, 0x8b, 0x7d, 0x05, 0x93, 0x4e, 0xc8, 0x08, 0x1d, 0xe8, 0xac, 0x32,
+	0x68, 0x0c, 0x09, 0x64, 0xb0, 0x82, 0x9c, 0xd8, 0xaa, 0xdb, 0x9d, 0x69, 0x30, 0x5e, 0x87, 0xbe,
+	0x73, 0xc2, 0x33, 0xcf, 0xc4, 0xf8, 0x13, 0x58, 0x4b, 0x77, 0xca, 0xb3, 0x70, 0x7e, 0xd4, 0x80,
+	0x62, 0xb0, 0x39, 0x56, 0xde, 0xc1, 0x95, 0x20, 0x7f, 0xd8, 0x3a, 0x3e, 0xda, 0xde, 0x69, 0xf2,
+	0x87, 0x70, 0x3b, 0x2d, 0xc3, 0x78, 0x79, 0xd4, 0xae, 0x65, 0xb6, 0x7e, 0x9e, 0x85, 0xcc, 0xfe,
+	0x2b, 0xf4, 0x29, 0x2c, 0xf0, 0xd7, 0x1e, 0x53, 0x9e, 0xf8, 0xe8, 0xd3, 0x1e, 0xb4, 0xe0, 0x1b,
+	0x3f, 0xfa, 0x8f, 0x9f, 0xff, 0x69, 0xe6, 0x1a, 0x2e, 0x37, 0xce, 0xbf, 0xd5, 0x38, 0x3b, 0x6f,
+	0xb0, 0xd8, 0xf0, 0x44, 0x7b, 0x84, 0x3e, 0x86, 0x2c, 0x7d, 0x9f, 0x92, 0xfa, 0xf4, 0x47, 0x4f,
+	0x7f, 0xe3, 0x82, 0x57, 0x18, 0xd3, 0x45, 0x0c, 0x82, 0xe9, 0x68, 0xec, 0x53, 0x96, 0x3f, 0x80,
+	0x92, 0xfa, 0x42, 0xe5, 0xad, 0xef, 0x81, 0xf4, 0xb7, 0xbf, 0x7e, 0xc1, 0x77, 0x98, 0xa8, 0x1b,
+	0x18, 0x09, 0x51, 0xfc, 0x0d, 0x8d, 0xda, 0x8b, 0xf6, 0x85, 0x8d, 0x52, 0x5f, 0x0b, 0xe9, 0xe9,
+	0x0f, 0x62, 0x26, 0x7a, 0xe1, 0x5f, 0xd8, 0x94, 0xe5, 0xf7, 0xc5, 0x5b, 0x98, 0xae, 0x8f, 0xee,
+	0xa6, 0xdf, 0xc5, 0x73, 0xee, 0x6b, 0xe9, 0x00, 0x21, 0xe4, 0x36, 0x13, 0x72, 0x1d, 0x5f, 0x13,
+	0x42, 0xba, 0x01, 0xe4, 0x89, 0xf6, 0x68, 0xab, 0x0b, 0x0b, 0xec, 0xd6, 0x07, 0x7d, 0x26, 0x3f,
+	0xf4, 0x84, 0x3b, 0xbd, 0x94, 0x81, 0x8e, 0xdc, 0x17, 0xe1, 0x65, 0x26, 0xa8, 0x8a, 0x8b, 0x54,
+	0x10, 0xbb, 0xf3, 0x79, 0xa2, 0x3d, 0xda, 0xd0, 0xbe, 0xa1, 0x6d, 0xfd, 0xfd, 0x02, 0x2c, 0xb0,
+	0x5c, 0x26, 0x3a, 0x03, 0x08, 0xaf, 0x37, 0xe2, 0xbd, 0x9b, 0xb8, 0x30, 0xd1, 0xd7, 0xd2, 0x01,
+	0x42, 0xa8, 0xce, 0x84, 0x2e, 0xe3, 0x45, 0x2a, 0x94, 0xa5, 0x48, 0x1b, 0x2c, 0xeb, 0x4b, 0xed,
+	0xf8, 0xc7, 0x9a, 0x48, 0xe5, 0xf2, 0xb5, 0x84, 0x92, 0xb8, 0x45, 0xee, 0x38, 0xf4, 0xf5, 0x29,
+	0x08, 0x21, 0xf0, 0xdb, 0x4c, 0x60, 0x03, 0xd7, 0x42, 0x81, 0x2e, 0x43, 0x3c, 0xd1, 0x1e, 0x7d,
+	0x56, 0xc7, 0x4b, 0xc2, 0xca, 0xb1, 0x1a, 0xf4, 0x43, 0xa8, 0x46, 0x73, 0xf8, 0xe8, 0x5e, 0x82,
+	0xac, 0xf8, 0x55, 0x80, 0x7e, 0x7f, 0x3a, 0x48, 0xe8, 0xb4, 0xca, 0x74, 0x12, 0xc2, 0xb9, 0xe4,
+	0x33, 0x42, 0x46, 0x26, 0x05, 0x89, 0x31, 0x40, 0x7f, 0xa9, 0xc1, 0x62, 0x2c, 0x29, 0x8f, 0x92,
+	0xb8, 0x4f, 0xa4, 0xfc, 0xf5, 0x07, 0x6f, 0x41, 0x09, 0x25, 0x7e, 0x93, 0x29, 0xf1, 0xff, 0xf1,
+	0x72, 0xa8, 0x84, 0x6f, 0x0d, 0x89, 0xef, 0x08, 0x2d, 0x3e, 0xbb, 0x8d, 0x6f, 0x44, 0x8c, 0x13,
+	0xa9, 0x0d, 0x07, 0x8b, 0xfd, 0x78, 0x89, 0x83, 0x15, 0x49, 0xd4, 0xeb, 0xeb, 0x53, 0x10, 0xe9,
+	0x83, 0xc5, 0x7e, 0xbd, 0xa4, 0xc1, 0x0a, 0x6a, 0xb6, 0xfe, 0x67, 0x1e, 0xf2, 0x3b, 0xfc, 0x59,
+	0x3c, 0x72, 0xa0, 0x18, 0xe4, 0x96, 0xd1, 0x6a, 0x52, 0x02, 0x2d, 0x3c, 0x4b, 0xe8, 0x77, 0x53,
+	0xeb, 0x85, 0x42, 0xeb, 0x4c, 0xa1, 0x5b, 0xf8, 0x3a, 0x95, 0x2c, 0x5e, 0xde, 0x37, 0x78, 0x72,
+	0xa3, 0x61, 0xf6, 0x7a, 0xd4, 0x10, 0xbf, 0x03, 0x65, 0x35, 0xf9, 0x8b, 0xd6, 0x93, 0x78, 0x46,
+	0xf2, 0xc7, 0x3a, 0x9e, 0x06, 0x11, 0x92, 0xef, 0x33, 0xc9, 0xab, 0xf8, 0x66, 0x82, 0x64, 0x97,
+	0x41, 0x23, 0xc2, 0x79, 0xe2, 0x36, 0x59, 0x78, 0x24, 0x2f, 0xac, 0xe3, 0x69, 0x90, 0x2b, 0x08,
+	0x1f, 0x33, 0x28, 0x15, 0xee, 0x01, 0x84, 0x29, 0x5a, 0x94, 0x68, 0x4b, 0xe5, 0x30, 0xa5, 0xaf,
+	0xa5, 0x03, 0x84, 0x58, 0xcc, 0xc4, 0x8a, 0x79, 0x17, 0x13, 0x3b, 0xb0, 0x3c, 0x9f, 0x2f, 0xcc,
+	0x4a, 0x24, 0xe7, 0x8a, 0x12, 0xfb, 0x13, 0x4d, 0xdc, 0xea, 0xf7, 0xa6, 0x62, 0x84, 0xf4, 0x07,
+	0x4c, 0xfa, 0x5d, 0xac, 0x27, 0x48, 0x1f, 0x71, 0x2c, 0x9d, 0x6c, 0x7f, 0x91, 0x87, 0xd2, 0x0b,
+	0xd3, 0xb2, 0x7d, 0x62, 0xd3, 0x6b, 0x6a, 0x74, 0x02, 0x0b, 0x2c, 0x52, 0xc7, 0x1d, 0xb1, 0x9a,
+	0xc6, 0xd3, 0x6f, 0x25, 0xd6, 0x09, 0xc1, 0x6b, 0x4c, 0xb0, 0x8e, 0x57, 0xa8, 0xe0, 0x61, 0xc8,
+	0xba, 0xc1, 0x52, 0x53, 0xb4, 0xd3, 0x6f, 0x20, 0x27, 0xae, 0xb7, 0x62, 0x8c, 0x22, 0x29, 0x2b,
+	0xfd, 0x76, 0x72, 0x65, 0xd2, 0x5c, 0x56, 0xc5, 0x78, 0x0c, 0x47, 0xe5, 0x9c, 0x03, 0x84, 0xf9,
+	0xde, 0xf8, 0x88, 0x4e, 0xe4, 0x9a, 0xf5, 0xb5, 0x74, 0x40, 0x92, 0x4d, 0x55, 0x99, 0xbd, 0x00,
+	0x4b, 0xe5, 0xfe, 0x36, 0xcc, 0xd3, 0xc7, 0x53, 0x28, 0x16, 0x7b, 0x95, 0x47, 0x61, 0xba, 0x9e,
+	0x54, 0x25, 0xa4, 0xdc, 0x65, 0x52, 0x6e, 0xe2, 0xe5, 0xb8, 0x14, 0xfa, 0x7e, 0x8a, 0xf2, 0xef,
+	0x41, 0x8e, 0xbf, 0x11, 0x8b, 0xdb, 0x2f, 0xf2, 0xce, 0x4c, 0xbf, 0x9d, 0x5c, 0x79, 0x55, 0x29,
+	0x23, 0x28, 0xc8, 0x47, 0x59, 0x28, 0x76, 0x53, 0x1d, 0x7b, 0xc0, 0xa5, 0xaf, 0xa6, 0x55, 0x0b,
+	0x59, 0xf7, 0x98, 0xac, 0x3b, 0xb8, 0x3e, 0x31, 0x56, 0x02, 0xf9, 0x44, 0x7b, 0xf4, 0x0d, 0x0d,
+	0xfd, 0x10, 0x20, 0x4c, 0x53, 0x4f, 0xac, 0xc0, 0x78, 0xc6, 0x5b, 0x5f, 0x4b, 0x07, 0x08, 0xb9,
+	0x9b, 0x4c, 0xee, 0x06, 0xbe, 0x17, 0x97, 0xeb, 0xbb, 0xa6, 0xed, 0xbd, 0x21, 0xee, 0xfb, 0x3c,
+	0x15, 0xe9, 0x9d, 0x5a, 0x23, 0xda, 0xe5, 0x3f, 0xd1, 0xa0, 0x16, 0x0e, 0x7b, 0xcb, 0x1e, 0x58,
+	0x36, 0x79, 0xfb, 0xbc, 0xd9, 0x48, 0x03, 0xc4, 0xaf, 0x18, 0xf0, 0x7b, 0x4c, 0x9f, 0x87, 0x78,
+	0x3d, 0x7d, 0xfe, 0x34, 0x1c, 0x26, 0x95, 0x19, 0x64, 0xeb, 0x1f, 0x17, 0x61, 0x9e, 0xee, 0xc8,
+	0xe9, 0xc6, 0x25, 0x4c, 0x64, 0xc4, 0x35, 0x9a, 0x48, 0x1f, 0xea, 0x6b, 0xe9, 0x80, 0xa4, 0x8d,
+	0x0b, 0xfb, 0x07, 0x2f, 0xc2, 0x00, 0xd4, 0x0a, 0x0e, 0x94, 0x94, 0x4c, 0x07, 0x4a, 0x60, 0x16,
+	0xcd, 0x4b, 0xea, 0xeb, 0x53, 0x10, 0x42, 0xde, 0x2d, 0x26, 0x6f, 0x05, 0xd7, 0x02, 0x79, 0x3d,
+	0xcb, 0x93, 0x02, 0x3f, 0x87, 0xb2, 0x9a, 0x0d, 0x41, 0x09, 0xfc, 0x62, 0x39, 0x4f, 0x1d, 0x4f,
+	0x83, 0x24, 0x39, 0xa2, 0xe0, 0x9f, 0xd8, 0x24, 0x8c, 0x0a, 0x1e, 0x40, 0x5e, 0xa4, 0x47, 0x92,
+	0x7a, 0x19, 0x4d, 0x90, 0xea, 0xeb, 0x53, 0x10, 0x49, 0x9b, 0x5d, 0x26, 0x71, 0xec, 0x85, 0xa1,
+	0x55, 0x48, 0x7b, 0x46, 0xfc, 0x34, 0x69, 0x61, 0xb6, 0x4f, 0x5f, 0x9f, 0x82, 0x98, 0x2e, 0xad,
+	0x4f, 0x7c, 0xb1, 0x7c, 0xe5, 0xa9, 0x16, 0xa5, 0x30, 0x53, 0xc3, 0x19, 0x9e, 0x06, 0x49, 0x3a,
+	0x8b, 0x84, 0x02, 0x65, 0x2c, 0xbb, 0x00, 0x08, 0x93, 0x37, 0xe8, 0x5e, 0x32, 0xc3, 0x48, 0xe2,
+	0x51, 0xbf, 0x3f, 0x1d, 0x94, 0xe4, 0xaa, 0x42, 0xb9, 0xfc, 0x28, 0x44, 0x25, 0xff, 0x44, 0x03,
+	0x34, 0x99, 0xe7, 0x41, 0x8f, 0x93, 0xb9, 0x27, 0xe6, 0x95, 0xf5, 0xf7, 0xae, 0x06, 0x4e, 0x8a,
+	0x3e, 0xa1, 0x4a, 0x5d, 0x86, 0x1e, 0x7d, 0x4e, 0x95, 0xfa, 0x03, 0x0d, 0x2a, 0x91, 0x24, 0x11,
+	0x7a, 0x98, 0x32, 0xa6, 0xb1, 0xb4, 0xb4, 0xfe, 0xce, 0x5b, 0x71, 0x49, 0x3b, 0x6f, 0x65, 0x06,
+	0xc8, 0x23, 0xc8, 0x1f, 0x6a, 0x50, 0x8d, 0x26, 0x95, 0x50, 0x0a, 0xef, 0x89, 0xb4, 0xb6, 0xbe,
+	0xf1, 0x76, 0xe0, 0xf4, 0xe1, 0x09, 0x4f, 0x1f, 0x03, 0xc8, 0x8b, 0x34, 0x54, 0xd2, 0xc4, 0x8f,
+	0x26, 0xc4, 0xf5, 0xf5, 0x29, 0x88, 0xd4, 0x89, 0xef, 0x3a, 0x03, 0xa2, 0x2c, 0x33, 0x91, 0xa7,
+	0x4a, 0x93, 0x36, 0x7d, 0x99, 0xc5, 0x92, 0x5c, 0x69, 0xd2, 0xc2, 0x65, 0x26, 0x13, 0x54, 0x28,
+	0x85, 0xd9, 0x5b, 0x96, 0x59, 0x3c, 0xbf, 0x95, 0xb0, 0xcc, 0x98, 0x40, 0x65, 0x99, 0x85, 0xa9,
+	0xa4, 0xa4, 0x65, 0x36, 0x91, 0xdf, 0xd7, 0xef, 0x4f, 0x07, 0xa5, 0x8e, 0x23, 0x93, 0x1b, 0x59,
+	0x66, 0x4b, 0x09, 0x59, 0x27, 0xf4, 0x5e, 0x8a, 0x11, 0x13, 0xaf, 0x0d, 0xf4, 0xf7, 0xaf, 0x88,
+	0x4e, 0x9d, 0xe3, 0xdc, 0xfc, 0x72, 0x8e, 0xff, 0x54, 0x83, 0xe5, 0xa4, 0x8c, 0x15, 0x4a, 0x91,
+	0x93, 0x72, 0xdd, 0xa0, 0x6f, 0x5e, 0x15, 0x3e, 0xdd, 0x5a, 0xc1, 0xac, 0x7f, 0x5a, 0xfb, 0x97,
+	0x2f, 0x57, 0xb5, 0x7f, 0xff, 0x72, 0x55, 0xfb, 0xcf, 0x2f, 0x57, 0xb5, 0x3f, 0xfb, 0xaf, 0xd5,
+	0xb9, 0x93, 0x1c, 0xfb, 0xd7, 0xe8, 0x6f, 0xfd, 0xdf, 0x00, 0x33, 0x44, 0x34, 0x77, 0xa1, 0x3d,
+	0x00, 0x00,
 }
@@ -92,12 +92,39 @@ var (
 		Name:      "read_indexes_failed_total",
 		Help:      "The total number of failed read indexes seen.",
 	})
+	linearizableReads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "linearizable_reads_total",
+		Help:      "The total number of served linearizable reads, by whether the leader lease (\"lease\") or a read index round trip (\"read_index\") confirmed them.",
+	},
+		[]string{"path"})
 	leaseExpired = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd_debugging",
 		Subsystem: "server",
 		Name:      "lease_expired_total",
 		Help:      "The total number of expired leases.",
 	})
+	snapshotTriggers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "snapshot_triggers_total",
+		Help:      "The total number of snapshots triggered, by whether the number (\"entries\") or the size (\"bytes\") of the entries applied since the last snapshot triggered them.",
+	},
+		[]string{"reason"})
+	raftLogMemoryBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "raft_log_memory_bytes",
+		Help:      "The aggregate size of the raft log entries held in memory.",
+	})
+	autoDefrags = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "auto_defrag_total",
+		Help:      "The total number of members the leader defragmented automatically, by whether it succeeded (\"success\") or not (\"failure\").",
+	},
+		[]string{"result"})
 	quotaBackendBytes = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: "etcd",
 		Subsystem: "server",
@@ -125,6 +152,22 @@ var (
 		Help:      "Server or member ID in hexadecimal format. 1 for 'server_id' label with current ID.",
 	},
 		[]string{"server_id"})
+	userResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "user_resources",
+		Help:      "The watchers, lease keepalive streams and buffered watch bytes each authenticated user holds, when client limits are set.",
+	},
+		[]string{"user", "resource"},
+	)
+	clientLimitsExceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "client_limit_exceeded_total",
+		Help:      "The total number of client requests rejected for exceeding a per-connection or per-user limit.",
+	},
+		[]string{"resource", "scope", "user"},
+	)
 )
 
 func init() {
@@ -139,11 +182,17 @@ func init() {
 	prometheus.MustRegister(proposalsFailed)
 	prometheus.MustRegister(slowReadIndex)
 	prometheus.MustRegister(readIndexFailed)
+	prometheus.MustRegister(linearizableReads)
 	prometheus.MustRegister(leaseExpired)
+	prometheus.MustRegister(snapshotTriggers)
+	prometheus.MustRegister(raftLogMemoryBytes)
+	prometheus.MustRegister(autoDefrags)
 	prometheus.MustRegister(quotaBackendBytes)
 	prometheus.MustRegister(currentVersion)
 	prometheus.MustRegister(currentGoVersion)
 	prometheus.MustRegister(serverID)
+	prometheus.MustRegister(userResources)
+	prometheus.MustRegister(clientLimitsExceeded)
 
 	currentVersion.With(prometheus.Labels{
 		"server_version": version.Version,
@@ -140,11 +140,14 @@ func (b *backendQuota) Available(v interface{}) bool {
 }
 
 func (b *backendQuota) Cost(v interface{}) int {
+	// values cost the space they take compressed, estimated from how much
+	// the values put so far were compressed
+	ratio := b.s.KV().ValueCompressionRatio()
 	switch r := v.(type) {
 	case *pb.PutRequest:
-		return costPut(r)
+		return costPut(r, ratio)
 	case *pb.TxnRequest:
-		return costTxn(r)
+		return costTxn(r, ratio)
 	case *pb.LeaseGrantRequest:
 		return leaseOverhead
 	default:
@@ -152,24 +155,26 @@ func (b *backendQuota) Cost(v interface{}) int {
 	}
 }
 
-func costPut(r *pb.PutRequest) int { return kvOverhead + len(r.Key) + len(r.Value) }
+func costPut(r *pb.PutRequest, ratio float64) int {
+	return kvOverhead + len(r.Key) + int(float64(len(r.Value))*ratio)
+}
 
-func costTxnReq(u *pb.RequestOp) int {
+func costTxnReq(u *pb.RequestOp, ratio float64) int {
 	r := u.GetRequestPut()
 	if r == nil {
 		return 0
 	}
-	return costPut(r)
+	return costPut(r, ratio)
 }
 
-func costTxn(r *pb.TxnRequest) int {
+func costTxn(r *pb.TxnRequest, ratio float64) int {
 	sizeSuccess := 0
 	for _, u := range r.Success {
-		sizeSuccess += costTxnReq(u)
+		sizeSuccess += costTxnReq(u, ratio)
 	}
 	sizeFailure := 0
 	for _, u := range r.Failure {
-		sizeFailure += costTxnReq(u)
+		sizeFailure += costTxnReq(u, ratio)
 	}
 	if sizeFailure > sizeSuccess {
 		return sizeFailure
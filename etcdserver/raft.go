@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"expvar"
 	"log"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -477,6 +478,12 @@ func startNode(cfg ServerConfig, cl *membership.RaftCluster, ids []types.ID) (id
 		MaxInflightMsgs: maxInflightMsgs,
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
+
+		MaxUncommittedEntriesSize: cfg.MaxUncommittedEntriesBytes,
+	}
+	if cfg.LeaseRead {
+		c.ReadOnlyOption = raft.ReadOnlyLeaseBased
+		c.LeaseClockDriftTicks = cfg.leaseClockDriftTicks()
 	}
 	if cfg.Logger != nil {
 		// called after capnslog setting in "init" function
@@ -531,6 +538,12 @@ func restartNode(cfg ServerConfig, snapshot *raftpb.Snapshot) (types.ID, *member
 		MaxInflightMsgs: maxInflightMsgs,
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
+
+		MaxUncommittedEntriesSize: cfg.MaxUncommittedEntriesBytes,
+	}
+	if cfg.LeaseRead {
+		c.ReadOnlyOption = raft.ReadOnlyLeaseBased
+		c.LeaseClockDriftTicks = cfg.leaseClockDriftTicks()
 	}
 	if cfg.Logger != nil {
 		// called after capnslog setting in "init" function
@@ -628,6 +641,12 @@ func restartAsStandaloneNode(cfg ServerConfig, snapshot *raftpb.Snapshot) (types
 		MaxInflightMsgs: maxInflightMsgs,
 		CheckQuorum:     true,
 		PreVote:         cfg.PreVote,
+
+		MaxUncommittedEntriesSize: cfg.MaxUncommittedEntriesBytes,
+	}
+	if cfg.LeaseRead {
+		c.ReadOnlyOption = raft.ReadOnlyLeaseBased
+		c.LeaseClockDriftTicks = cfg.leaseClockDriftTicks()
 	}
 	if cfg.Logger != nil {
 		// called after capnslog setting in "init" function
@@ -657,6 +676,9 @@ func getIDs(lg *zap.Logger, snap *raftpb.Snapshot, ents []raftpb.Entry) []uint64
 		for _, id := range snap.Metadata.ConfState.Nodes {
 			ids[id] = true
 		}
+		for _, id := range snap.Metadata.ConfState.Learners {
+			ids[id] = true
+		}
 	}
 	for _, e := range ents {
 		if e.Type != raftpb.EntryConfChange {
@@ -665,7 +687,7 @@ func getIDs(lg *zap.Logger, snap *raftpb.Snapshot, ents []raftpb.Entry) []uint64
 		var cc raftpb.ConfChange
 		pbutil.MustUnmarshal(&cc, e.Data)
 		switch cc.Type {
-		case raftpb.ConfChangeAddNode:
+		case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode, raftpb.ConfChangeAddWitnessNode:
 			ids[cc.NodeID] = true
 		case raftpb.ConfChangeRemoveNode:
 			delete(ids, cc.NodeID)
@@ -675,7 +697,7 @@ func getIDs(lg *zap.Logger, snap *raftpb.Snapshot, ents []raftpb.Entry) []uint64
 			if lg != nil {
 				lg.Panic("unknown ConfChange Type", zap.String("type", cc.Type.String()))
 			} else {
-				plog.Panicf("ConfChange Type should be either ConfChangeAddNode or ConfChangeRemoveNode!")
+				plog.Panicf("ConfChange Type should be either ConfChangeAddNode, ConfChangeAddLearnerNode, ConfChangeAddWitnessNode or ConfChangeRemoveNode!")
 			}
 		}
 	}
@@ -687,6 +709,48 @@ func getIDs(lg *zap.Logger, snap *raftpb.Snapshot, ents []raftpb.Entry) []uint64
 	return []uint64(sids)
 }
 
+// isWitness returns if the member with the given id is a witness, according
+// to the snapshot and the conf change entries in the given raft storage.
+func isWitness(lg *zap.Logger, id types.ID, s *raft.MemoryStorage) bool {
+	witness := false
+	snap, err := s.Snapshot()
+	if err == nil {
+		for _, wid := range snap.Metadata.ConfState.Witnesses {
+			if wid == uint64(id) {
+				witness = true
+			}
+		}
+	}
+	var ents []raftpb.Entry
+	first, _ := s.FirstIndex()
+	if last, _ := s.LastIndex(); last >= first {
+		if ents, err = s.Entries(first, last+1, math.MaxUint64); err != nil {
+			if lg != nil {
+				lg.Panic("failed to read raft entries", zap.Error(err))
+			} else {
+				plog.Panicf("failed to read raft entries (%v)", err)
+			}
+		}
+	}
+	for _, e := range ents {
+		if e.Type != raftpb.EntryConfChange {
+			continue
+		}
+		var cc raftpb.ConfChange
+		pbutil.MustUnmarshal(&cc, e.Data)
+		if cc.NodeID != uint64(id) {
+			continue
+		}
+		switch cc.Type {
+		case raftpb.ConfChangeAddWitnessNode:
+			witness = true
+		case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode, raftpb.ConfChangeRemoveNode:
+			witness = false
+		}
+	}
+	return witness
+}
+
 // createConfigChangeEnts creates a series of Raft entries (i.e.
 // EntryConfChange) to remove the set of given IDs from the cluster. The ID
 // `self` is _not_ removed, even if present in the set.
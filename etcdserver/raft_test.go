@@ -39,6 +39,8 @@ func TestGetIDs(t *testing.T) {
 	normalEntry := raftpb.Entry{Type: raftpb.EntryNormal}
 	updatecc := &raftpb.ConfChange{Type: raftpb.ConfChangeUpdateNode, NodeID: 2}
 	updateEntry := raftpb.Entry{Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(updatecc)}
+	addLearnercc := &raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3}
+	addLearnerEntry := raftpb.Entry{Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(addLearnercc)}
 
 	tests := []struct {
 		confState *raftpb.ConfState
@@ -59,6 +61,10 @@ func TestGetIDs(t *testing.T) {
 			[]raftpb.Entry{addEntry, normalEntry, updateEntry}, []uint64{1, 2}},
 		{&raftpb.ConfState{Nodes: []uint64{1}},
 			[]raftpb.Entry{addEntry, removeEntry, normalEntry}, []uint64{1}},
+		{&raftpb.ConfState{Nodes: []uint64{1}, Learners: []uint64{2}},
+			[]raftpb.Entry{}, []uint64{1, 2}},
+		{&raftpb.ConfState{Nodes: []uint64{1}},
+			[]raftpb.Entry{addLearnerEntry}, []uint64{1, 3}},
 	}
 
 	for i, tt := range tests {
@@ -73,6 +79,46 @@ func TestGetIDs(t *testing.T) {
 	}
 }
 
+func TestIsWitness(t *testing.T) {
+	entry := func(typ raftpb.ConfChangeType, id uint64) raftpb.Entry {
+		cc := &raftpb.ConfChange{Type: typ, NodeID: id}
+		return raftpb.Entry{Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(cc)}
+	}
+	addWitnessEntry := entry(raftpb.ConfChangeAddWitnessNode, 2)
+	addOtherWitnessEntry := entry(raftpb.ConfChangeAddWitnessNode, 3)
+	removeEntry := entry(raftpb.ConfChangeRemoveNode, 2)
+	addEntry := entry(raftpb.ConfChangeAddNode, 2)
+	updateEntry := entry(raftpb.ConfChangeUpdateNode, 2)
+	normalEntry := raftpb.Entry{Type: raftpb.EntryNormal}
+
+	tests := []struct {
+		confState raftpb.ConfState
+		ents      []raftpb.Entry
+
+		wwitness bool
+	}{
+		{raftpb.ConfState{Nodes: []uint64{1}}, []raftpb.Entry{}, false},
+		{raftpb.ConfState{Nodes: []uint64{1, 2}, Witnesses: []uint64{2}}, []raftpb.Entry{}, true},
+		{raftpb.ConfState{Nodes: []uint64{1}}, []raftpb.Entry{addWitnessEntry}, true},
+		{raftpb.ConfState{Nodes: []uint64{1}}, []raftpb.Entry{addOtherWitnessEntry}, false},
+		{raftpb.ConfState{Nodes: []uint64{1}}, []raftpb.Entry{addWitnessEntry, normalEntry, updateEntry}, true},
+		{raftpb.ConfState{Nodes: []uint64{1, 2}, Witnesses: []uint64{2}}, []raftpb.Entry{removeEntry}, false},
+		{raftpb.ConfState{Nodes: []uint64{1}}, []raftpb.Entry{addWitnessEntry, removeEntry, addEntry}, false},
+	}
+
+	for i, tt := range tests {
+		s := raft.NewMemoryStorage()
+		s.ApplySnapshot(raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{Index: 10, Term: 1, ConfState: tt.confState}})
+		for j := range tt.ents {
+			tt.ents[j].Index, tt.ents[j].Term = uint64(11+j), 1
+		}
+		s.Append(tt.ents)
+		if w := isWitness(testLogger, 2, s); w != tt.wwitness {
+			t.Errorf("#%d: witness = %v, want %v", i, w, tt.wwitness)
+		}
+	}
+}
+
 func TestCreateConfigChangeEnts(t *testing.T) {
 	m := membership.Member{
 		ID:             types.ID(1),
@@ -0,0 +1,85 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+)
+
+// pageTokenVersion is the first byte of every page token, so that the
+// encoding may change without misreading the tokens of older members.
+const pageTokenVersion = 1
+
+// encodePageToken encodes the revision a paginated range is read at and the
+// key the next page starts from. Clients treat the token as opaque.
+func encodePageToken(rev int64, key []byte) []byte {
+	token := make([]byte, 1+8+len(key))
+	token[0] = pageTokenVersion
+	binary.BigEndian.PutUint64(token[1:], uint64(rev))
+	copy(token[9:], key)
+	return token
+}
+
+func decodePageToken(token []byte) (rev int64, key []byte, err error) {
+	if len(token) < 9 || token[0] != pageTokenVersion {
+		return 0, nil, ErrInvalidPageToken
+	}
+	rev = int64(binary.BigEndian.Uint64(token[1:]))
+	if rev <= 0 {
+		return 0, nil, ErrInvalidPageToken
+	}
+	return rev, token[9:], nil
+}
+
+// isPageable returns true if the range returns its keys in ascending key
+// order, so that a page may resume right after the last key of the previous.
+func isPageable(r *pb.RangeRequest) bool {
+	// other targets are sorted in ascending order even without a sort order
+	return r.SortTarget == pb.RangeRequest_KEY && r.SortOrder != pb.RangeRequest_DESCEND
+}
+
+// rangeStart returns the key and revision a range request reads from. They
+// are those of the request, unless it resumes a paginated range.
+func rangeStart(r *pb.RangeRequest) (key []byte, rev int64, err error) {
+	if len(r.PageToken) == 0 {
+		return r.Key, r.Revision, nil
+	}
+	if !isPageable(r) {
+		return nil, 0, ErrInvalidPageToken
+	}
+	rev, key, err = decodePageToken(r.PageToken)
+	if err != nil {
+		return nil, 0, err
+	}
+	// the token must come from the same range
+	if r.Revision != 0 && r.Revision != rev {
+		return nil, 0, ErrInvalidPageToken
+	}
+	if bytes.Compare(key, r.Key) < 0 {
+		return nil, 0, ErrInvalidPageToken
+	}
+	return key, rev, nil
+}
+
+// nextPageToken returns the token for the page after the given keys, which
+// are the last page read at rev.
+func nextPageToken(rev int64, lastKey []byte) []byte {
+	next := make([]byte, len(lastKey)+1)
+	copy(next, lastKey)
+	return encodePageToken(rev, next)
+}
@@ -97,6 +97,10 @@ const (
 	maxPendingRevokes = 16
 
 	recommendedMaxRequestBytes = 10 * 1024 * 1024
+
+	// readyPercent is the fraction of the leader's log a learner must have
+	// replicated before it can be promoted to a voting member.
+	readyPercent = 0.9
 )
 
 var (
@@ -156,6 +160,11 @@ type Server interface {
 	// UpdateMember attempts to update an existing member in the cluster. It will
 	// return ErrIDNotFound if the member ID does not exist.
 	UpdateMember(ctx context.Context, updateMemb membership.Member) ([]*membership.Member, error)
+	// PromoteMember attempts to promote a non-voting node to a voting node. It will
+	// return ErrIDNotFound if the member ID does not exist.
+	// return ErrLearnerNotReady if the member are not ready.
+	// return ErrMemberNotLearner if the member is not a learner.
+	PromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error)
 
 	// ClusterVersion is the cluster-wide minimum major.minor version.
 	// Cluster version is set to the min version that an etcd member is
@@ -246,6 +255,13 @@ type EtcdServer struct {
 	SyncTicker *time.Ticker
 	// compactor is used to auto-compact the KV.
 	compactor v3compactor.Compactor
+	// retainer computes the history compactions retain; nil if there is
+	// no prefix retention policy.
+	retainer *v3compactor.Retainer
+
+	// clientLimits bounds the resources each client holds; nil if they are
+	// unlimited.
+	clientLimits *ClientLimits
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -320,10 +336,14 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 
 	bepath := cfg.backendPath()
 	beExist := fileutil.Exist(bepath)
-	be := openBackend(cfg)
+	// a witness keeps no keyspace, so its backend is not opened from a file
+	var (
+		be      backend.Backend
+		witness bool
+	)
 
 	defer func() {
-		if err != nil {
+		if err != nil && be != nil {
 			be.Close()
 		}
 	}()
@@ -357,6 +377,11 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 			return nil, fmt.Errorf("incompatible with current running cluster")
 		}
 
+		if witness = cl.MemberByName(cfg.Name).IsWitness; witness {
+			be = openWitnessBackend(cfg)
+		} else {
+			be = openBackend(cfg)
+		}
 		remotes = existingCluster.Members()
 		cl.SetID(types.ID(0), existingCluster.ID())
 		cl.SetStore(st)
@@ -394,6 +419,7 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 				return nil, err
 			}
 		}
+		be = openBackend(cfg)
 		cl.SetStore(st)
 		cl.SetBackend(be)
 		id, n, s, w = startNode(cfg, cl, cl.MemberIDs())
@@ -440,7 +466,20 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 			} else {
 				plog.Infof("recovered store from snapshot at index %d", snapshot.Metadata.Index)
 			}
+		}
 
+		if !cfg.ForceNewCluster {
+			id, cl, n, s, w = restartNode(cfg, snapshot)
+		} else {
+			id, cl, n, s, w = restartAsStandaloneNode(cfg, snapshot)
+		}
+
+		if witness = isWitness(cfg.Logger, id, s); witness {
+			be = openWitnessBackend(cfg)
+		} else {
+			be = openBackend(cfg)
+		}
+		if snapshot != nil && !witness {
 			if be, err = recoverSnapshotBackend(cfg, be, *snapshot); err != nil {
 				if cfg.Logger != nil {
 					cfg.Logger.Panic("failed to recover v3 backend from snapshot", zap.Error(err))
@@ -460,16 +499,10 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 			}
 		}
 
-		if !cfg.ForceNewCluster {
-			id, cl, n, s, w = restartNode(cfg, snapshot)
-		} else {
-			id, cl, n, s, w = restartAsStandaloneNode(cfg, snapshot)
-		}
-
 		cl.SetStore(st)
 		cl.SetBackend(be)
 		cl.Recover(api.UpdateCapability)
-		if cl.Version() != nil && !cl.Version().LessThan(semver.Version{Major: 3}) && !beExist {
+		if cl.Version() != nil && !cl.Version().LessThan(semver.Version{Major: 3}) && !beExist && !witness {
 			os.RemoveAll(bepath)
 			return nil, fmt.Errorf("database file (%v) of the backend is missing", bepath)
 		}
@@ -505,7 +538,7 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 			},
 		),
 		id:               id,
-		attributes:       membership.Attributes{Name: cfg.Name, ClientURLs: cfg.ClientURLs.StringSlice()},
+		attributes:       membership.Attributes{Name: cfg.Name, ClientURLs: cfg.ClientURLs.StringSlice(), Priority: cfg.LeaderPriority},
 		cluster:          cl,
 		stats:            sstats,
 		lstats:           lstats,
@@ -513,6 +546,7 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 		peerRt:           prt,
 		reqIDGen:         idutil.NewGenerator(uint16(id), time.Now()),
 		forceVersionC:    make(chan struct{}),
+		clientLimits:     newClientLimits(cfg),
 		AccessController: &AccessController{CORS: cfg.CORS, HostWhitelist: cfg.HostWhitelist},
 	}
 	serverID.With(prometheus.Labels{"server_id": id.String()}).Set(1)
@@ -525,7 +559,19 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
 	srv.lessor = lease.NewLessor(srv.getLogger(), srv.be, lease.LessorConfig{MinLeaseTTL: int64(math.Ceil(minTTL.Seconds())), CheckpointInterval: cfg.LeaseCheckpointInterval})
-	srv.kv = mvcc.New(srv.getLogger(), srv.be, srv.lessor, &srv.consistIndex)
+	storeCfg := mvcc.StoreConfig{
+		RevisionIndex:            cfg.RevisionIndex,
+		CompactionBatchLimit:     cfg.CompactionBatchLimit,
+		CompactionMaxPause:       cfg.CompactionMaxPause,
+		CompactionKeysPerSecond:  cfg.CompactionKeysPerSecond,
+		CompactionBytesPerSecond: cfg.CompactionBytesPerSecond,
+		ValueCompression:         cfg.ValueCompression,
+		WatchCacheRevisions:      cfg.WatchCacheRevisions,
+	}
+	if cfg.IndexCheckpoint && !witness {
+		storeCfg.IndexCheckpointPath = cfg.indexCheckpointPath()
+	}
+	srv.kv = mvcc.NewWithConfig(srv.getLogger(), srv.be, srv.lessor, &srv.consistIndex, storeCfg)
 	if beExist {
 		kvindex := srv.kv.ConsistentIndex()
 		// TODO: remove kvindex != 0 checking when we do not expect users to upgrade
@@ -575,6 +621,10 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 		}
 		srv.compactor.Run()
 	}
+	if len(cfg.CompactionPrefixRetention) > 0 {
+		srv.retainer = v3compactor.NewRetainer(cfg.Logger, cfg.CompactionPrefixRetention, srv.kv)
+		srv.retainer.Run()
+	}
 
 	srv.applyV3Base = srv.newApplierV3Backend()
 	if err = srv.restoreAlarms(); err != nil {
@@ -714,8 +764,10 @@ func (s *EtcdServer) Start() {
 	s.goAttach(s.purgeFile)
 	s.goAttach(func() { monitorFileDescriptor(s.getLogger(), s.stopping) })
 	s.goAttach(s.monitorVersions)
+	s.goAttach(s.monitorLeaderPriority)
 	s.goAttach(s.linearizableReadLoop)
 	s.goAttach(s.monitorKVHash)
+	s.goAttach(s.monitorDefrag)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -830,6 +882,7 @@ type ServerPeer interface {
 	ServerV2
 	RaftHandler() http.Handler
 	LeaseHandler() http.Handler
+	DefragHandler() http.Handler
 }
 
 func (s *EtcdServer) LeaseHandler() http.Handler {
@@ -877,6 +930,9 @@ type etcdProgress struct {
 	snapi     uint64
 	appliedt  uint64
 	appliedi  uint64
+	// snapBytes is the aggregate byte size of the entries applied since
+	// the last snapshot.
+	snapBytes uint64
 }
 
 // raftReadyHandler contains a set of EtcdServer operations to be called by raftNode,
@@ -1006,6 +1062,9 @@ func (s *EtcdServer) run() {
 		if s.compactor != nil {
 			s.compactor.Stop()
 		}
+		if s.retainer != nil {
+			s.retainer.Stop()
+		}
 		close(s.done)
 	}()
 
@@ -1082,10 +1141,18 @@ func (s *EtcdServer) applyAll(ep *etcdProgress, apply *apply) {
 	// storage, since the raft routine might be slower than apply routine.
 	<-apply.notifyc
 
+	raftLogMemoryBytes.Set(float64(s.r.raftStorage.Size()))
+
 	s.triggerSnapshot(ep)
 	select {
 	// snapshot requested via send()
 	case m := <-s.r.msgSnapC:
+		if s.cluster.IsLocalMemberWitness() {
+			// a witness has no keyspace to send; it hands over the
+			// leadership right away, and the next leader sends the snapshot
+			s.r.ReportSnapshot(m.To, raft.SnapshotFailure)
+			break
+		}
 		merged := s.createMergedSnapshotMessage(m, ep.appliedt, ep.appliedi, ep.confState)
 		s.sendMergedSnap(merged)
 	default:
@@ -1141,7 +1208,86 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 	// wait for raftNode to persist snapshot onto the disk
 	<-apply.notifyc
 
-	newbe, err := openSnapshotBackend(s.Cfg, s.snapshotter, apply.snapshot)
+	if s.cluster.IsLocalMemberWitness() {
+		// a witness does not keep the keyspace, only the membership
+		s.discardSnapshotBackend(apply.snapshot)
+	} else {
+		s.restoreSnapshotBackend(apply.snapshot)
+	}
+
+	if lg != nil {
+		lg.Info("restoring v2 store")
+	} else {
+		plog.Info("recovering store v2...")
+	}
+	if err := s.v2store.Recovery(apply.snapshot.Data); err != nil {
+		if lg != nil {
+			lg.Panic("failed to restore v2 store", zap.Error(err))
+		} else {
+			plog.Panicf("recovery store error: %v", err)
+		}
+	}
+
+	if lg != nil {
+		lg.Info("restored v2 store")
+	} else {
+		plog.Info("finished recovering store v2")
+	}
+
+	s.cluster.SetBackend(s.be)
+
+	if lg != nil {
+		lg.Info("restoring cluster configuration")
+	} else {
+		plog.Info("recovering cluster configuration...")
+	}
+
+	s.cluster.Recover(api.UpdateCapability)
+
+	if lg != nil {
+		lg.Info("restored cluster configuration")
+		lg.Info("removing old peers from network")
+	} else {
+		plog.Info("finished recovering cluster configuration")
+		plog.Info("removing old peers from network...")
+	}
+
+	// recover raft transport
+	s.r.transport.RemoveAllPeers()
+
+	if lg != nil {
+		lg.Info("removed old peers from network")
+		lg.Info("adding peers from new cluster configuration")
+	} else {
+		plog.Info("finished removing old peers from network")
+		plog.Info("adding peers from new cluster configuration into network...")
+	}
+
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.ID() {
+			continue
+		}
+		s.r.transport.AddPeer(m.ID, m.PeerURLs)
+	}
+
+	if lg != nil {
+		lg.Info("added peers from new cluster configuration")
+	} else {
+		plog.Info("finished adding peers from new cluster configuration into network...")
+	}
+
+	ep.appliedt = apply.snapshot.Metadata.Term
+	ep.appliedi = apply.snapshot.Metadata.Index
+	ep.snapi = ep.appliedi
+	ep.snapBytes = 0
+	ep.confState = apply.snapshot.Metadata.ConfState
+}
+
+// restoreSnapshotBackend replaces the backend with the one received along
+// with the given snapshot and restores the stores kept in it.
+func (s *EtcdServer) restoreSnapshotBackend(snapshot raftpb.Snapshot) {
+	lg := s.getLogger()
+	newbe, err := openSnapshotBackend(s.Cfg, s.snapshotter, snapshot)
 	if err != nil {
 		if lg != nil {
 			lg.Panic("failed to open snapshot backend", zap.Error(err))
@@ -1254,72 +1400,26 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 			plog.Info("finished recovering auth store")
 		}
 	}
+}
 
-	if lg != nil {
-		lg.Info("restoring v2 store")
-	} else {
-		plog.Info("recovering store v2...")
-	}
-	if err := s.v2store.Recovery(apply.snapshot.Data); err != nil {
-		if lg != nil {
-			lg.Panic("failed to restore v2 store", zap.Error(err))
-		} else {
-			plog.Panicf("recovery store error: %v", err)
-		}
-	}
-
-	if lg != nil {
-		lg.Info("restored v2 store")
-	} else {
-		plog.Info("finished recovering store v2")
-	}
-
-	s.cluster.SetBackend(s.be)
-
-	if lg != nil {
-		lg.Info("restoring cluster configuration")
-	} else {
-		plog.Info("recovering cluster configuration...")
-	}
-
-	s.cluster.Recover(api.UpdateCapability)
-
-	if lg != nil {
-		lg.Info("restored cluster configuration")
-		lg.Info("removing old peers from network")
-	} else {
-		plog.Info("finished recovering cluster configuration")
-		plog.Info("removing old peers from network...")
-	}
-
-	// recover raft transport
-	s.r.transport.RemoveAllPeers()
+// discardSnapshotBackend removes the empty backend file received along with
+// the given snapshot, and moves the consistent index of the volatile backend
+// past it.
+func (s *EtcdServer) discardSnapshotBackend(snapshot raftpb.Snapshot) {
+	s.consistIndex.setConsistentIndex(snapshot.Metadata.Index)
+	s.kv.Commit()
 
-	if lg != nil {
-		lg.Info("removed old peers from network")
-		lg.Info("adding peers from new cluster configuration")
-	} else {
-		plog.Info("finished removing old peers from network")
-		plog.Info("adding peers from new cluster configuration into network...")
+	fn, err := s.snapshotter.DBFilePath(snapshot.Metadata.Index)
+	if err == nil {
+		err = os.Remove(fn)
 	}
-
-	for _, m := range s.cluster.Members() {
-		if m.ID == s.ID() {
-			continue
+	if err != nil {
+		if lg := s.getLogger(); lg != nil {
+			lg.Warn("failed to remove snapshot backend file", zap.Error(err))
+		} else {
+			plog.Warningf("failed to remove snapshot backend file: %v", err)
 		}
-		s.r.transport.AddPeer(m.ID, m.PeerURLs)
-	}
-
-	if lg != nil {
-		lg.Info("added peers from new cluster configuration")
-	} else {
-		plog.Info("finished adding peers from new cluster configuration into network...")
 	}
-
-	ep.appliedt = apply.snapshot.Metadata.Term
-	ep.appliedi = apply.snapshot.Metadata.Index
-	ep.snapi = ep.appliedi
-	ep.confState = apply.snapshot.Metadata.ConfState
 }
 
 func (s *EtcdServer) applyEntries(ep *etcdProgress, apply *apply) {
@@ -1345,6 +1445,9 @@ func (s *EtcdServer) applyEntries(ep *etcdProgress, apply *apply) {
 	if len(ents) == 0 {
 		return
 	}
+	for i := range ents {
+		ep.snapBytes += uint64(ents[i].Size())
+	}
 	var shouldstop bool
 	if ep.appliedt, ep.appliedi, shouldstop = s.apply(ents, &ep.confState); shouldstop {
 		go s.stopWithDelay(10*100*time.Millisecond, fmt.Errorf("the member has been permanently removed from the cluster"))
@@ -1352,8 +1455,12 @@ func (s *EtcdServer) applyEntries(ep *etcdProgress, apply *apply) {
 }
 
 func (s *EtcdServer) triggerSnapshot(ep *etcdProgress) {
+	reason := "entries"
 	if ep.appliedi-ep.snapi <= s.Cfg.SnapshotCount {
-		return
+		if s.Cfg.SnapshotBytes == 0 || ep.snapBytes < s.Cfg.SnapshotBytes || ep.appliedi == ep.snapi {
+			return
+		}
+		reason = "bytes"
 	}
 
 	if lg := s.getLogger(); lg != nil {
@@ -1363,13 +1470,17 @@ func (s *EtcdServer) triggerSnapshot(ep *etcdProgress) {
 			zap.Uint64("local-member-applied-index", ep.appliedi),
 			zap.Uint64("local-member-snapshot-index", ep.snapi),
 			zap.Uint64("local-member-snapshot-count", s.Cfg.SnapshotCount),
+			zap.Uint64("local-member-snapshot-bytes", ep.snapBytes),
+			zap.String("reason", reason),
 		)
 	} else {
-		plog.Infof("start to snapshot (applied: %d, lastsnap: %d)", ep.appliedi, ep.snapi)
+		plog.Infof("start to snapshot (applied: %d, lastsnap: %d, bytes: %d)", ep.appliedi, ep.snapi, ep.snapBytes)
 	}
 
+	snapshotTriggers.WithLabelValues(reason).Inc()
 	s.snapshot(ep.appliedi, ep.confState)
 	ep.snapi = ep.appliedi
+	ep.snapBytes = 0
 }
 
 func (s *EtcdServer) isMultiNode() bool {
@@ -1380,8 +1491,17 @@ func (s *EtcdServer) isLeader() bool {
 	return uint64(s.ID()) == s.Lead()
 }
 
+// IsWitness returns if the local member is a raft witness.
+func (s *EtcdServer) IsWitness() bool {
+	return s.cluster.IsLocalMemberWitness()
+}
+
 // MoveLeader transfers the leader to the given transferee.
 func (s *EtcdServer) MoveLeader(ctx context.Context, lead, transferee uint64) error {
+	if m := s.cluster.Member(types.ID(transferee)); m != nil && m.IsWitness {
+		return ErrWitnessTransferee
+	}
+
 	now := time.Now()
 	interval := time.Duration(s.Cfg.TickMs) * time.Millisecond
 
@@ -1448,7 +1568,7 @@ func (s *EtcdServer) TransferLeadership() error {
 		return nil
 	}
 
-	transferee, ok := longestConnected(s.r.transport, s.cluster.MemberIDs())
+	transferee, ok := longestConnected(s.r.transport, s.cluster.TransfereeIDs())
 	if !ok {
 		return ErrUnhealthy
 	}
@@ -1544,50 +1664,69 @@ func (s *EtcdServer) AddMember(ctx context.Context, memb membership.Member) ([]*
 		return nil, err
 	}
 
-	if s.Cfg.StrictReconfigCheck {
-		// by default StrictReconfigCheck is enabled; reject new members if unhealthy
-		if !s.cluster.IsReadyToAddNewMember() {
-			if lg := s.getLogger(); lg != nil {
-				lg.Warn(
-					"rejecting member add request; not enough healthy members",
-					zap.String("local-member-id", s.ID().String()),
-					zap.String("requested-member-add", fmt.Sprintf("%+v", memb)),
-					zap.Error(ErrNotEnoughStartedMembers),
-				)
-			} else {
-				plog.Warningf("not enough started members, rejecting member add %+v", memb)
-			}
-			return nil, ErrNotEnoughStartedMembers
-		}
-
-		if !isConnectedFullySince(s.r.transport, time.Now().Add(-HealthInterval), s.ID(), s.cluster.Members()) {
-			if lg := s.getLogger(); lg != nil {
-				lg.Warn(
-					"rejecting member add request; local member has not been connected to all peers, reconfigure breaks active quorum",
-					zap.String("local-member-id", s.ID().String()),
-					zap.String("requested-member-add", fmt.Sprintf("%+v", memb)),
-					zap.Error(ErrUnhealthy),
-				)
-			} else {
-				plog.Warningf("not healthy for reconfigure, rejecting member add %+v", memb)
-			}
-			return nil, ErrUnhealthy
-		}
-	}
-
 	// TODO: move Member to protobuf type
 	b, err := json.Marshal(memb)
 	if err != nil {
 		return nil, err
 	}
+
+	// by default StrictReconfigCheck is enabled; reject new members if unhealthy.
+	if err := s.mayAddMember(memb); err != nil {
+		return nil, err
+	}
+
 	cc := raftpb.ConfChange{
 		Type:    raftpb.ConfChangeAddNode,
 		NodeID:  uint64(memb.ID),
 		Context: b,
 	}
+
+	if memb.IsLearner {
+		cc.Type = raftpb.ConfChangeAddLearnerNode
+	} else if memb.IsWitness {
+		cc.Type = raftpb.ConfChangeAddWitnessNode
+	}
+
 	return s.configure(ctx, cc)
 }
 
+func (s *EtcdServer) mayAddMember(memb membership.Member) error {
+	if !s.Cfg.StrictReconfigCheck {
+		return nil
+	}
+
+	// protect quorum when adding voting member
+	if !memb.IsLearner && !s.cluster.IsReadyToAddVotingMember() {
+		if lg := s.getLogger(); lg != nil {
+			lg.Warn(
+				"rejecting member add request; not enough healthy members",
+				zap.String("local-member-id", s.ID().String()),
+				zap.String("requested-member-add", fmt.Sprintf("%+v", memb)),
+				zap.Error(ErrNotEnoughStartedMembers),
+			)
+		} else {
+			plog.Warningf("not enough started members, rejecting member add %+v", memb)
+		}
+		return ErrNotEnoughStartedMembers
+	}
+
+	if !isConnectedFullySince(s.r.transport, time.Now().Add(-HealthInterval), s.ID(), s.cluster.VotingMembers()) {
+		if lg := s.getLogger(); lg != nil {
+			lg.Warn(
+				"rejecting member add request; local member has not been connected to all peers, reconfigure breaks active quorum",
+				zap.String("local-member-id", s.ID().String()),
+				zap.String("requested-member-add", fmt.Sprintf("%+v", memb)),
+				zap.Error(ErrUnhealthy),
+			)
+		} else {
+			plog.Warningf("not healthy for reconfigure, rejecting member add %+v", memb)
+		}
+		return ErrUnhealthy
+	}
+
+	return nil
+}
+
 func (s *EtcdServer) RemoveMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
 	if err := s.checkMembershipOperationPermission(ctx); err != nil {
 		return nil, err
@@ -1605,12 +1744,140 @@ func (s *EtcdServer) RemoveMember(ctx context.Context, id uint64) ([]*membership
 	return s.configure(ctx, cc)
 }
 
+// PromoteMember promotes a learner node to a voting node.
+func (s *EtcdServer) PromoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
+	// only raft leader has information on whether the to-be-promoted learner node is ready. If promoteMember call
+	// fails with ErrNotLeader, forward the request to leader node via HTTP. If promoteMember call fails with error
+	// other than ErrNotLeader, return the error.
+	resp, err := s.promoteMember(ctx, id)
+	if err != ErrNotLeader {
+		return resp, err
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, s.Cfg.ReqTimeout())
+	defer cancel()
+	// forward to leader
+	for cctx.Err() == nil {
+		leader, err := s.waitLeader(cctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, url := range leader.PeerURLs {
+			resp, err := promoteMemberHTTP(cctx, url, id, s.peerRt)
+			if err == nil {
+				return resp, nil
+			}
+			// If member promotion failed, return early. Otherwise keep retry.
+			if err == ErrLearnerNotReady || err == membership.ErrIDNotFound || err == membership.ErrMemberNotLearner {
+				return nil, err
+			}
+		}
+	}
+
+	if cctx.Err() == context.DeadlineExceeded {
+		return nil, ErrTimeout
+	}
+	return nil, ErrCanceled
+}
+
+// promoteMember checks whether the to-be-promoted learner node is ready before sending the promote
+// request to raft.
+// The function returns ErrNotLeader if the local node is not raft leader (therefore does not have
+// enough information to determine if the learner node is ready), returns ErrLearnerNotReady if the
+// local node is leader (therefore has enough information) but decided the learner node is not ready
+// to be promoted.
+func (s *EtcdServer) promoteMember(ctx context.Context, id uint64) ([]*membership.Member, error) {
+	if err := s.checkMembershipOperationPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	// check if we can promote this learner.
+	if err := s.mayPromoteMember(types.ID(id)); err != nil {
+		return nil, err
+	}
+
+	// build the context for the promote confChange. mark IsLearner to false and IsPromote to true.
+	promoteChangeContext := membership.ConfigChangeContext{
+		Member: membership.Member{
+			ID: types.ID(id),
+		},
+		IsPromote: true,
+	}
+
+	b, err := json.Marshal(promoteChangeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	cc := raftpb.ConfChange{
+		Type:    raftpb.ConfChangeAddNode,
+		NodeID:  id,
+		Context: b,
+	}
+
+	return s.configure(ctx, cc)
+}
+
+func (s *EtcdServer) mayPromoteMember(id types.ID) error {
+	if err := s.isLearnerReady(uint64(id)); err != nil {
+		return err
+	}
+
+	if !s.Cfg.StrictReconfigCheck {
+		return nil
+	}
+	if !s.cluster.IsReadyToPromoteMember(uint64(id)) {
+		if lg := s.getLogger(); lg != nil {
+			lg.Warn(
+				"rejecting member promote request; not enough healthy members",
+				zap.String("local-member-id", s.ID().String()),
+				zap.String("requested-member-promote-id", id.String()),
+				zap.Error(ErrNotEnoughStartedMembers),
+			)
+		} else {
+			plog.Warningf("not enough started members, rejecting promote member %s", id)
+		}
+		return ErrNotEnoughStartedMembers
+	}
+
+	return nil
+}
+
+// isLearnerReady checks whether the learner has caught up with the leader.
+// It returns nil if the member is not found in the cluster or is not a learner;
+// both conditions are checked again when the conf change is applied.
+func (s *EtcdServer) isLearnerReady(id uint64) error {
+	rs := s.r.Status()
+
+	// leader's raftStatus.Progress is not nil
+	if rs.Progress == nil {
+		return ErrNotLeader
+	}
+
+	pr, ok := rs.Progress[id]
+	if !ok {
+		return nil
+	}
+	leaderMatch := rs.Progress[rs.ID].Match
+	// the learner's Match not caught up with leader yet
+	if float64(pr.Match) < float64(leaderMatch)*readyPercent {
+		return ErrLearnerNotReady
+	}
+
+	return nil
+}
+
 func (s *EtcdServer) mayRemoveMember(id types.ID) error {
 	if !s.Cfg.StrictReconfigCheck {
 		return nil
 	}
 
-	if !s.cluster.IsReadyToRemoveMember(uint64(id)) {
+	// skip the quorum check when removing a learner; it does not vote
+	if isLearner := s.cluster.IsMemberExist(id) && s.cluster.Member(id).IsLearner; isLearner {
+		return nil
+	}
+
+	if !s.cluster.IsReadyToRemoveVotingMember(uint64(id)) {
 		if lg := s.getLogger(); lg != nil {
 			lg.Warn(
 				"rejecting member remove request; not enough healthy members",
@@ -1630,7 +1897,7 @@ func (s *EtcdServer) mayRemoveMember(id types.ID) error {
 	}
 
 	// protect quorum if some members are down
-	m := s.cluster.Members()
+	m := s.cluster.VotingMembers()
 	active := numConnectedSince(s.r.transport, time.Now().Add(-HealthInterval), s.ID(), m)
 	if (active - 1) < 1+((len(m)-1)/2) {
 		if lg := s.getLogger(); lg != nil {
@@ -2002,6 +2269,12 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 		id = raftReq.Header.ID
 	}
 
+	// a witness does not keep the keyspace; fail any proposal waiting on it.
+	if s.cluster.IsLocalMemberWitness() {
+		s.w.Trigger(id, &applyResult{err: ErrNotSupportedForWitness})
+		return
+	}
+
 	var ar *applyResult
 	needResult := s.w.IsRegistered(id)
 	if needResult || !noSideEffect(&raftReq) {
@@ -2054,29 +2327,34 @@ func (s *EtcdServer) applyConfChange(cc raftpb.ConfChange, confState *raftpb.Con
 	lg := s.getLogger()
 	*confState = *s.r.ApplyConfChange(cc)
 	switch cc.Type {
-	case raftpb.ConfChangeAddNode:
-		m := new(membership.Member)
-		if err := json.Unmarshal(cc.Context, m); err != nil {
+	case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode, raftpb.ConfChangeAddWitnessNode:
+		confChangeContext := new(membership.ConfigChangeContext)
+		if err := json.Unmarshal(cc.Context, confChangeContext); err != nil {
 			if lg != nil {
 				lg.Panic("failed to unmarshal member", zap.Error(err))
 			} else {
 				plog.Panicf("unmarshal member should never fail: %v", err)
 			}
 		}
-		if cc.NodeID != uint64(m.ID) {
+		if cc.NodeID != uint64(confChangeContext.Member.ID) {
 			if lg != nil {
 				lg.Panic(
 					"got different member ID",
 					zap.String("member-id-from-config-change-entry", types.ID(cc.NodeID).String()),
-					zap.String("member-id-from-message", m.ID.String()),
+					zap.String("member-id-from-message", confChangeContext.Member.ID.String()),
 				)
 			} else {
 				plog.Panicf("nodeID should always be equal to member ID")
 			}
 		}
-		s.cluster.AddMember(m)
-		if m.ID != s.id {
-			s.r.transport.AddPeer(m.ID, m.PeerURLs)
+		if confChangeContext.IsPromote {
+			s.cluster.PromoteMember(confChangeContext.Member.ID)
+		} else {
+			s.cluster.AddMember(&confChangeContext.Member)
+
+			if confChangeContext.Member.ID != s.id {
+				s.r.transport.AddPeer(confChangeContext.Member.ID, confChangeContext.PeerURLs)
+			}
 		}
 
 	case raftpb.ConfChangeRemoveNode:
@@ -2115,6 +2393,24 @@ func (s *EtcdServer) applyConfChange(cc raftpb.ConfChange, confState *raftpb.Con
 	return false, nil
 }
 
+// checkpointIndex saves the key index, so that the next start does not
+// rebuild it by scanning the entire backend.
+func (s *EtcdServer) checkpointIndex() {
+	start := time.Now()
+	err := s.KV().CheckpointIndex()
+	if lg := s.getLogger(); lg != nil {
+		if err != nil {
+			lg.Warn("failed to checkpoint key index", zap.Error(err))
+		} else {
+			lg.Info("checkpointed key index", zap.Duration("took", time.Since(start)))
+		}
+	} else if err != nil {
+		plog.Warningf("failed to checkpoint key index (%v)", err)
+	} else {
+		plog.Infof("checkpointed key index in %v", time.Since(start))
+	}
+}
+
 // TODO: non-blocking snapshot
 func (s *EtcdServer) snapshot(snapi uint64, confState raftpb.ConfState) {
 	clone := s.v2store.Clone()
@@ -2126,6 +2422,10 @@ func (s *EtcdServer) snapshot(snapi uint64, confState raftpb.ConfState) {
 	// the go routine created below.
 	s.KV().Commit()
 
+	if s.Cfg.IndexCheckpoint {
+		s.goAttach(s.checkpointIndex)
+	}
+
 	s.goAttach(func() {
 		lg := s.getLogger()
 
@@ -2189,6 +2489,17 @@ func (s *EtcdServer) snapshot(snapi uint64, confState raftpb.ConfState) {
 		if snapi > s.Cfg.SnapshotCatchUpEntries {
 			compacti = snapi - s.Cfg.SnapshotCatchUpEntries
 		}
+		if s.Cfg.SnapshotCatchUpBytes > 0 {
+			if first, _ := s.r.raftStorage.FirstIndex(); first > compacti+1 {
+				compacti = first - 1
+			}
+			if compacti < snapi {
+				ents, err := s.r.raftStorage.Entries(compacti+1, snapi+1, math.MaxUint64)
+				if err == nil {
+					compacti = catchUpCompactIndex(ents, compacti, s.Cfg.SnapshotCatchUpBytes)
+				}
+			}
+		}
 
 		err = s.r.raftStorage.Compact(compacti)
 		if err != nil {
@@ -2287,6 +2598,65 @@ func (s *EtcdServer) monitorVersions() {
 	}
 }
 
+// monitorLeaderPriority transfers the leadership of the local member to the
+// healthy member with the highest priority, if it is higher than the local
+// one. Both the leader and the transferee must have been stable for an entire
+// LeaderBalanceInterval, so that the leadership does not flap.
+func (s *EtcdServer) monitorLeaderPriority() {
+	interval := s.Cfg.LeaderBalanceInterval
+	if interval == 0 {
+		return
+	}
+	lg := s.getLogger()
+	for {
+		select {
+		case <-time.After(interval):
+		case <-s.stopping:
+			return
+		}
+
+		if !s.isLeader() {
+			continue
+		}
+		now := time.Now()
+		s.leadTimeMu.RLock()
+		elected := s.leadElectedTime
+		s.leadTimeMu.RUnlock()
+		if now.Sub(elected) < interval {
+			continue
+		}
+
+		transferee, ok := preferredLeader(s.r.transport, s.cluster.Members(), s.ID(), now.Add(-interval))
+		if !ok {
+			continue
+		}
+		if lg != nil {
+			lg.Info(
+				"transferring leadership to member with higher priority",
+				zap.String("local-member-id", s.ID().String()),
+				zap.String("transferee-member-id", transferee.String()),
+			)
+		} else {
+			plog.Infof("%s transfers leadership to %s with higher priority", s.ID(), transferee)
+		}
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		err := s.MoveLeader(ctx, s.Lead(), uint64(transferee))
+		cancel()
+		if err != nil {
+			if lg != nil {
+				lg.Warn(
+					"failed to transfer leadership to member with higher priority",
+					zap.String("local-member-id", s.ID().String()),
+					zap.String("transferee-member-id", transferee.String()),
+					zap.Error(err),
+				)
+			} else {
+				plog.Warningf("%s failed to transfer leadership to %s (%v)", s.ID(), transferee, err)
+			}
+		}
+	}
+}
+
 func (s *EtcdServer) updateClusterVersion(ver string) {
 	lg := s.getLogger()
 
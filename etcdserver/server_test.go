@@ -508,6 +508,12 @@ func TestApplyConfChangeError(t *testing.T) {
 	}
 	cl.RemoveMember(4)
 
+	attr := membership.RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", 1)}}
+	ctx, err := json.Marshal(&membership.Member{ID: types.ID(1), RaftAttributes: attr})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		cc   raftpb.ConfChange
 		werr error
@@ -528,8 +534,9 @@ func TestApplyConfChangeError(t *testing.T) {
 		},
 		{
 			raftpb.ConfChange{
-				Type:   raftpb.ConfChangeAddNode,
-				NodeID: 1,
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  1,
+				Context: ctx,
 			},
 			membership.ErrIDExists,
 		},
@@ -553,7 +560,7 @@ func TestApplyConfChangeError(t *testing.T) {
 		if err != tt.werr {
 			t.Errorf("#%d: applyConfChange error = %v, want %v", i, err, tt.werr)
 		}
-		cc := raftpb.ConfChange{Type: tt.cc.Type, NodeID: raft.None}
+		cc := raftpb.ConfChange{Type: tt.cc.Type, NodeID: raft.None, Context: tt.cc.Context}
 		w := []testutil.Action{
 			{
 				Name:   "ApplyConfChange",
@@ -1000,6 +1007,78 @@ func TestSnapshot(t *testing.T) {
 	<-ch
 }
 
+// TestCreateMergedSnapshotMessageWitness ensures a witness is sent the
+// membership without the backend.
+func TestCreateMergedSnapshotMessageWitness(t *testing.T) {
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.RemoveAll(tmpPath)
+	}()
+
+	witness := &membership.Member{ID: 3}
+	witness.IsWitness = true
+	srv := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      zap.NewExample(),
+		v2store: v2store.New(),
+		cluster: newTestCluster([]*membership.Member{{ID: 2}, witness}),
+	}
+	srv.kv = mvcc.New(zap.NewExample(), be, &lease.FakeLessor{}, &srv.consistIndex)
+	srv.be = be
+
+	for i, tt := range []struct {
+		to uint64
+
+		wdb bool
+	}{
+		{2, true},
+		{3, false},
+	} {
+		m := srv.createMergedSnapshotMessage(raftpb.Message{Type: raftpb.MsgSnap, To: tt.to}, 1, 10, raftpb.ConfState{Nodes: []uint64{1, 2, 3}})
+		b, err := ioutil.ReadAll(m.ReadCloser)
+		m.ReadCloser.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Snapshot.Metadata.Index != 10 || len(m.Snapshot.Data) == 0 {
+			t.Errorf("#%d: snapshot = %+v, want the membership at index 10", i, m.Snapshot)
+		}
+		if db := len(b) != 0; db != tt.wdb {
+			t.Errorf("#%d: sent backend = %v, want %v", i, db, tt.wdb)
+		}
+	}
+}
+
+// TestApplyEntryNormalWitness ensures a witness fails the v3 proposal
+// waiting on an entry instead of leaving it to time out.
+func TestApplyEntryNormalWitness(t *testing.T) {
+	witness := &membership.Member{ID: 1}
+	witness.IsWitness = true
+	cl := newTestCluster([]*membership.Member{witness})
+	cl.SetID(1, 0)
+	srv := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      zap.NewExample(),
+		id:      1,
+		cluster: cl,
+		w:       wait.New(),
+	}
+
+	req := &pb.InternalRaftRequest{Header: &pb.RequestHeader{ID: 1}, Compaction: &pb.CompactionRequest{Revision: 1}}
+	ch := srv.w.Register(1)
+	srv.applyEntryNormal(&raftpb.Entry{Index: 1, Data: pbutil.MustMarshal(req)})
+
+	select {
+	case x := <-ch:
+		if ar, ok := x.(*applyResult); !ok || ar.err != ErrNotSupportedForWitness {
+			t.Fatalf("result = %+v, want %v", x, ErrNotSupportedForWitness)
+		}
+	default:
+		t.Fatal("expected the waiter to be triggered")
+	}
+}
+
 // TestSnapshotOrdering ensures raft persists snapshot onto disk before
 // snapshot db is applied.
 func TestSnapshotOrdering(t *testing.T) {
@@ -1275,6 +1354,49 @@ func TestAddMember(t *testing.T) {
 	}
 }
 
+// TestAddLearnerMember tests AddMember can propose and perform learner node addition.
+func TestAddLearnerMember(t *testing.T) {
+	n := newNodeConfChangeCommitterRecorder()
+	n.readyc <- raft.Ready{
+		SoftState: &raft.SoftState{RaftState: raft.StateLeader},
+	}
+	cl := newTestCluster(nil)
+	st := v2store.New()
+	cl.SetStore(st)
+	r := newRaftNode(raftNodeConfig{
+		lg:          zap.NewExample(),
+		Node:        n,
+		raftStorage: raft.NewMemoryStorage(),
+		storage:     mockstorage.NewStorageRecorder(""),
+		transport:   newNopTransporter(),
+	})
+	s := &EtcdServer{
+		lgMu:       new(sync.RWMutex),
+		lg:         zap.NewExample(),
+		r:          *r,
+		v2store:    st,
+		cluster:    cl,
+		reqIDGen:   idutil.NewGenerator(0, time.Time{}),
+		SyncTicker: &time.Ticker{},
+	}
+	s.start()
+	m := membership.Member{ID: 1234, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"foo"}, IsLearner: true}}
+	_, err := s.AddMember(context.TODO(), m)
+	gaction := n.Action()
+	s.Stop()
+
+	if err != nil {
+		t.Fatalf("AddMember error: %v", err)
+	}
+	wactions := []testutil.Action{{Name: "ProposeConfChange:ConfChangeAddLearnerNode"}, {Name: "ApplyConfChange:ConfChangeAddLearnerNode"}}
+	if !reflect.DeepEqual(gaction, wactions) {
+		t.Errorf("action = %v, want %v", gaction, wactions)
+	}
+	if !cl.IsMemberExist(1234) || !cl.Member(1234).IsLearner {
+		t.Errorf("learner member with id 1234 is not added")
+	}
+}
+
 // TestRemoveMember tests RemoveMember can propose and perform node removal.
 func TestRemoveMember(t *testing.T) {
 	n := newNodeConfChangeCommitterRecorder()
@@ -1610,7 +1732,7 @@ func (n *nodeRecorder) Propose(ctx context.Context, data []byte) error {
 	n.Record(testutil.Action{Name: "Propose", Params: []interface{}{data}})
 	return nil
 }
-func (n *nodeRecorder) ProposeConfChange(ctx context.Context, conf raftpb.ConfChange) error {
+func (n *nodeRecorder) ProposeConfChange(ctx context.Context, conf raftpb.ConfChangeI) error {
 	n.Record(testutil.Action{Name: "ProposeConfChange"})
 	return nil
 }
@@ -1623,7 +1745,7 @@ func (n *nodeRecorder) Ready() <-chan raft.Ready
 func (n *nodeRecorder) TransferLeadership(ctx context.Context, lead, transferee uint64) {}
 func (n *nodeRecorder) ReadIndex(ctx context.Context, rctx []byte) error                { return nil }
 func (n *nodeRecorder) Advance()                                                        {}
-func (n *nodeRecorder) ApplyConfChange(conf raftpb.ConfChange) *raftpb.ConfState {
+func (n *nodeRecorder) ApplyConfChange(conf raftpb.ConfChangeI) *raftpb.ConfState {
 	n.Record(testutil.Action{Name: "ApplyConfChange", Params: []interface{}{conf}})
 	return &raftpb.ConfState{}
 }
@@ -1684,21 +1806,21 @@ func newNodeConfChangeCommitterStream() *nodeConfChangeCommitterRecorder {
 	return &nodeConfChangeCommitterRecorder{*newReadyNode(), 0}
 }
 
-func (n *nodeConfChangeCommitterRecorder) ProposeConfChange(ctx context.Context, conf raftpb.ConfChange) error {
-	data, err := conf.Marshal()
+func (n *nodeConfChangeCommitterRecorder) ProposeConfChange(ctx context.Context, conf raftpb.ConfChangeI) error {
+	typ, data, err := raftpb.MarshalConfChange(conf)
 	if err != nil {
 		return err
 	}
 	n.index++
-	n.Record(testutil.Action{Name: "ProposeConfChange:" + conf.Type.String()})
-	n.readyc <- raft.Ready{CommittedEntries: []raftpb.Entry{{Index: n.index, Type: raftpb.EntryConfChange, Data: data}}}
+	n.Record(testutil.Action{Name: "ProposeConfChange:" + conf.AsV2().Changes[0].Type.String()})
+	n.readyc <- raft.Ready{CommittedEntries: []raftpb.Entry{{Index: n.index, Type: typ, Data: data}}}
 	return nil
 }
 func (n *nodeConfChangeCommitterRecorder) Ready() <-chan raft.Ready {
 	return n.readyc
 }
-func (n *nodeConfChangeCommitterRecorder) ApplyConfChange(conf raftpb.ConfChange) *raftpb.ConfState {
-	n.Record(testutil.Action{Name: "ApplyConfChange:" + conf.Type.String()})
+func (n *nodeConfChangeCommitterRecorder) ApplyConfChange(conf raftpb.ConfChangeI) *raftpb.ConfState {
+	n.Record(testutil.Action{Name: "ApplyConfChange:" + conf.AsV2().Changes[0].Type.String()})
 	return &raftpb.ConfState{}
 }
 
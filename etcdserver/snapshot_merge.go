@@ -15,10 +15,13 @@
 package etcdserver
 
 import (
+	"bytes"
 	"io"
+	"io/ioutil"
 
 	"go.etcd.io/etcd/etcdserver/api/snap"
 	"go.etcd.io/etcd/mvcc/backend"
+	"go.etcd.io/etcd/pkg/types"
 	"go.etcd.io/etcd/raft/raftpb"
 
 	humanize "github.com/dustin/go-humanize"
@@ -27,7 +30,7 @@ import (
 
 // createMergedSnapshotMessage creates a snapshot message that contains: raft status (term, conf),
 // a snapshot of v2 store inside raft.Snapshot as []byte, a snapshot of v3 KV in the top level message
-// as ReadCloser.
+// as ReadCloser. A witness keeps no v3 KV, so the one sent to a witness is empty.
 func (s *EtcdServer) createMergedSnapshotMessage(m raftpb.Message, snapt, snapi uint64, confState raftpb.ConfState) snap.Message {
 	// get a snapshot of v2 store as []byte
 	clone := s.v2store.Clone()
@@ -40,12 +43,6 @@ func (s *EtcdServer) createMergedSnapshotMessage(m raftpb.Message, snapt, snapi
 		}
 	}
 
-	// commit kv to write metadata(for example: consistent index).
-	s.KV().Commit()
-	dbsnap := s.be.Snapshot()
-	// get a snapshot of v3 KV as readCloser
-	rc := newSnapshotReaderCloser(s.getLogger(), dbsnap)
-
 	// put the []byte snapshot of store into raft snapshot and return the merged snapshot with
 	// KV readCloser snapshot.
 	snapshot := raftpb.Snapshot{
@@ -58,6 +55,16 @@ func (s *EtcdServer) createMergedSnapshotMessage(m raftpb.Message, snapt, snapi
 	}
 	m.Snapshot = snapshot
 
+	if mb := s.cluster.Member(types.ID(m.To)); mb != nil && mb.IsWitness {
+		return *snap.NewMessage(m, ioutil.NopCloser(&bytes.Buffer{}), 0)
+	}
+
+	// commit kv to write metadata(for example: consistent index).
+	s.KV().Commit()
+	dbsnap := s.be.Snapshot()
+	// get a snapshot of v3 KV as readCloser
+	rc := newSnapshotReaderCloser(s.getLogger(), dbsnap)
+
 	return *snap.NewMessage(m, rc, dbsnap.Size())
 }
 
@@ -25,6 +25,7 @@ import (
 	"go.etcd.io/etcd/etcdserver/api/rafthttp"
 	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
 	"go.etcd.io/etcd/pkg/types"
+	"go.etcd.io/etcd/raft/raftpb"
 
 	"go.uber.org/zap"
 )
@@ -87,17 +88,72 @@ func longestConnected(tp rafthttp.Transporter, membs []types.ID) (types.ID, bool
 	return longest, true
 }
 
+// preferredLeader chooses the voting member with the highest priority that is
+// higher than the one of the local member and that has been connected since
+// the given time. Witnesses and learners cannot lead; ties go to the member
+// listed first. It returns false, if no member qualifies.
+func preferredLeader(tp rafthttp.Transporter, membs []*membership.Member, self types.ID, since time.Time) (types.ID, bool) {
+	var (
+		best     types.ID
+		priority int
+	)
+	for _, m := range membs {
+		if m.ID == self {
+			priority = m.Priority
+		}
+	}
+	for _, m := range membs {
+		if m.ID == self || m.IsLearner || m.IsWitness || m.Priority <= priority {
+			continue
+		}
+		if !isConnectedSince(tp, since, m.ID) {
+			continue
+		}
+		best, priority = m.ID, m.Priority
+	}
+	return best, best != 0
+}
+
+// catchUpCompactIndex returns the index up to which the raft log can be
+// compacted so that the given entries kept for slow followers, which directly
+// follow compacti, do not exceed maxBytes in aggregate.
+func catchUpCompactIndex(ents []raftpb.Entry, compacti, maxBytes uint64) uint64 {
+	var size uint64
+	for i := len(ents) - 1; i >= 0; i-- {
+		size += uint64(ents[i].Size())
+		if size > maxBytes {
+			return ents[i].Index
+		}
+	}
+	return compacti
+}
+
 type notifier struct {
 	c   chan struct{}
 	err error
+	// leaseRead is set by the linearizable read loop before notifying if the
+	// read index was served from the leader lease.
+	leaseRead bool
+
+	// readIndexc is closed once readIndex holds the read index obtained by
+	// the linearizable read loop, which may be before c is closed.
+	readIndexc chan struct{}
+	readIndex  uint64
 }
 
 func newNotifier() *notifier {
 	return &notifier{
-		c: make(chan struct{}),
+		c:          make(chan struct{}),
+		readIndexc: make(chan struct{}),
 	}
 }
 
+// notifyReadIndex makes the read index available to bounded-staleness reads.
+func (nc *notifier) notifyReadIndex(index uint64) {
+	nc.readIndex = index
+	close(nc.readIndexc)
+}
+
 func (nc *notifier) notify(err error) {
 	nc.err = err
 	close(nc.c)
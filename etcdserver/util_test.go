@@ -61,6 +61,89 @@ func TestLongestConnected(t *testing.T) {
 	}
 }
 
+func TestPreferredLeader(t *testing.T) {
+	now := time.Now()
+	newMember := func(id uint64, priority int) *membership.Member {
+		return &membership.Member{ID: types.ID(id), Attributes: membership.Attributes{Priority: priority}}
+	}
+	witness := newMember(4, 9)
+	witness.IsWitness = true
+	learner := newMember(5, 9)
+	learner.IsLearner = true
+
+	tests := []struct {
+		membs  []*membership.Member
+		active map[types.ID]time.Time
+
+		wid types.ID
+		wok bool
+	}{
+		// equal priorities never move the leadership
+		{
+			[]*membership.Member{newMember(1, 0), newMember(2, 0), newMember(3, 0)},
+			map[types.ID]time.Time{2: now.Add(-time.Hour), 3: now.Add(-time.Hour)},
+			0, false,
+		},
+		// the highest priority wins, the lowest ID breaks ties
+		{
+			[]*membership.Member{newMember(1, 1), newMember(2, 2), newMember(3, 2)},
+			map[types.ID]time.Time{2: now.Add(-time.Hour), 3: now.Add(-time.Hour)},
+			2, true,
+		},
+		// members connected too recently are skipped
+		{
+			[]*membership.Member{newMember(1, 1), newMember(2, 3), newMember(3, 2)},
+			map[types.ID]time.Time{2: now, 3: now.Add(-time.Hour)},
+			3, true,
+		},
+		// the local member already has the highest priority
+		{
+			[]*membership.Member{newMember(1, 3), newMember(2, 2), newMember(3, 1)},
+			map[types.ID]time.Time{2: now.Add(-time.Hour), 3: now.Add(-time.Hour)},
+			0, false,
+		},
+		// witnesses and learners cannot lead
+		{
+			[]*membership.Member{newMember(1, 0), newMember(2, 0), witness, learner},
+			map[types.ID]time.Time{2: now.Add(-time.Hour), 4: now.Add(-time.Hour), 5: now.Add(-time.Hour)},
+			0, false,
+		},
+	}
+	for i, tt := range tests {
+		tr := &nopTransporterWithActiveTime{activeMap: tt.active}
+		id, ok := preferredLeader(tr, tt.membs, 1, now.Add(-time.Minute))
+		if id != tt.wid || ok != tt.wok {
+			t.Errorf("#%d: preferredLeader = (%s, %v), want (%s, %v)", i, id, ok, tt.wid, tt.wok)
+		}
+	}
+}
+
+func TestCatchUpCompactIndex(t *testing.T) {
+	ents := make([]raftpb.Entry, 10)
+	for i := range ents {
+		ents[i] = raftpb.Entry{Index: uint64(11 + i), Term: 1, Data: make([]byte, 100)}
+	}
+	esize := uint64(ents[0].Size())
+
+	tests := []struct {
+		maxBytes uint64
+		wcompact uint64
+	}{
+		// everything fits, keep the original compact index
+		{10 * esize, 10},
+		{100 * esize, 10},
+		// only keep as many trailing entries as fit
+		{3 * esize, 17},
+		{3*esize + 1, 17},
+		{0, 20},
+	}
+	for i, tt := range tests {
+		if g := catchUpCompactIndex(ents, 10, tt.maxBytes); g != tt.wcompact {
+			t.Errorf("#%d: compact index = %d, want %d", i, g, tt.wcompact)
+		}
+	}
+}
+
 type nopTransporterWithActiveTime struct {
 	activeMap map[types.ID]time.Time
 }
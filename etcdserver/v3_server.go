@@ -92,7 +92,11 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 	}(time.Now())
 
 	if !r.Serializable {
-		err = s.linearizableReadNotify(ctx)
+		if r.MaxStaleness > 0 {
+			err = s.boundedStalenessReadNotify(ctx, uint64(r.MaxStaleness))
+		} else {
+			err = s.linearizableReadNotify(ctx)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -186,6 +190,10 @@ func isTxnReadonly(r *pb.TxnRequest) bool {
 }
 
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	if len(r.Retain) == 0 && s.retainer != nil {
+		// the retention goes in the request so every member applies the same
+		r = &pb.CompactionRequest{Revision: r.Revision, Physical: r.Physical, Retain: s.retainer.Retain()}
+	}
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Compaction: r})
 	if r.Physical && result != nil && result.physc != nil {
 		<-result.physc
@@ -607,6 +615,10 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	if err != nil {
 		proposalsFailed.Inc()
 		s.w.Trigger(id, nil) // GC wait
+		if err == raft.ErrProposalDropped && s.uncommittedSizeExceeded(len(data)) {
+			// let the client back off until the uncommitted log shrinks
+			return nil, ErrTooManyRequests
+		}
 		return nil, err
 	}
 	proposalsPending.Inc()
@@ -624,6 +636,22 @@ func (s *EtcdServer) processInternalRaftRequestOnce(ctx context.Context, r pb.In
 	}
 }
 
+// uncommittedSizeExceeded reports whether a dropped proposal of the given size
+// would have pushed the uncommitted raft log past MaxUncommittedEntriesBytes.
+// Raft drops proposals for other reasons too, such as having no leader or
+// transferring the leadership, which the client should retry instead.
+func (s *EtcdServer) uncommittedSizeExceeded(size int) bool {
+	max := s.Cfg.MaxUncommittedEntriesBytes
+	if max == 0 {
+		return false
+	}
+	st := s.r.Status()
+	if st.RaftState != raft.StateLeader || st.LeadTransferee != raft.None {
+		return false
+	}
+	return st.UncommittedSize > 0 && st.UncommittedSize+uint64(size) > max
+}
+
 // Watchable returns a watchable interface attached to the etcdserver.
 func (s *EtcdServer) Watchable() mvcc.WatchableKV { return s.KV() }
 
@@ -716,15 +744,25 @@ func (s *EtcdServer) linearizableReadLoop() {
 			continue
 		}
 
-		if ai := s.getAppliedIndex(); ai < rs.Index {
+		// bounded-staleness reads only need the read index
+		nr.notifyReadIndex(rs.Index)
+
+		// unblock all l-reads requested at indices before rs.Index
+		nr.leaseRead = rs.LeaseBased
+		if ai := s.getAppliedIndex(); ai >= rs.Index {
+			nr.notify(nil)
+			continue
+		}
+		// wait for the apply outside of the loop, so that the next rounds
+		// still serve bounded-staleness reads while the apply lags behind
+		index := rs.Index
+		s.goAttach(func() {
 			select {
-			case <-s.applyWait.Wait(rs.Index):
+			case <-s.applyWait.Wait(index):
+				nr.notify(nil)
 			case <-s.stopping:
-				return
 			}
-		}
-		// unblock all l-reads requested at indices before rs.Index
-		nr.notify(nil)
+		})
 	}
 }
 
@@ -742,6 +780,13 @@ func (s *EtcdServer) linearizableReadNotify(ctx context.Context) error {
 	// wait for read state notification
 	select {
 	case <-nc.c:
+		if nc.err == nil {
+			if nc.leaseRead {
+				linearizableReads.WithLabelValues("lease").Inc()
+			} else {
+				linearizableReads.WithLabelValues("read_index").Inc()
+			}
+		}
 		return nc.err
 	case <-ctx.Done():
 		return ctx.Err()
@@ -750,6 +795,48 @@ func (s *EtcdServer) linearizableReadNotify(ctx context.Context) error {
 	}
 }
 
+// boundedStalenessReadNotify waits until the local applied index is at most
+// maxStaleness entries behind the read index of the next linearizable read
+// round, without waiting for the rest of that round to be applied.
+func (s *EtcdServer) boundedStalenessReadNotify(ctx context.Context, maxStaleness uint64) error {
+	s.readMu.RLock()
+	nc := s.readNotifier
+	s.readMu.RUnlock()
+
+	// signal linearizable loop for current notify if it hasn't been already
+	select {
+	case s.readwaitc <- struct{}{}:
+	default:
+	}
+
+	// wait for the read index, or for the whole round if it fails
+	select {
+	case <-nc.readIndexc:
+	case <-nc.c:
+		return nc.err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return ErrStopped
+	}
+
+	if nc.readIndex <= maxStaleness {
+		return nil
+	}
+	index := nc.readIndex - maxStaleness
+	if ai := s.getAppliedIndex(); ai >= index {
+		return nil
+	}
+	select {
+	case <-s.applyWait.Wait(index):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return ErrStopped
+	}
+}
+
 func (s *EtcdServer) AuthInfoFromCtx(ctx context.Context) (*auth.AuthInfo, error) {
 	authInfo, err := s.AuthStore().AuthInfoFromCtx(ctx)
 	if authInfo != nil || err != nil {
@@ -0,0 +1,187 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"go.etcd.io/etcd/auth"
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"go.etcd.io/etcd/lease"
+	"go.etcd.io/etcd/mvcc"
+	"go.etcd.io/etcd/mvcc/backend"
+	"go.etcd.io/etcd/pkg/idutil"
+	"go.etcd.io/etcd/pkg/wait"
+	"go.etcd.io/etcd/raft"
+)
+
+// readIndexNode answers every read index request with a fixed index.
+type readIndexNode struct {
+	*nodeRecorder
+	index      uint64
+	readStateC chan<- raft.ReadState
+}
+
+func (n *readIndexNode) ReadIndex(ctx context.Context, rctx []byte) error {
+	n.readStateC <- raft.ReadState{Index: n.index, RequestCtx: rctx}
+	return nil
+}
+
+// TestBoundedStalenessRangeLagsApply ensures a bounded-staleness range returns
+// as soon as the applied index is within its staleness of the read index,
+// while a linearizable range waits for the read index to be applied.
+func TestBoundedStalenessRangeLagsApply(t *testing.T) {
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.RemoveAll(tmpPath)
+	}()
+
+	n := &readIndexNode{nodeRecorder: newNodeRecorder(), index: 12}
+	r := newRaftNode(raftNodeConfig{lg: zap.NewExample(), Node: n})
+	n.readStateC = r.readStateC
+	srv := &EtcdServer{
+		lgMu:          new(sync.RWMutex),
+		lg:            zap.NewExample(),
+		Cfg:           ServerConfig{Logger: zap.NewExample(), TickMs: 1, ElectionTicks: 1},
+		r:             *r,
+		reqIDGen:      idutil.NewGenerator(0, time.Time{}),
+		applyWait:     wait.NewTimeList(),
+		readwaitc:     make(chan struct{}, 1),
+		readNotifier:  newNotifier(),
+		leaderChanged: make(chan struct{}),
+		stopping:      make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	srv.kv = mvcc.New(zap.NewExample(), be, &lease.FakeLessor{}, &srv.consistIndex)
+	srv.authStore = auth.NewAuthStore(zap.NewExample(), be, nil, bcrypt.MinCost)
+	srv.applyV3Base = srv.newApplierV3Backend()
+	// hold apply back 2 entries behind the read index
+	srv.setAppliedIndex(10)
+
+	srv.goAttach(srv.linearizableReadLoop)
+	defer func() {
+		srv.wgMu.Lock()
+		close(srv.stopping)
+		srv.wgMu.Unlock()
+		srv.wg.Wait()
+		close(srv.done)
+	}()
+
+	lerrc := make(chan error, 1)
+	go func() {
+		_, err := srv.Range(context.Background(), &pb.RangeRequest{Key: []byte("foo")})
+		lerrc <- err
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	_, err := srv.Range(ctx, &pb.RangeRequest{Key: []byte("foo"), MaxStaleness: 5})
+	cancel()
+	if err != nil {
+		t.Fatalf("bounded-staleness range error = %v, want nil", err)
+	}
+	select {
+	case err = <-lerrc:
+		t.Fatalf("linearizable range returned %v before the read index was applied", err)
+	default:
+	}
+
+	// a staleness bound below the lag waits for the apply as well
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	_, err = srv.Range(ctx, &pb.RangeRequest{Key: []byte("foo"), MaxStaleness: 1})
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Fatalf("bounded-staleness range error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	srv.setAppliedIndex(12)
+	srv.applyWait.Trigger(12)
+	select {
+	case err = <-lerrc:
+		if err != nil {
+			t.Fatalf("linearizable range error = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("linearizable range did not return after the read index was applied")
+	}
+}
+
+// dropNode drops every proposal, reporting the given raft status.
+type dropNode struct {
+	*nodeRecorder
+	status raft.Status
+}
+
+func (n *dropNode) Propose(ctx context.Context, data []byte) error { return raft.ErrProposalDropped }
+func (n *dropNode) Status() raft.Status                            { return n.status }
+
+// TestProcessInternalRaftRequestDropped ensures only the proposals dropped for
+// the uncommitted log size fail with ErrTooManyRequests.
+func TestProcessInternalRaftRequestDropped(t *testing.T) {
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		be.Close()
+		os.RemoveAll(tmpPath)
+	}()
+
+	leader := func(uncommitted, transferee uint64) raft.Status {
+		var st raft.Status
+		st.RaftState = raft.StateLeader
+		st.LeadTransferee = transferee
+		st.UncommittedSize = uncommitted
+		return st
+	}
+	tests := []struct {
+		maxBytes uint64
+		status   raft.Status
+
+		werr error
+	}{
+		// the uncommitted log is full
+		{100, leader(100, raft.None), ErrTooManyRequests},
+		// no leader
+		{100, raft.Status{}, raft.ErrProposalDropped},
+		// the leadership is being transferred
+		{100, leader(100, 2), raft.ErrProposalDropped},
+		// the uncommitted log has room
+		{1 << 20, leader(100, raft.None), raft.ErrProposalDropped},
+		// no limit
+		{0, leader(100, raft.None), raft.ErrProposalDropped},
+	}
+	for i, tt := range tests {
+		r := newRaftNode(raftNodeConfig{lg: zap.NewExample(), Node: &dropNode{nodeRecorder: newNodeRecorder(), status: tt.status}})
+		srv := &EtcdServer{
+			lgMu:     new(sync.RWMutex),
+			lg:       zap.NewExample(),
+			Cfg:      ServerConfig{Logger: zap.NewExample(), TickMs: 1, ElectionTicks: 1, MaxRequestBytes: 1 << 20, MaxUncommittedEntriesBytes: tt.maxBytes},
+			r:        *r,
+			reqIDGen: idutil.NewGenerator(0, time.Time{}),
+			w:        wait.New(),
+		}
+		srv.authStore = auth.NewAuthStore(zap.NewExample(), be, nil, bcrypt.MinCost)
+
+		req := pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}}
+		if _, err := srv.processInternalRaftRequestOnce(context.Background(), req); err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
@@ -150,6 +150,14 @@ type ClusterConfig struct {
 	UseIP bool
 
 	LeaseCheckpointInterval time.Duration
+
+	MaxWatchersPerConnection           int64
+	MaxLeaseKeepAlivesPerConnection    int64
+	MaxWatchBufferedBytesPerConnection int64
+
+	AutoDefragCheckInterval time.Duration
+	AutoDefragRatio         float64
+	AutoDefragMinBytes      uint64
 }
 
 type cluster struct {
@@ -293,6 +301,12 @@ func (c *cluster) mustNewMember(t testing.TB) *member {
 			clientMaxCallRecvMsgSize: c.cfg.ClientMaxCallRecvMsgSize,
 			useIP:                    c.cfg.UseIP,
 			leaseCheckpointInterval:  c.cfg.LeaseCheckpointInterval,
+			maxWatchersPerConn:       c.cfg.MaxWatchersPerConnection,
+			maxKeepAlivesPerConn:     c.cfg.MaxLeaseKeepAlivesPerConnection,
+			maxWatchBytesPerConn:     c.cfg.MaxWatchBufferedBytesPerConnection,
+			autoDefragInterval:       c.cfg.AutoDefragCheckInterval,
+			autoDefragRatio:          c.cfg.AutoDefragRatio,
+			autoDefragMinBytes:       c.cfg.AutoDefragMinBytes,
 		})
 	m.DiscoveryURL = c.cfg.DiscoveryURL
 	if c.cfg.UseGRPC {
@@ -579,6 +593,12 @@ type memberConfig struct {
 	clientMaxCallRecvMsgSize int
 	useIP                    bool
 	leaseCheckpointInterval  time.Duration
+	maxWatchersPerConn       int64
+	maxKeepAlivesPerConn     int64
+	maxWatchBytesPerConn     int64
+	autoDefragInterval       time.Duration
+	autoDefragRatio          float64
+	autoDefragMinBytes       uint64
 }
 
 // mustNewMember return an inited member with the given name. If peerTLS is
@@ -670,6 +690,12 @@ func mustNewMember(t testing.TB, mcfg memberConfig) *member {
 	m.clientMaxCallRecvMsgSize = mcfg.clientMaxCallRecvMsgSize
 	m.useIP = mcfg.useIP
 	m.LeaseCheckpointInterval = mcfg.leaseCheckpointInterval
+	m.MaxWatchersPerConnection = mcfg.maxWatchersPerConn
+	m.MaxLeaseKeepAlivesPerConnection = mcfg.maxKeepAlivesPerConn
+	m.MaxWatchBufferedBytesPerConnection = mcfg.maxWatchBytesPerConn
+	m.AutoDefragCheckInterval = mcfg.autoDefragInterval
+	m.AutoDefragRatio = mcfg.autoDefragRatio
+	m.AutoDefragMinBytes = mcfg.autoDefragMinBytes
 
 	m.InitialCorruptCheck = true
 
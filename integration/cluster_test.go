@@ -20,6 +20,7 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -27,7 +28,9 @@ import (
 
 	"go.etcd.io/etcd/client"
 	"go.etcd.io/etcd/etcdserver"
+	"go.etcd.io/etcd/pkg/fileutil"
 	"go.etcd.io/etcd/pkg/testutil"
+	"go.etcd.io/etcd/pkg/types"
 )
 
 func init() {
@@ -492,6 +495,80 @@ func TestRestartRemoved(t *testing.T) {
 	}
 }
 
+// TestWitnessKeepsNoBackend ensures a witness never writes a backend file,
+// also when it catches up from a snapshot of the leader after a restart.
+func TestWitnessKeepsNoBackend(t *testing.T) {
+	defer testutil.AfterTest(t)
+	c := NewClusterV3(t, &ClusterConfig{Size: 2, SnapshotCount: 10, SnapshotCatchUpEntries: 5})
+	defer c.Terminate(t)
+
+	m := c.mustNewMember(t)
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	_, err := c.Client(0).MemberAddAsWitness(ctx, m.PeerURLs.StringSlice())
+	cancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.InitialPeerURLsMap = types.URLsMap{}
+	for _, mm := range c.Members {
+		m.InitialPeerURLsMap[mm.Name] = mm.PeerURLs
+	}
+	m.InitialPeerURLsMap[m.Name] = m.PeerURLs
+	m.NewCluster = false
+	if err = m.Launch(); err != nil {
+		t.Fatal(err)
+	}
+	c.Members = append(c.Members, m)
+	c.waitMembersMatch(t, c.HTTPMembers())
+
+	// fall behind the compacted raft log of the leader
+	m.Stop(t)
+	for i := 0; i < 30; i++ {
+		if _, err = c.Client(0).Put(context.TODO(), "foo", "bar"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), requestTimeout)
+	lresp, err := c.Client(0).Status(ctx, c.Client(0).Endpoints()[0])
+	cancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+
+	cli, err := NewClientV3(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	for i := 0; ; i++ {
+		ctx, cancel = context.WithTimeout(context.Background(), requestTimeout)
+		resp, serr := cli.Status(ctx, cli.Endpoints()[0])
+		cancel()
+		if serr == nil && resp.RaftAppliedIndex >= lresp.RaftIndex {
+			break
+		}
+		if i == 50 {
+			t.Fatalf("witness did not catch up (status %+v, error %v)", resp, serr)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	snapDir := filepath.Join(m.DataDir, "member", "snap")
+	if fileutil.Exist(filepath.Join(snapDir, "db")) {
+		t.Error("witness wrote a backend file")
+	}
+	dbs, err := filepath.Glob(filepath.Join(snapDir, "*.snap.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dbs) != 0 {
+		t.Errorf("witness kept backend snapshots %q", dbs)
+	}
+}
+
 // clusterMustProgress ensures that cluster can make progress. It creates
 // a random key first, and check the new key could be got from all client urls
 // of the cluster.
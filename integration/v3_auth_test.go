@@ -17,6 +17,7 @@ package integration
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -393,3 +394,62 @@ func TestV3AuthOldRevConcurrent(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+// TestV3AuthAutoDefrag ensures the leader defragments the other members
+// automatically when auth is enabled, without any user credentials.
+func TestV3AuthAutoDefrag(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{
+		Size:                    3,
+		AutoDefragCheckInterval: 100 * time.Millisecond,
+		AutoDefragRatio:         0.01,
+		AutoDefragMinBytes:      16 * 1024,
+	})
+	defer clus.Terminate(t)
+
+	authSetupRoot(t, toGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := clientv3.New(clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	if cerr != nil {
+		t.Fatal(cerr)
+	}
+	defer rootc.Close()
+
+	metric := func(result string) int {
+		v, err := clus.Members[0].Metric(`etcd_server_auto_defrag_total{result="` + result + `"}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	successes, failures := metric("success"), metric("failure")
+
+	// waste space on every member
+	var rev int64
+	for i := 0; i < 50; i++ {
+		time.Sleep(10 * time.Millisecond) // to execute multiple backend txn
+		resp, err := rootc.Put(context.TODO(), "foo", string(make([]byte, 4096)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rev = resp.Header.Revision
+	}
+	if _, err := rootc.Compact(context.TODO(), rev, clientv3.WithCompactPhysical()); err != nil {
+		t.Fatal(err)
+	}
+	// Put to move PendingPages to FreePages
+	if _, err := rootc.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; metric("success") == successes; i++ {
+		if i == 100 {
+			t.Fatal("no member was defragmented automatically")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if n := metric("failure"); n != failures {
+		t.Fatalf("automatic defragmentation failed %d times, want 0", n-failures)
+	}
+}
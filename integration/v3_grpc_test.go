@@ -1521,6 +1521,144 @@ func TestV3RangeRequest(t *testing.T) {
 	}
 }
 
+// TestV3RangeMaxStaleness checks that followers serve bounded-staleness
+// range requests and that negative bounds are rejected.
+func TestV3RangeMaxStaleness(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lead := clus.WaitLeader(t)
+	follower := (lead + 1) % 3
+	if _, err := toGRPC(clus.Client(lead)).KV.Put(context.TODO(), &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+		t.Fatal(err)
+	}
+
+	kvc := toGRPC(clus.Client(follower)).KV
+	for _, staleness := range []int64{1, 1000} {
+		if _, err := kvc.Range(context.TODO(), &pb.RangeRequest{Key: []byte("foo"), MaxStaleness: staleness}); err != nil {
+			t.Fatalf("max_staleness %d: unexpected error %v", staleness, err)
+		}
+	}
+
+	// a bound of zero is a linearizable read
+	resp, err := kvc.Range(context.TODO(), &pb.RangeRequest{Key: []byte("foo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "bar" {
+		t.Fatalf("kvs = %v, want foo=bar", resp.Kvs)
+	}
+
+	_, err = kvc.Range(context.TODO(), &pb.RangeRequest{Key: []byte("foo"), MaxStaleness: -1})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCBadStaleness) {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCBadStaleness)
+	}
+}
+
+// TestV3RangePageToken checks that paginated ranges return every key once,
+// at the revision of the first page, and fail once that revision is compacted.
+func TestV3RangePageToken(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kvc := toGRPC(clus.RandClient()).KV
+	keys := []string{"a", "b", "c", "d", "e"}
+	for _, k := range keys {
+		if _, err := kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte(k), Value: []byte("v1")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2}
+	var got []string
+	for i := 0; ; i++ {
+		resp, err := kvc.Range(context.TODO(), req)
+		if err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+		for _, kv := range resp.Kvs {
+			if string(kv.Value) != "v1" {
+				t.Fatalf("#%d: %s = %q, want the value at the first page's revision", i, kv.Key, kv.Value)
+			}
+			got = append(got, string(kv.Key))
+		}
+		if resp.More != (len(resp.NextPageToken) != 0) {
+			t.Fatalf("#%d: more = %v, but next page token = %q", i, resp.More, resp.NextPageToken)
+		}
+		if !resp.More {
+			break
+		}
+		if i == 0 {
+			// later writes are not seen by later pages
+			if _, err = kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("e"), Value: []byte("v2")}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("f"), Value: []byte("v2")}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Fatalf("keys = %v, want %v", got, keys)
+	}
+
+	// a sorted range other than by ascending key returns no token
+	resp, err := kvc.Range(context.TODO(), &pb.RangeRequest{
+		Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2,
+		SortTarget: pb.RangeRequest_MOD, SortOrder: pb.RangeRequest_DESCEND,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.More || resp.NextPageToken != nil {
+		t.Fatalf("more = %v, next page token = %q, want more without a token", resp.More, resp.NextPageToken)
+	}
+
+	resp, err = kvc.Range(context.TODO(), &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := resp.NextPageToken
+
+	badReqs := []*pb.RangeRequest{
+		{Key: []byte("a"), RangeEnd: []byte("z"), PageToken: []byte("bad")},
+		// different revision than the token's
+		{Key: []byte("a"), RangeEnd: []byte("z"), PageToken: token, Revision: 1},
+		// token before the start of the range
+		{Key: []byte("d"), RangeEnd: []byte("z"), PageToken: token},
+		{Key: []byte("a"), RangeEnd: []byte("z"), PageToken: token, SortTarget: pb.RangeRequest_VALUE},
+	}
+	for i, r := range badReqs {
+		if _, err = kvc.Range(context.TODO(), r); !eqErrGRPC(err, rpctypes.ErrGRPCInvalidPageToken) {
+			t.Errorf("#%d: err = %v, want %v", i, err, rpctypes.ErrGRPCInvalidPageToken)
+		}
+	}
+	txn := &pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{
+		RequestRange: &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), PageToken: []byte("bad")}}}}}
+	if _, err = kvc.Txn(context.TODO(), txn); !eqErrGRPC(err, rpctypes.ErrGRPCInvalidPageToken) {
+		t.Errorf("txn err = %v, want %v", err, rpctypes.ErrGRPCInvalidPageToken)
+	}
+
+	// compact away the revision of the first page
+	if _, err = kvc.Put(context.TODO(), &pb.PutRequest{Key: []byte("a"), Value: []byte("v2")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = kvc.Compact(context.TODO(), &pb.CompactionRequest{Revision: resp.Header.Revision + 1, Physical: true}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = kvc.Range(context.TODO(), &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("z"), Limit: 2, PageToken: token})
+	if !eqErrGRPC(err, rpctypes.ErrGRPCPageTokenCompacted) {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCPageTokenCompacted)
+	}
+	txn.Success[0].GetRequestRange().PageToken = token
+	if _, err = kvc.Txn(context.TODO(), txn); !eqErrGRPC(err, rpctypes.ErrGRPCPageTokenCompacted) {
+		t.Errorf("txn err = %v, want %v", err, rpctypes.ErrGRPCPageTokenCompacted)
+	}
+}
+
 func newClusterV3NoClients(t *testing.T, cfg *ClusterConfig) *ClusterV3 {
 	cfg.UseGRPC = true
 	clus := &ClusterV3{cluster: NewClusterByConfig(t, cfg)}
@@ -224,6 +224,58 @@ func TestV3LeaseKeepAlive(t *testing.T) {
 	})
 }
 
+// TestV3LeaseKeepAliveLimit ensures the keepalive streams of a connection
+// beyond its limit are rejected until another stream closes.
+func TestV3LeaseKeepAliveLimit(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, MaxLeaseKeepAlivesPerConnection: 1})
+	defer clus.Terminate(t)
+
+	lc := toGRPC(clus.RandClient()).Lease
+	lresp, err := lc.LeaseGrant(context.TODO(), &pb.LeaseGrantRequest{TTL: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lreq := &pb.LeaseKeepAliveRequest{ID: lresp.ID}
+
+	keepAlive := func(ctx context.Context) error {
+		lac, err := lc.LeaseKeepAlive(ctx)
+		if err != nil {
+			return err
+		}
+		if err = lac.Send(lreq); err != nil {
+			return err
+		}
+		_, err = lac.Recv()
+		return err
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	if err = keepAlive(ctx1); err != nil {
+		t.Fatal(err)
+	}
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	if err = keepAlive(ctx2); !eqErrGRPC(err, rpctypes.ErrGRPCTooManyConnLeaseKeepAlives) {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrGRPCTooManyConnLeaseKeepAlives, err)
+	}
+
+	// closing the first stream makes room for another
+	cancel1()
+	for i := 0; i < 10; i++ {
+		ctx3, cancel3 := context.WithCancel(context.Background())
+		defer cancel3()
+		if err = keepAlive(ctx3); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestV3LeaseCheckpoint ensures a lease checkpoint results in a remaining TTL being persisted
 // across leader elections.
 func TestV3LeaseCheckpoint(t *testing.T) {
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"go.etcd.io/etcd/etcdserver/api/v3rpc"
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
 	"go.etcd.io/etcd/mvcc/mvccpb"
 	"go.etcd.io/etcd/pkg/testutil"
@@ -1129,6 +1130,59 @@ func TestV3WatchWithFilter(t *testing.T) {
 	}
 }
 
+// TestV3WatchInvalidFilter ensures a watch with a malformed filter is canceled
+// while the other watches of its stream are still served.
+func TestV3WatchInvalidFilter(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	ws, err := toGRPC(clus.RandClient()).Watch.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, creq := range []*pb.WatchCreateRequest{
+		{Key: []byte("foo"), KeyRegex: "("},
+		{Key: []byte("foo"), KeyGlob: "["},
+	} {
+		if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: creq}}); err != nil {
+			t.Fatal(err)
+		}
+		resp, rerr := ws.Recv()
+		if rerr != nil {
+			t.Fatal(rerr)
+		}
+		if reason := rpctypes.ErrorDesc(rpctypes.ErrGRPCInvalidWatchFilter); !resp.Canceled || resp.CancelReason != reason {
+			t.Fatalf("expected watch canceled with %q, got %+v", reason, resp)
+		}
+	}
+
+	// the stream still serves a valid watch
+	creq := &pb.WatchCreateRequest{Key: []byte("foo")}
+	if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: creq}}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := ws.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Created || resp.Canceled {
+		t.Fatalf("expected created watch, got %+v", resp)
+	}
+	if _, err = toGRPC(clus.RandClient()).KV.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = ws.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Events) != 1 || string(resp.Events[0].Kv.Key) != "foo" {
+		t.Fatalf("expected put of foo, got %+v", resp)
+	}
+}
+
 func TestV3WatchWithPrevKV(t *testing.T) {
 	defer testutil.AfterTest(t)
 	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
@@ -1201,3 +1255,265 @@ func TestV3WatchWithPrevKV(t *testing.T) {
 		}
 	}
 }
+
+// TestV3WatchResumeToken ensures a watch resumed on another member from the
+// resume token of a fragment receives the rest of the events exactly once.
+func TestV3WatchResumeToken(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 3, MaxRequestBytes: 301 * 1024})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	kvc := toGRPC(clus.Client(0)).KV
+	for _, k := range []string{"a/1", "a/2", "a/3"} {
+		if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte(k), Value: bytes.Repeat([]byte("x"), 300*1024)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	watch := func(member int, c *pb.WatchCreateRequest) pb.Watch_WatchClient {
+		ws, err := toGRPC(clus.Client(member)).Watch.Watch(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: c}}); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := ws.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !resp.Created || resp.Canceled || len(resp.ResumeToken) == 0 {
+			t.Fatalf("expected created response with resume token, got %+v", resp)
+		}
+		return ws
+	}
+	// the previous values of the deletions make them larger than a fragment
+	ws := watch(1, &pb.WatchCreateRequest{Key: []byte("a/"), RangeEnd: []byte("a0"), StartRevision: 5, PrevKv: true, Fragment: true})
+	if _, err := kvc.DeleteRange(ctx, &pb.DeleteRangeRequest{Key: []byte("a/"), RangeEnd: []byte("a0")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte("a/4")}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := ws.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Fragment || len(resp.Events) == 0 || len(resp.ResumeToken) == 0 {
+		t.Fatalf("expected fragment with resume token, got %+v", resp)
+	}
+	var got []string
+	for _, ev := range resp.Events {
+		got = append(got, fmt.Sprintf("%s %s", ev.Type, ev.Kv.Key))
+	}
+
+	ws = watch(2, &pb.WatchCreateRequest{Key: []byte("a/"), RangeEnd: []byte("a0"), PrevKv: true, ResumeToken: resp.ResumeToken})
+	for len(got) < 4 {
+		if resp, err = ws.Recv(); err != nil {
+			t.Fatal(err)
+		}
+		for _, ev := range resp.Events {
+			got = append(got, fmt.Sprintf("%s %s", ev.Type, ev.Kv.Key))
+		}
+	}
+	want := []string{"DELETE a/1", "DELETE a/2", "DELETE a/3", "PUT a/4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got events %v, want %v", got, want)
+	}
+
+	ws, err = toGRPC(clus.Client(0)).Watch.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{
+		CreateRequest: &pb.WatchCreateRequest{Key: []byte("a/"), ResumeToken: []byte("bad")}}}
+	if err = ws.Send(req); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = ws.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Canceled || resp.CancelReason != rpctypes.ErrorDesc(rpctypes.ErrGRPCInvalidResumeToken) {
+		t.Fatalf("expected canceled watch on invalid token, got %+v", resp)
+	}
+}
+
+// TestV3WatchInitialState ensures a watch with the initial state receives the
+// key-values in its range at the created revision, then the events after it.
+func TestV3WatchInitialState(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	kvc := toGRPC(clus.RandClient()).KV
+	for _, k := range []string{"a/1", "a/2", "b", "a/1"} {
+		if _, err := kvc.Put(ctx, &pb.PutRequest{Key: []byte(k), Value: []byte(k)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ws, err := toGRPC(clus.RandClient()).Watch.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.WatchCreateRequest{Key: []byte("a/"), RangeEnd: []byte("a0"), InitialState: true, StartRevision: 4}
+	if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: req}}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := ws.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Created || resp.Canceled || resp.Header.Revision != 3 || len(resp.ResumeToken) != 0 {
+		t.Fatalf("expected created response at 3 without resume token, got %+v", resp)
+	}
+
+	if resp, err = ws.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ev := range resp.Events {
+		got = append(got, fmt.Sprintf("%s %s@%d", ev.Type, ev.Kv.Key, ev.Kv.ModRevision))
+	}
+	if w := []string{"PUT a/1@2", "PUT a/2@3"}; !reflect.DeepEqual(got, w) || resp.Header.Revision != 3 || len(resp.ResumeToken) != 0 {
+		t.Fatalf("initial state = %v at %d, want %v at 3 without resume token", got, resp.Header.Revision, w)
+	}
+
+	// the event after the initial state follows it
+	if resp, err = ws.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Events) != 1 || resp.Events[0].Kv.ModRevision != 5 || len(resp.ResumeToken) == 0 {
+		t.Fatalf("expected event at 5 with resume token, got %+v", resp)
+	}
+}
+
+// TestV3WatchLimit ensures the watchers of a connection beyond its limit are
+// canceled on creation until another watcher is canceled.
+func TestV3WatchLimit(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, MaxWatchersPerConnection: 2})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// the streams share the connection of the client
+	wc := toGRPC(clus.RandClient()).Watch
+	ctx1, cancel1 := context.WithCancel(ctx)
+	defer cancel1()
+	ws1, err := wc.Watch(ctx1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws2, err := wc.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	create := func(ws pb.Watch_WatchClient) *pb.WatchResponse {
+		req := &pb.WatchCreateRequest{Key: []byte("foo")}
+		if err := ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: req}}); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := ws.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	if resp := create(ws1); !resp.Created || resp.Canceled {
+		t.Fatalf("expected created watcher, got %+v", resp)
+	}
+	if resp := create(ws2); !resp.Created || resp.Canceled {
+		t.Fatalf("expected created watcher, got %+v", resp)
+	}
+	resp := create(ws2)
+	if reason := rpctypes.ErrorDesc(rpctypes.ErrGRPCTooManyConnWatchers); !resp.Canceled || resp.CancelReason != reason {
+		t.Fatalf("expected watcher canceled with %q, got %+v", reason, resp)
+	}
+
+	// closing a stream releases its watchers
+	cancel1()
+	for i := 0; i < 10; i++ {
+		if resp = create(ws2); !resp.Canceled {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !resp.Created || resp.Canceled {
+		t.Fatalf("expected created watcher, got %+v", resp)
+	}
+}
+
+// TestV3WatchBufferLimit ensures a watch stream whose client does not keep up
+// is closed once the responses buffered for it exceed the byte limit of the
+// connection, and that closing it releases its bytes.
+func TestV3WatchBufferLimit(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1, MaxWatchBufferedBytesPerConnection: 256 * 1024})
+	defer clus.Terminate(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	wc := toGRPC(clus.RandClient()).Watch
+	ws, err := wc.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.WatchCreateRequest{Key: []byte("foo")}
+	if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: req}}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, rerr := ws.Recv(); rerr != nil || !resp.Created {
+		t.Fatalf("expected created watcher, got %+v (%v)", resp, rerr)
+	}
+
+	// the client does not receive while the events outgrow the flow control
+	// windows of the stream, so they stay buffered on the member
+	kvc := toGRPC(clus.Client(0)).KV
+	numPuts, value := 64, bytes.Repeat([]byte("a"), 32*1024)
+	for i := 0; i < numPuts; i++ {
+		if _, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	evs := 0
+	for evs < numPuts {
+		resp, rerr := ws.Recv()
+		if rerr != nil {
+			err = rerr
+			break
+		}
+		evs += len(resp.Events)
+	}
+	if err == nil || !eqErrGRPC(err, rpctypes.ErrGRPCConnWatchBufferFull) {
+		t.Fatalf("err = %v after %d events, want %v", err, evs, rpctypes.ErrGRPCConnWatchBufferFull)
+	}
+
+	// a stream keeping up on the same connection is not limited
+	ws, err = wc.Watch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ws.Send(&pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: req}}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, rerr := ws.Recv(); rerr != nil || !resp.Created {
+		t.Fatalf("expected created watcher, got %+v (%v)", resp, rerr)
+	}
+	if _, err = kvc.Put(ctx, &pb.PutRequest{Key: []byte("foo"), Value: value}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, rerr := ws.Recv(); rerr != nil || len(resp.Events) != 1 {
+		t.Fatalf("expected one event, got %+v (%v)", resp, rerr)
+	}
+}
@@ -15,7 +15,6 @@
 package backend
 
 import (
-	"fmt"
 	"hash/crc32"
 	"io"
 	"io/ioutil"
@@ -27,7 +26,6 @@ import (
 
 	"github.com/coreos/pkg/capnslog"
 	humanize "github.com/dustin/go-humanize"
-	bolt "go.etcd.io/bbolt"
 	"go.uber.org/zap"
 )
 
@@ -50,6 +48,9 @@ var (
 
 type Backend interface {
 	ReadTx() ReadTx
+	// ConcurrentReadTx returns a read tx that neither blocks nor is blocked
+	// by the batch tx committing. It needs no locking; Unlock ends it.
+	ConcurrentReadTx() ReadTx
 	BatchTx() BatchTx
 
 	Snapshot() Snapshot
@@ -64,6 +65,10 @@ type Backend interface {
 	// number of pages, the returned value can be not exactly accurate in bytes.
 	SizeInUse() int64
 	Defrag() error
+	// DefragOnline defragments the backend like Defrag, but only blocks
+	// reads and writes while switching over to the defragmented database.
+	// If progress is not nil, it is called as the defragmentation advances.
+	DefragOnline(progress func(DefragProgress)) error
 	ForceCommit()
 	Close() error
 }
@@ -89,7 +94,16 @@ type backend struct {
 	commits int64
 
 	mu sync.RWMutex
-	db *bolt.DB
+	db engine
+
+	// defragMu serializes defragmentations.
+	defragMu sync.Mutex
+	// defragLog records the writes made while an online defragmentation
+	// copies the database; it is protected by the batchTx lock.
+	defragLog *defragWriteLog
+
+	// engineName is the storage engine the backend was opened with.
+	engineName string
 
 	batchInterval time.Duration
 	batchLimit    int
@@ -106,6 +120,9 @@ type backend struct {
 type BackendConfig struct {
 	// Path is the file path to the backend file.
 	Path string
+	// Engine is the storage engine the backend is kept in,
+	// either EngineBolt or EngineMemory.
+	Engine string
 	// BatchInterval is the maximum time before flushing the BatchTx.
 	BatchInterval time.Duration
 	// BatchLimit is the maximum puts before flushing the BatchTx.
@@ -118,6 +135,7 @@ type BackendConfig struct {
 
 func DefaultBackendConfig() BackendConfig {
 	return BackendConfig{
+		Engine:        EngineBolt,
 		BatchInterval: defaultBatchInterval,
 		BatchLimit:    defaultBatchLimit,
 		MmapSize:      initialMmapSize,
@@ -134,14 +152,17 @@ func NewDefaultBackend(path string) Backend {
 	return newBackend(bcfg)
 }
 
-func newBackend(bcfg BackendConfig) *backend {
-	bopts := &bolt.Options{}
-	if boltOpenOptions != nil {
-		*bopts = *boltOpenOptions
-	}
-	bopts.InitialMmapSize = bcfg.mmapSize()
+// NewVolatileBackend returns a backend kept in memory only, which loses its
+// data once closed. It cannot be defragmented.
+func NewVolatileBackend(lg *zap.Logger) Backend {
+	bcfg := DefaultBackendConfig()
+	bcfg.Engine = EngineMemory
+	bcfg.Logger = lg
+	return newBackend(bcfg)
+}
 
-	db, err := bolt.Open(bcfg.Path, 0600, bopts)
+func newBackend(bcfg BackendConfig) *backend {
+	db, err := openEngine(bcfg.Engine, bcfg.Path, bcfg.mmapSize())
 	if err != nil {
 		if bcfg.Logger != nil {
 			bcfg.Logger.Panic("failed to open database", zap.String("path", bcfg.Path), zap.Error(err))
@@ -153,16 +174,21 @@ func newBackend(bcfg BackendConfig) *backend {
 	// In future, may want to make buffering optional for low-concurrency systems
 	// or dynamically swap between buffered/non-buffered depending on workload.
 	b := &backend{
-		db: db,
+		db:         db,
+		engineName: bcfg.Engine,
 
 		batchInterval: bcfg.BatchInterval,
 		batchLimit:    bcfg.BatchLimit,
 
 		readTx: &readTx{
-			buf: txReadBuffer{
-				txBuffer: txBuffer{make(map[string]*bucketBuffer)},
+			baseReadTx: baseReadTx{
+				buf: txReadBuffer{
+					txBuffer: txBuffer{make(map[string]*bucketBuffer)},
+				},
+				txMu:    new(sync.RWMutex),
+				buckets: make(map[string]engineBucket),
 			},
-			buckets: make(map[string]*bolt.Bucket),
+			txWg: new(sync.WaitGroup),
 		},
 
 		stopc: make(chan struct{}),
@@ -184,6 +210,25 @@ func (b *backend) BatchTx() BatchTx {
 
 func (b *backend) ReadTx() ReadTx { return b.readTx }
 
+// ConcurrentReadTx copies the read buffer, which holds the writes not yet
+// committed, and reads the rest from the current engine read tx. The copy is
+// shared with the other concurrent read txs until the buffer changes.
+func (b *backend) ConcurrentReadTx() ReadTx {
+	b.readTx.mu.RLock()
+	defer b.readTx.mu.RUnlock()
+	// keep the engine tx from being rolled back until the copy is done
+	b.readTx.txWg.Add(1)
+	return &concurrentReadTx{
+		baseReadTx: baseReadTx{
+			buf:     b.readTx.copyBuf(),
+			txMu:    b.readTx.txMu,
+			tx:      b.readTx.tx,
+			buckets: b.readTx.buckets,
+		},
+		txWg: b.readTx.txWg,
+	}
+}
+
 // ForceCommit forces the current batching tx to commit.
 func (b *backend) ForceCommit() {
 	b.batchTx.Commit()
@@ -251,26 +296,22 @@ func (b *backend) Hash(ignores map[IgnoreKey]struct{}) (uint32, error) {
 
 	b.mu.RLock()
 	defer b.mu.RUnlock()
-	err := b.db.View(func(tx *bolt.Tx) error {
-		c := tx.Cursor()
-		for next, _ := c.First(); next != nil; next, _ = c.Next() {
-			b := tx.Bucket(next)
-			if b == nil {
-				return fmt.Errorf("cannot get hash of bucket %s", string(next))
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	err = tx.ForEachBucket(func(next []byte, b engineBucket) error {
+		h.Write(next)
+		return b.ForEach(func(k, v []byte) error {
+			bk := IgnoreKey{Bucket: string(next), Key: string(k)}
+			if _, ok := ignores[bk]; !ok {
+				h.Write(k)
+				h.Write(v)
 			}
-			h.Write(next)
-			b.ForEach(func(k, v []byte) error {
-				bk := IgnoreKey{Bucket: string(next), Key: string(k)}
-				if _, ok := ignores[bk]; !ok {
-					h.Write(k)
-					h.Write(v)
-				}
-				return nil
-			})
-		}
-		return nil
+			return nil
+		})
 	})
-
 	if err != nil {
 		return 0, err
 	}
@@ -316,13 +357,18 @@ func (b *backend) Commits() int64 {
 }
 
 func (b *backend) Defrag() error {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+	// b.db is only replaced by defragmentation, which defragMu serializes
+	if b.db.Path() == "" {
+		return errDefragVolatile
+	}
 	return b.defrag()
 }
 
 func (b *backend) defrag() error {
 	now := time.Now()
 
-	// TODO: make this non-blocking?
 	// lock batchTx to ensure nobody is using previous tx, and then
 	// close previous ongoing tx.
 	b.batchTx.Lock()
@@ -340,7 +386,7 @@ func (b *backend) defrag() error {
 
 	b.batchTx.tx = nil
 
-	tmpdb, err := bolt.Open(b.db.Path()+".tmp", 0600, boltOpenOptions)
+	tmpdb, err := openEngine(b.engineName, b.db.Path()+".tmp", 0)
 	if err != nil {
 		return err
 	}
@@ -348,25 +394,31 @@ func (b *backend) defrag() error {
 	dbp := b.db.Path()
 	tdbp := tmpdb.Path()
 	size1, sizeInUse1 := b.Size(), b.SizeInUse()
-	if b.lg != nil {
-		b.lg.Info(
-			"defragmenting",
-			zap.String("path", dbp),
-			zap.Int64("current-db-size-bytes", size1),
-			zap.String("current-db-size", humanize.Bytes(uint64(size1))),
-			zap.Int64("current-db-size-in-use-bytes", sizeInUse1),
-			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse1))),
-		)
-	}
+	b.logDefragmenting(dbp, size1, sizeInUse1)
 
 	err = defragdb(b.db, tmpdb, defragLimit)
 	if err != nil {
 		tmpdb.Close()
-		os.RemoveAll(tmpdb.Path())
+		os.RemoveAll(tdbp)
 		return err
 	}
 
-	err = b.db.Close()
+	b.unsafeReplaceDB(tmpdb)
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+	b.logDefragmented(dbp, size1, sizeInUse1, took)
+	return nil
+}
+
+// unsafeReplaceDB replaces the database with the defragmented tmpdb, and
+// begins new transactions on it. It must be called holding the batchTx,
+// database and readTx locks, with no transaction open.
+func (b *backend) unsafeReplaceDB(tmpdb engine) {
+	dbp := b.db.Path()
+	tdbp := tmpdb.Path()
+
+	err := b.db.Close()
 	if err != nil {
 		if b.lg != nil {
 			b.lg.Fatal("failed to close database", zap.Error(err))
@@ -391,7 +443,7 @@ func (b *backend) defrag() error {
 		}
 	}
 
-	b.db, err = bolt.Open(dbp, 0600, boltOpenOptions)
+	b.db, err = openEngine(b.engineName, dbp, 0)
 	if err != nil {
 		if b.lg != nil {
 			b.lg.Fatal("failed to open database", zap.String("path", dbp), zap.Error(err))
@@ -404,14 +456,24 @@ func (b *backend) defrag() error {
 	b.readTx.reset()
 	b.readTx.tx = b.unsafeBegin(false)
 
-	size := b.readTx.tx.Size()
-	db := b.readTx.tx.DB()
-	atomic.StoreInt64(&b.size, size)
-	atomic.StoreInt64(&b.sizeInUse, size-(int64(db.Stats().FreePageN)*int64(db.Info().PageSize)))
+	atomic.StoreInt64(&b.size, b.readTx.tx.Size())
+	atomic.StoreInt64(&b.sizeInUse, b.readTx.tx.SizeInUse())
+}
 
-	took := time.Since(now)
-	defragSec.Observe(took.Seconds())
+func (b *backend) logDefragmenting(dbp string, size, sizeInUse int64) {
+	if b.lg != nil {
+		b.lg.Info(
+			"defragmenting",
+			zap.String("path", dbp),
+			zap.Int64("current-db-size-bytes", size),
+			zap.String("current-db-size", humanize.Bytes(uint64(size))),
+			zap.Int64("current-db-size-in-use-bytes", sizeInUse),
+			zap.String("current-db-size-in-use", humanize.Bytes(uint64(sizeInUse))),
+		)
+	}
+}
 
+func (b *backend) logDefragmented(dbp string, size1, sizeInUse1 int64, took time.Duration) {
 	size2, sizeInUse2 := b.Size(), b.SizeInUse()
 	if b.lg != nil {
 		b.lg.Info(
@@ -426,16 +488,9 @@ func (b *backend) defrag() error {
 			zap.Duration("took", took),
 		)
 	}
-	return nil
 }
 
-func defragdb(odb, tmpdb *bolt.DB, limit int) error {
-	// open a tx on tmpdb for writes
-	tmptx, err := tmpdb.Begin(true)
-	if err != nil {
-		return err
-	}
-
+func defragdb(odb, tmpdb engine, limit int) error {
 	// open a tx on old db for read
 	tx, err := odb.Begin(false)
 	if err != nil {
@@ -443,58 +498,77 @@ func defragdb(odb, tmpdb *bolt.DB, limit int) error {
 	}
 	defer tx.Rollback()
 
-	c := tx.Cursor()
+	return copyTx(tx, tmpdb, limit, nil)
+}
 
-	count := 0
-	for next, _ := c.First(); next != nil; next, _ = c.Next() {
-		b := tx.Bucket(next)
-		if b == nil {
-			return fmt.Errorf("backend: cannot defrag bucket %s", string(next))
-		}
+// copyTx copies the data seen by tx into tmpdb, committing every limit keys.
+// If chunkDone is not nil, it is called with the number of keys copied after
+// each commit; an error returned by it aborts the copy.
+func copyTx(tx engineTx, tmpdb engine, limit int, chunkDone func(n int) error) error {
+	// open a tx on tmpdb for writes
+	tmptx, err := tmpdb.Begin(true)
+	if err != nil {
+		return err
+	}
 
+	count := 0
+	err = tx.ForEachBucket(func(next []byte, b engineBucket) error {
 		tmpb, berr := tmptx.CreateBucketIfNotExists(next)
 		if berr != nil {
 			return berr
 		}
-		tmpb.FillPercent = 0.9 // for seq write in for each
+		tmpb.HintSequential() // for seq write in for each
 
-		b.ForEach(func(k, v []byte) error {
+		return b.ForEach(func(k, v []byte) error {
 			count++
 			if count > limit {
 				err = tmptx.Commit()
 				if err != nil {
 					return err
 				}
+				if chunkDone != nil {
+					if err = chunkDone(limit); err != nil {
+						return err
+					}
+				}
 				tmptx, err = tmpdb.Begin(true)
 				if err != nil {
 					return err
 				}
 				tmpb = tmptx.Bucket(next)
-				tmpb.FillPercent = 0.9 // for seq write in for each
+				tmpb.HintSequential() // for seq write in for each
 
-				count = 0
+				count = 1
 			}
 			return tmpb.Put(k, v)
 		})
+	})
+	if err != nil {
+		tmptx.Rollback()
+		return err
 	}
 
-	return tmptx.Commit()
+	if err = tmptx.Commit(); err != nil {
+		return err
+	}
+	if chunkDone != nil {
+		return chunkDone(count)
+	}
+	return nil
 }
 
-func (b *backend) begin(write bool) *bolt.Tx {
+func (b *backend) begin(write bool) engineTx {
 	b.mu.RLock()
 	tx := b.unsafeBegin(write)
 	b.mu.RUnlock()
 
-	size := tx.Size()
-	db := tx.DB()
-	atomic.StoreInt64(&b.size, size)
-	atomic.StoreInt64(&b.sizeInUse, size-(int64(db.Stats().FreePageN)*int64(db.Info().PageSize)))
+	atomic.StoreInt64(&b.size, tx.Size())
+	atomic.StoreInt64(&b.sizeInUse, tx.SizeInUse())
 
 	return tx
 }
 
-func (b *backend) unsafeBegin(write bool) *bolt.Tx {
+func (b *backend) unsafeBegin(write bool) engineTx {
 	tx, err := b.db.Begin(write)
 	if err != nil {
 		if b.lg != nil {
@@ -508,14 +582,18 @@ func (b *backend) unsafeBegin(write bool) *bolt.Tx {
 
 // NewTmpBackend creates a backend implementation for testing.
 func NewTmpBackend(batchInterval time.Duration, batchLimit int) (*backend, string) {
+	bcfg := DefaultBackendConfig()
+	bcfg.BatchInterval, bcfg.BatchLimit = batchInterval, batchLimit
+	return newTmpBackend(bcfg)
+}
+
+func newTmpBackend(bcfg BackendConfig) (*backend, string) {
 	dir, err := ioutil.TempDir(os.TempDir(), "etcd_backend_test")
 	if err != nil {
 		panic(err)
 	}
-	tmpPath := filepath.Join(dir, "database")
-	bcfg := DefaultBackendConfig()
-	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = tmpPath, batchInterval, batchLimit
-	return newBackend(bcfg), tmpPath
+	bcfg.Path = filepath.Join(dir, "database")
+	return newBackend(bcfg), bcfg.Path
 }
 
 func NewDefaultTmpBackend() (*backend, string) {
@@ -523,7 +601,7 @@ func NewDefaultTmpBackend() (*backend, string) {
 }
 
 type snapshot struct {
-	*bolt.Tx
+	engineTx
 	stopc chan struct{}
 	donec chan struct{}
 }
@@ -531,5 +609,5 @@ type snapshot struct {
 func (s *snapshot) Close() error {
 	close(s.stopc)
 	<-s.donec
-	return s.Tx.Rollback()
+	return s.engineTx.Rollback()
 }
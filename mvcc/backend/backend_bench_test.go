@@ -22,7 +22,13 @@ import (
 )
 
 func BenchmarkBackendPut(b *testing.B) {
-	backend, tmppath := NewTmpBackend(100*time.Millisecond, 10000)
+	for _, engine := range testEngines {
+		b.Run(engine, func(b *testing.B) { benchmarkBackendPut(b, engine) })
+	}
+}
+
+func benchmarkBackendPut(b *testing.B, engine string) {
+	backend, tmppath := newTmpEngineBackend(engine, 100*time.Millisecond, 10000)
 	defer backend.Close()
 	defer os.Remove(tmppath)
 
@@ -21,283 +21,445 @@ import (
 	"reflect"
 	"testing"
 	"time"
-
-	bolt "go.etcd.io/bbolt"
 )
 
 func TestBackendClose(t *testing.T) {
-	b, tmpPath := NewTmpBackend(time.Hour, 10000)
-	defer os.Remove(tmpPath)
-
-	// check close could work
-	done := make(chan struct{})
-	go func() {
-		err := b.Close()
-		if err != nil {
-			t.Errorf("close error = %v, want nil", err)
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, time.Hour, 10000)
+		defer os.Remove(tmpPath)
+
+		// check close could work
+		done := make(chan struct{})
+		go func() {
+			err := b.Close()
+			if err != nil {
+				t.Errorf("close error = %v, want nil", err)
+			}
+			done <- struct{}{}
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Errorf("failed to close database in 10s")
 		}
-		done <- struct{}{}
-	}()
-	select {
-	case <-done:
-	case <-time.After(10 * time.Second):
-		t.Errorf("failed to close database in 10s")
-	}
+	})
 }
 
 func TestBackendSnapshot(t *testing.T) {
-	b, tmpPath := NewTmpBackend(time.Hour, 10000)
-	defer cleanup(b, tmpPath)
-
-	tx := b.BatchTx()
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("test"))
-	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
-	tx.Unlock()
-	b.ForceCommit()
-
-	// write snapshot to a new file
-	f, err := ioutil.TempFile(os.TempDir(), "etcd_backend_test")
-	if err != nil {
-		t.Fatal(err)
-	}
-	snap := b.Snapshot()
-	defer snap.Close()
-	if _, err := snap.WriteTo(f); err != nil {
-		t.Fatal(err)
-	}
-	f.Close()
-
-	// bootstrap new backend from the snapshot
-	bcfg := DefaultBackendConfig()
-	bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = f.Name(), time.Hour, 10000
-	nb := New(bcfg)
-	defer cleanup(nb, f.Name())
-
-	newTx := nb.BatchTx()
-	newTx.Lock()
-	ks, _ := newTx.UnsafeRange([]byte("test"), []byte("foo"), []byte("goo"), 0)
-	if len(ks) != 1 {
-		t.Errorf("len(kvs) = %d, want 1", len(ks))
-	}
-	newTx.Unlock()
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, time.Hour, 10000)
+		defer cleanup(b, tmpPath)
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("test"))
+		tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+		tx.Unlock()
+		b.ForceCommit()
+
+		// write snapshot to a new file
+		f, err := ioutil.TempFile(os.TempDir(), "etcd_backend_test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		snap := b.Snapshot()
+		defer snap.Close()
+		if _, err := snap.WriteTo(f); err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+
+		// bootstrap new backend from the snapshot
+		bcfg := DefaultBackendConfig()
+		bcfg.Path, bcfg.BatchInterval, bcfg.BatchLimit = f.Name(), time.Hour, 10000
+		bcfg.Engine = engine
+		nb := New(bcfg)
+		defer cleanup(nb, f.Name())
+
+		newTx := nb.BatchTx()
+		newTx.Lock()
+		ks, _ := newTx.UnsafeRange([]byte("test"), []byte("foo"), []byte("goo"), 0)
+		if len(ks) != 1 {
+			t.Errorf("len(kvs) = %d, want 1", len(ks))
+		}
+		newTx.Unlock()
+	})
 }
 
 func TestBackendBatchIntervalCommit(t *testing.T) {
-	// start backend with super short batch interval so
-	// we do not need to wait long before commit to happen.
-	b, tmpPath := NewTmpBackend(time.Nanosecond, 10000)
-	defer cleanup(b, tmpPath)
-
-	pc := b.Commits()
-
-	tx := b.BatchTx()
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("test"))
-	tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
-	tx.Unlock()
-
-	for i := 0; i < 10; i++ {
-		if b.Commits() >= pc+1 {
-			break
+	forEachEngine(t, func(t *testing.T, engine string) {
+		// start backend with super short batch interval so
+		// we do not need to wait long before commit to happen.
+		b, tmpPath := newTmpEngineBackend(engine, time.Nanosecond, 10000)
+		defer cleanup(b, tmpPath)
+
+		pc := b.Commits()
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("test"))
+		tx.UnsafePut([]byte("test"), []byte("foo"), []byte("bar"))
+		tx.Unlock()
+
+		for i := 0; i < 10; i++ {
+			if b.Commits() >= pc+1 {
+				break
+			}
+			time.Sleep(time.Duration(i*100) * time.Millisecond)
 		}
-		time.Sleep(time.Duration(i*100) * time.Millisecond)
-	}
 
-	// check whether put happens via db view
-	b.db.View(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket([]byte("test"))
-		if bucket == nil {
-			t.Errorf("bucket test does not exit")
-			return nil
-		}
-		v := bucket.Get([]byte("foo"))
-		if v == nil {
+		// check whether put happens via db view
+		if !hasCommittedKey(t, b, []byte("test"), []byte("foo")) {
 			t.Errorf("foo key failed to written in backend")
 		}
-		return nil
 	})
 }
 
 func TestBackendDefrag(t *testing.T) {
-	b, tmpPath := NewDefaultTmpBackend()
-	defer cleanup(b, tmpPath)
-
-	tx := b.BatchTx()
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("test"))
-	for i := 0; i < defragLimit+100; i++ {
-		tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
-	}
-	tx.Unlock()
-	b.ForceCommit()
-
-	// remove some keys to ensure the disk space will be reclaimed after defrag
-	tx = b.BatchTx()
-	tx.Lock()
-	for i := 0; i < 50; i++ {
-		tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)))
-	}
-	tx.Unlock()
-	b.ForceCommit()
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, defaultBatchInterval, defaultBatchLimit)
+		defer cleanup(b, tmpPath)
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("test"))
+		for i := 0; i < defragLimit+100; i++ {
+			tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+		}
+		tx.Unlock()
+		b.ForceCommit()
+
+		// remove some keys to ensure the disk space will be reclaimed after defrag
+		tx = b.BatchTx()
+		tx.Lock()
+		for i := 0; i < 50; i++ {
+			tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)))
+		}
+		tx.Unlock()
+		b.ForceCommit()
 
-	size := b.Size()
+		size := b.Size()
 
-	// shrink and check hash
-	oh, err := b.Hash(nil)
-	if err != nil {
-		t.Fatal(err)
-	}
+		// shrink and check hash
+		oh, err := b.Hash(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	err = b.Defrag()
-	if err != nil {
-		t.Fatal(err)
-	}
+		err = b.Defrag()
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	nh, err := b.Hash(nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if oh != nh {
-		t.Errorf("hash = %v, want %v", nh, oh)
-	}
+		nh, err := b.Hash(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if oh != nh {
+			t.Errorf("hash = %v, want %v", nh, oh)
+		}
 
-	nsize := b.Size()
-	if nsize >= size {
-		t.Errorf("new size = %v, want < %d", nsize, size)
-	}
+		nsize := b.Size()
+		if nsize >= size {
+			t.Errorf("new size = %v, want < %d", nsize, size)
+		}
 
-	// try put more keys after shrink.
-	tx = b.BatchTx()
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("test"))
-	tx.UnsafePut([]byte("test"), []byte("more"), []byte("bar"))
-	tx.Unlock()
-	b.ForceCommit()
+		// try put more keys after shrink.
+		tx = b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("test"))
+		tx.UnsafePut([]byte("test"), []byte("more"), []byte("bar"))
+		tx.Unlock()
+		b.ForceCommit()
+	})
+}
+
+// TestBackendDefragOnline ensures writes made while the database is being
+// copied are not lost by online defragmentation.
+func TestBackendDefragOnline(t *testing.T) {
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, defaultBatchInterval, defaultBatchLimit)
+		defer cleanup(b, tmpPath)
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("test"))
+		for i := 0; i < defragLimit+100; i++ {
+			tx.UnsafePut([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+		}
+		tx.Unlock()
+		b.ForceCommit()
+
+		// remove some keys to ensure the disk space will be reclaimed after defrag
+		tx.Lock()
+		for i := 0; i < 50; i++ {
+			tx.UnsafeDelete([]byte("test"), []byte(fmt.Sprintf("foo_%d", i)))
+		}
+		tx.Unlock()
+		b.ForceCommit()
+
+		size := b.Size()
+
+		var progress []DefragProgress
+		err := b.DefragOnline(func(p DefragProgress) {
+			if len(progress) == 0 {
+				// write while the database is being copied
+				tx.Lock()
+				tx.UnsafeCreateBucket([]byte("more"))
+				tx.UnsafePut([]byte("more"), []byte("foo"), []byte("bar"))
+				tx.UnsafePut([]byte("test"), []byte("foo_0"), []byte("baz"))
+				tx.UnsafeDelete([]byte("test"), []byte("foo_60"))
+				tx.Unlock()
+			}
+			progress = append(progress, p)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wp := DefragProgress{CopiedKeys: int64(defragLimit + 50), ReplayedWrites: 4}
+		if len(progress) < 2 || progress[len(progress)-1] != wp {
+			t.Errorf("progress = %+v, want at least 2 updates ending with %+v", progress, wp)
+		}
+		if nsize := b.Size(); nsize >= size {
+			t.Errorf("new size = %v, want < %d", nsize, size)
+		}
+
+		tx.Lock()
+		defer tx.Unlock()
+		ks, _ := tx.UnsafeRange([]byte("test"), []byte("foo_"), []byte("foo`"), 0)
+		if len(ks) != defragLimit+50 {
+			t.Errorf("len(keys) = %d, want %d", len(ks), defragLimit+50)
+		}
+		tests := []struct {
+			bucket, key string
+			wval        []byte
+		}{
+			{"more", "foo", []byte("bar")},
+			{"test", "foo_0", []byte("baz")},
+			{"test", "foo_60", nil},
+			{"test", "foo_70", []byte("bar")},
+		}
+		for i, tt := range tests {
+			_, vs := tx.UnsafeRange([]byte(tt.bucket), []byte(tt.key), nil, 0)
+			var v []byte
+			if len(vs) == 1 {
+				v = vs[0]
+			}
+			if !reflect.DeepEqual(v, tt.wval) {
+				t.Errorf("#%d: value of %s/%s = %q, want %q", i, tt.bucket, tt.key, v, tt.wval)
+			}
+		}
+	})
 }
 
 // TestBackendWriteback ensures writes are stored to the read txn on write txn unlock.
 func TestBackendWriteback(t *testing.T) {
-	b, tmpPath := NewDefaultTmpBackend()
-	defer cleanup(b, tmpPath)
-
-	tx := b.BatchTx()
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("key"))
-	tx.UnsafePut([]byte("key"), []byte("abc"), []byte("bar"))
-	tx.UnsafePut([]byte("key"), []byte("def"), []byte("baz"))
-	tx.UnsafePut([]byte("key"), []byte("overwrite"), []byte("1"))
-	tx.Unlock()
-
-	// overwrites should be propagated too
-	tx.Lock()
-	tx.UnsafePut([]byte("key"), []byte("overwrite"), []byte("2"))
-	tx.Unlock()
-
-	keys := []struct {
-		key   []byte
-		end   []byte
-		limit int64
-
-		wkey [][]byte
-		wval [][]byte
-	}{
-		{
-			key: []byte("abc"),
-			end: nil,
-
-			wkey: [][]byte{[]byte("abc")},
-			wval: [][]byte{[]byte("bar")},
-		},
-		{
-			key: []byte("abc"),
-			end: []byte("def"),
-
-			wkey: [][]byte{[]byte("abc")},
-			wval: [][]byte{[]byte("bar")},
-		},
-		{
-			key: []byte("abc"),
-			end: []byte("deg"),
-
-			wkey: [][]byte{[]byte("abc"), []byte("def")},
-			wval: [][]byte{[]byte("bar"), []byte("baz")},
-		},
-		{
-			key:   []byte("abc"),
-			end:   []byte("\xff"),
-			limit: 1,
-
-			wkey: [][]byte{[]byte("abc")},
-			wval: [][]byte{[]byte("bar")},
-		},
-		{
-			key: []byte("abc"),
-			end: []byte("\xff"),
-
-			wkey: [][]byte{[]byte("abc"), []byte("def"), []byte("overwrite")},
-			wval: [][]byte{[]byte("bar"), []byte("baz"), []byte("2")},
-		},
-	}
-	rtx := b.ReadTx()
-	for i, tt := range keys {
-		rtx.Lock()
-		k, v := rtx.UnsafeRange([]byte("key"), tt.key, tt.end, tt.limit)
-		rtx.Unlock()
-		if !reflect.DeepEqual(tt.wkey, k) || !reflect.DeepEqual(tt.wval, v) {
-			t.Errorf("#%d: want k=%+v, v=%+v; got k=%+v, v=%+v", i, tt.wkey, tt.wval, k, v)
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, defaultBatchInterval, defaultBatchLimit)
+		defer cleanup(b, tmpPath)
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("key"))
+		tx.UnsafePut([]byte("key"), []byte("abc"), []byte("bar"))
+		tx.UnsafePut([]byte("key"), []byte("def"), []byte("baz"))
+		tx.UnsafePut([]byte("key"), []byte("overwrite"), []byte("1"))
+		tx.Unlock()
+
+		// overwrites should be propagated too
+		tx.Lock()
+		tx.UnsafePut([]byte("key"), []byte("overwrite"), []byte("2"))
+		tx.Unlock()
+
+		keys := []struct {
+			key   []byte
+			end   []byte
+			limit int64
+
+			wkey [][]byte
+			wval [][]byte
+		}{
+			{
+				key: []byte("abc"),
+				end: nil,
+
+				wkey: [][]byte{[]byte("abc")},
+				wval: [][]byte{[]byte("bar")},
+			},
+			{
+				key: []byte("abc"),
+				end: []byte("def"),
+
+				wkey: [][]byte{[]byte("abc")},
+				wval: [][]byte{[]byte("bar")},
+			},
+			{
+				key: []byte("abc"),
+				end: []byte("deg"),
+
+				wkey: [][]byte{[]byte("abc"), []byte("def")},
+				wval: [][]byte{[]byte("bar"), []byte("baz")},
+			},
+			{
+				key:   []byte("abc"),
+				end:   []byte("\xff"),
+				limit: 1,
+
+				wkey: [][]byte{[]byte("abc")},
+				wval: [][]byte{[]byte("bar")},
+			},
+			{
+				key: []byte("abc"),
+				end: []byte("\xff"),
+
+				wkey: [][]byte{[]byte("abc"), []byte("def"), []byte("overwrite")},
+				wval: [][]byte{[]byte("bar"), []byte("baz"), []byte("2")},
+			},
 		}
-	}
+		rtx := b.ReadTx()
+		for i, tt := range keys {
+			rtx.Lock()
+			k, v := rtx.UnsafeRange([]byte("key"), tt.key, tt.end, tt.limit)
+			rtx.Unlock()
+			if !reflect.DeepEqual(tt.wkey, k) || !reflect.DeepEqual(tt.wval, v) {
+				t.Errorf("#%d: want k=%+v, v=%+v; got k=%+v, v=%+v", i, tt.wkey, tt.wval, k, v)
+			}
+		}
+	})
 }
 
 // TestBackendWritebackForEach checks that partially written / buffered
 // data is visited in the same order as fully committed data.
 func TestBackendWritebackForEach(t *testing.T) {
-	b, tmpPath := NewTmpBackend(time.Hour, 10000)
-	defer cleanup(b, tmpPath)
-
-	tx := b.BatchTx()
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("key"))
-	for i := 0; i < 5; i++ {
-		k := []byte(fmt.Sprintf("%04d", i))
-		tx.UnsafePut([]byte("key"), k, []byte("bar"))
-	}
-	tx.Unlock()
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, time.Hour, 10000)
+		defer cleanup(b, tmpPath)
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("key"))
+		for i := 0; i < 5; i++ {
+			k := []byte(fmt.Sprintf("%04d", i))
+			tx.UnsafePut([]byte("key"), k, []byte("bar"))
+		}
+		tx.Unlock()
 
-	// writeback
-	b.ForceCommit()
+		// writeback
+		b.ForceCommit()
 
-	tx.Lock()
-	tx.UnsafeCreateBucket([]byte("key"))
-	for i := 5; i < 20; i++ {
-		k := []byte(fmt.Sprintf("%04d", i))
-		tx.UnsafePut([]byte("key"), k, []byte("bar"))
-	}
-	tx.Unlock()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("key"))
+		for i := 5; i < 20; i++ {
+			k := []byte(fmt.Sprintf("%04d", i))
+			tx.UnsafePut([]byte("key"), k, []byte("bar"))
+		}
+		tx.Unlock()
 
-	seq := ""
-	getSeq := func(k, v []byte) error {
-		seq += string(k)
-		return nil
-	}
-	rtx := b.ReadTx()
-	rtx.Lock()
-	rtx.UnsafeForEach([]byte("key"), getSeq)
-	rtx.Unlock()
+		seq := ""
+		getSeq := func(k, v []byte) error {
+			seq += string(k)
+			return nil
+		}
+		rtx := b.ReadTx()
+		rtx.Lock()
+		rtx.UnsafeForEach([]byte("key"), getSeq)
+		rtx.Unlock()
+
+		partialSeq := seq
 
-	partialSeq := seq
+		seq = ""
+		b.ForceCommit()
 
-	seq = ""
-	b.ForceCommit()
+		tx.Lock()
+		tx.UnsafeForEach([]byte("key"), getSeq)
+		tx.Unlock()
 
-	tx.Lock()
-	tx.UnsafeForEach([]byte("key"), getSeq)
-	tx.Unlock()
+		if seq != partialSeq {
+			t.Fatalf("expected %q, got %q", seq, partialSeq)
+		}
+	})
+}
+
+// TestBackendConcurrentReadTx ensures a concurrent read tx sees the writes
+// made before it, buffered or committed, and does not block later commits.
+func TestBackendConcurrentReadTx(t *testing.T) {
+	forEachEngine(t, func(t *testing.T, engine string) {
+		b, tmpPath := newTmpEngineBackend(engine, time.Hour, 10000)
+		defer cleanup(b, tmpPath)
+
+		tx := b.BatchTx()
+		tx.Lock()
+		tx.UnsafeCreateBucket([]byte("key"))
+		tx.UnsafePut([]byte("key"), []byte("abc"), []byte("ABC"))
+		tx.Unlock()
+		b.ForceCommit()
+
+		// buffered, not committed
+		tx.Lock()
+		tx.UnsafePut([]byte("key"), []byte("def"), []byte("DEF"))
+		tx.Unlock()
+
+		rtx := b.ConcurrentReadTx()
+		rtx.Lock()
+
+		done := make(chan struct{})
+		go func() {
+			tx.Lock()
+			tx.UnsafePut([]byte("key"), []byte("ghi"), []byte("GHI"))
+			tx.Unlock()
+			b.ForceCommit()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("commit blocked by concurrent read tx")
+		}
 
-	if seq != partialSeq {
-		t.Fatalf("expected %q, got %q", seq, partialSeq)
+		keys, vals := rtx.UnsafeRange([]byte("key"), []byte("a"), []byte("z"), 0)
+		rtx.Unlock()
+		wkeys, wvals := [][]byte{[]byte("abc"), []byte("def")}, [][]byte{[]byte("ABC"), []byte("DEF")}
+		if !reflect.DeepEqual(keys, wkeys) || !reflect.DeepEqual(vals, wvals) {
+			t.Fatalf("got %q=%q, want %q=%q", keys, vals, wkeys, wvals)
+		}
+
+		rtx = b.ConcurrentReadTx()
+		rtx.Lock()
+		keys, _ = rtx.UnsafeRange([]byte("key"), []byte("a"), []byte("z"), 0)
+		rtx.Unlock()
+		if len(keys) != 3 {
+			t.Fatalf("got keys %q, want abc, def and ghi", keys)
+		}
+	})
+}
+
+// testEngines are the storage engines the backend tests run against.
+var testEngines = []string{EngineBolt, EngineMemory}
+
+func forEachEngine(t *testing.T, f func(t *testing.T, engine string)) {
+	for _, engine := range testEngines {
+		t.Run(engine, func(t *testing.T) { f(t, engine) })
+	}
+}
+
+func newTmpEngineBackend(engine string, batchInterval time.Duration, batchLimit int) (*backend, string) {
+	bcfg := DefaultBackendConfig()
+	bcfg.Engine, bcfg.BatchInterval, bcfg.BatchLimit = engine, batchInterval, batchLimit
+	return newTmpBackend(bcfg)
+}
+
+// hasCommittedKey checks whether the key has been committed to the engine.
+func hasCommittedKey(t *testing.T, b *backend, bucketName, key []byte) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		t.Fatal(err)
 	}
+	defer tx.Rollback()
+	bucket := tx.Bucket(bucketName)
+	return bucket != nil && bucket.Get(key) != nil
 }
 
 func cleanup(b Backend, path string) {
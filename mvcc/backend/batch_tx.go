@@ -21,7 +21,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	bolt "go.etcd.io/bbolt"
 	"go.uber.org/zap"
 )
 
@@ -39,15 +38,15 @@ type BatchTx interface {
 
 type batchTx struct {
 	sync.Mutex
-	tx      *bolt.Tx
+	tx      engineTx
 	backend *backend
 
 	pending int
 }
 
 func (t *batchTx) UnsafeCreateBucket(name []byte) {
-	_, err := t.tx.CreateBucket(name)
-	if err != nil && err != bolt.ErrBucketExists {
+	_, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
 		if t.backend.lg != nil {
 			t.backend.lg.Fatal(
 				"failed to create a bucket",
@@ -58,6 +57,9 @@ func (t *batchTx) UnsafeCreateBucket(name []byte) {
 			plog.Fatalf("cannot create bucket %s (%v)", name, err)
 		}
 	}
+	if t.backend.defragLog != nil {
+		t.backend.defragLog.append(defragWriteCreateBucket, name, nil, nil)
+	}
 	t.pending++
 }
 
@@ -84,9 +86,9 @@ func (t *batchTx) unsafePut(bucketName []byte, key []byte, value []byte, seq boo
 		}
 	}
 	if seq {
-		// it is useful to increase fill percent when the workloads are mostly append-only.
-		// this can delay the page split and reduce space usage.
-		bucket.FillPercent = 0.9
+		// it is useful to let the engine know when the workloads are mostly append-only.
+		// e.g. bbolt can delay the page split and reduce space usage.
+		bucket.HintSequential()
 	}
 	if err := bucket.Put(key, value); err != nil {
 		if t.backend.lg != nil {
@@ -99,6 +101,9 @@ func (t *batchTx) unsafePut(bucketName []byte, key []byte, value []byte, seq boo
 			plog.Fatalf("cannot put key into bucket (%v)", err)
 		}
 	}
+	if t.backend.defragLog != nil {
+		t.backend.defragLog.append(defragWritePut, bucketName, key, value)
+	}
 	t.pending++
 }
 
@@ -118,7 +123,7 @@ func (t *batchTx) UnsafeRange(bucketName, key, endKey []byte, limit int64) ([][]
 	return unsafeRange(bucket.Cursor(), key, endKey, limit)
 }
 
-func unsafeRange(c *bolt.Cursor, key, endKey []byte, limit int64) (keys [][]byte, vs [][]byte) {
+func unsafeRange(c engineCursor, key, endKey []byte, limit int64) (keys [][]byte, vs [][]byte) {
 	if limit <= 0 {
 		limit = math.MaxInt64
 	}
@@ -165,6 +170,9 @@ func (t *batchTx) UnsafeDelete(bucketName []byte, key []byte) {
 			plog.Fatalf("cannot delete key from bucket (%v)", err)
 		}
 	}
+	if t.backend.defragLog != nil {
+		t.backend.defragLog.append(defragWriteDelete, bucketName, key, nil)
+	}
 	t.pending++
 }
 
@@ -173,7 +181,7 @@ func (t *batchTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) err
 	return unsafeForEach(t.tx, bucketName, visitor)
 }
 
-func unsafeForEach(tx *bolt.Tx, bucket []byte, visitor func(k, v []byte) error) error {
+func unsafeForEach(tx engineTx, bucket []byte, visitor func(k, v []byte) error) error {
 	if b := tx.Bucket(bucket); b != nil {
 		return b.ForEach(visitor)
 	}
@@ -216,13 +224,8 @@ func (t *batchTx) commit(stop bool) {
 
 		start := time.Now()
 
-		// gofail: var beforeCommit struct{}
 		err := t.tx.Commit()
-		// gofail: var afterCommit struct{}
 
-		rebalanceSec.Observe(t.tx.Stats().RebalanceTime.Seconds())
-		spillSec.Observe(t.tx.Stats().SpillTime.Seconds())
-		writeSec.Observe(t.tx.Stats().WriteTime.Seconds())
 		commitSec.Observe(time.Since(start).Seconds())
 		atomic.AddInt64(&t.backend.commits, 1)
 
@@ -261,6 +264,7 @@ func (t *batchTxBuffered) Unlock() {
 	if t.pending != 0 {
 		t.backend.readTx.mu.Lock()
 		t.buf.writeback(&t.backend.readTx.buf)
+		t.backend.readTx.bufCopy = nil
 		t.backend.readTx.mu.Unlock()
 		if t.pending >= t.backend.batchLimit {
 			t.commit(false)
@@ -290,13 +294,18 @@ func (t *batchTxBuffered) commit(stop bool) {
 
 func (t *batchTxBuffered) unsafeCommit(stop bool) {
 	if t.backend.readTx.tx != nil {
-		if err := t.backend.readTx.tx.Rollback(); err != nil {
-			if t.backend.lg != nil {
-				t.backend.lg.Fatal("failed to rollback tx", zap.Error(err))
-			} else {
-				plog.Fatalf("cannot rollback tx (%s)", err)
+		// concurrent read txs may still read from the tx; roll it back
+		// once they are done, without holding up the commit
+		go func(tx engineTx, wg *sync.WaitGroup) {
+			wg.Wait()
+			if err := tx.Rollback(); err != nil {
+				if t.backend.lg != nil {
+					t.backend.lg.Fatal("failed to rollback tx", zap.Error(err))
+				} else {
+					plog.Fatalf("cannot rollback tx (%s)", err)
+				}
 			}
-		}
+		}(t.backend.readTx.tx, t.backend.readTx.txWg)
 		t.backend.readTx.reset()
 	}
 
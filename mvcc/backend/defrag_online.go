@@ -0,0 +1,266 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errDefragAborted  = errors.New("backend: defragmentation aborted since backend is closed")
+	errDefragVolatile = errors.New("backend: cannot defragment a volatile backend")
+)
+
+// DefragProgress reports how far an online defragmentation has got.
+type DefragProgress struct {
+	// CopiedKeys is the number of keys copied to the new database so far.
+	CopiedKeys int64
+	// ReplayedWrites is the number of writes made during the copy that
+	// have been replayed onto the new database so far.
+	ReplayedWrites int64
+}
+
+const (
+	defragWriteCreateBucket = iota
+	defragWritePut
+	defragWriteDelete
+)
+
+type defragWrite struct {
+	typ    int
+	bucket []byte
+	key    []byte
+	value  []byte
+}
+
+// defragWriteLog records the writes made to the backend while an online
+// defragmentation copies it, so that they can be replayed onto the copy.
+type defragWriteLog struct {
+	mu     sync.Mutex
+	writes []defragWrite
+}
+
+func (l *defragWriteLog) append(typ int, bucket, key, value []byte) {
+	// the caller may reuse the slices once the write returns
+	w := defragWrite{typ: typ, bucket: copyBytes(bucket)}
+	if key != nil {
+		w.key = copyBytes(key)
+	}
+	if value != nil {
+		w.value = copyBytes(value)
+	}
+	l.mu.Lock()
+	l.writes = append(l.writes, w)
+	l.mu.Unlock()
+}
+
+// take returns the writes recorded since the last call.
+func (l *defragWriteLog) take() []defragWrite {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ws := l.writes
+	l.writes = nil
+	return ws
+}
+
+// DefragOnline copies the database into a new one from a read transaction,
+// while recording the writes made meanwhile. It then replays those writes
+// onto the copy until few enough are left to replay them with reads and
+// writes blocked, before switching over to the copy.
+func (b *backend) DefragOnline(progress func(DefragProgress)) error {
+	b.defragMu.Lock()
+	defer b.defragMu.Unlock()
+
+	// b.db is only replaced by defragmentation, which defragMu serializes
+	if b.db.Path() == "" {
+		return errDefragVolatile
+	}
+
+	now := time.Now()
+	defragInProgress.Set(1)
+	defer defragInProgress.Set(0)
+
+	// start recording writes and take the view to copy at the same point
+	wlog := &defragWriteLog{}
+	b.batchTx.Lock()
+	b.batchTx.commit(false)
+	b.mu.RLock()
+	dbp := b.db.Path()
+	tx, err := b.db.Begin(false)
+	b.mu.RUnlock()
+	if err == nil {
+		b.defragLog = wlog
+	}
+	b.batchTx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	size1, sizeInUse1 := b.Size(), b.SizeInUse()
+	b.logDefragmenting(dbp, size1, sizeInUse1)
+
+	var p DefragProgress
+	report := func() {
+		if progress != nil {
+			progress(p)
+		}
+	}
+
+	tdbp := dbp + ".tmp"
+	tmpdb, err := openEngine(b.engineName, tdbp, 0)
+	if err != nil {
+		tx.Rollback()
+		b.stopDefragLog()
+		return err
+	}
+
+	err = copyTx(tx, tmpdb, defragLimit, func(n int) error {
+		p.CopiedKeys += int64(n)
+		defragCopiedKeys.Add(float64(n))
+		report()
+		return b.checkNotClosed()
+	})
+	tx.Rollback()
+
+	// catch up with the writes made during the copy
+	for err == nil {
+		ws := wlog.take()
+		if err = replayDefragWrites(tmpdb, ws); err != nil {
+			break
+		}
+		p.ReplayedWrites += int64(len(ws))
+		defragReplayedWrites.Add(float64(len(ws)))
+		report()
+		if len(ws) < defragLimit {
+			break
+		}
+		err = b.checkNotClosed()
+	}
+	if err != nil {
+		b.stopDefragLog()
+		tmpdb.Close()
+		os.RemoveAll(tdbp)
+		return err
+	}
+
+	pause := time.Now()
+	b.batchTx.Lock()
+	b.mu.Lock()
+	b.readTx.mu.Lock()
+
+	ws := wlog.take()
+	if err = b.checkNotClosed(); err == nil {
+		b.batchTx.unsafeCommit(true)
+		b.batchTx.tx = nil
+		if err = replayDefragWrites(tmpdb, ws); err == nil {
+			b.unsafeReplaceDB(tmpdb)
+		} else {
+			// keep using the current database
+			b.batchTx.tx = b.unsafeBegin(true)
+			b.readTx.tx = b.unsafeBegin(false)
+		}
+	}
+	b.defragLog = nil
+
+	b.readTx.mu.Unlock()
+	b.mu.Unlock()
+	b.batchTx.Unlock()
+	pauseTook := time.Since(pause)
+	defragPauseSec.Observe(pauseTook.Seconds())
+
+	if err != nil {
+		tmpdb.Close()
+		os.RemoveAll(tdbp)
+		return err
+	}
+	p.ReplayedWrites += int64(len(ws))
+	defragReplayedWrites.Add(float64(len(ws)))
+	report()
+
+	took := time.Since(now)
+	defragSec.Observe(took.Seconds())
+	b.logDefragmented(dbp, size1, sizeInUse1, took)
+	if b.lg != nil {
+		b.lg.Info(
+			"online defragmentation blocked requests",
+			zap.Duration("took", pauseTook),
+			zap.Int64("copied-keys", p.CopiedKeys),
+			zap.Int64("replayed-writes", p.ReplayedWrites),
+		)
+	}
+	return nil
+}
+
+func (b *backend) stopDefragLog() {
+	b.batchTx.Lock()
+	b.defragLog = nil
+	b.batchTx.Unlock()
+}
+
+func (b *backend) checkNotClosed() error {
+	select {
+	case <-b.stopc:
+		return errDefragAborted
+	default:
+		return nil
+	}
+}
+
+// replayDefragWrites applies the given writes to db, committing every
+// defragLimit writes.
+func replayDefragWrites(db engine, ws []defragWrite) error {
+	for len(ws) > 0 {
+		n := len(ws)
+		if n > defragLimit {
+			n = defragLimit
+		}
+		tx, err := db.Begin(true)
+		if err != nil {
+			return err
+		}
+		for _, w := range ws[:n] {
+			if err = replayDefragWrite(tx, w); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+		if err = tx.Commit(); err != nil {
+			return err
+		}
+		ws = ws[n:]
+	}
+	return nil
+}
+
+func replayDefragWrite(tx engineTx, w defragWrite) error {
+	if w.typ == defragWriteCreateBucket {
+		_, err := tx.CreateBucketIfNotExists(w.bucket)
+		return err
+	}
+	bucket := tx.Bucket(w.bucket)
+	if bucket == nil {
+		return fmt.Errorf("backend: cannot replay write to missing bucket %s", w.bucket)
+	}
+	if w.typ == defragWriteDelete {
+		return bucket.Delete(w.key)
+	}
+	return bucket.Put(w.key, w.value)
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// EngineBolt stores the backend in a bbolt B+tree file. It is the default.
+	EngineBolt = "bbolt"
+	// EngineMemory keeps the whole backend in memory and persists every
+	// commit to an append-only log, which is rewritten on defragmentation.
+	EngineMemory = "memory"
+)
+
+// IsValidEngine returns true if name is a supported storage engine.
+func IsValidEngine(name string) bool {
+	switch name {
+	case "", EngineBolt, EngineMemory:
+		return true
+	}
+	return false
+}
+
+// DetectEngine returns the storage engine that wrote the database at path.
+// Files not written by another engine are taken as bbolt databases.
+func DetectEngine(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	magic := make([]byte, len(memoryMagic))
+	if _, err = io.ReadFull(f, magic); err == nil && bytes.Equal(magic, memoryMagic) {
+		return EngineMemory, nil
+	}
+	return EngineBolt, nil
+}
+
+// engine is the storage engine a backend keeps its buckets in.
+type engine interface {
+	// Path returns the path of the file the engine stores its data in.
+	Path() string
+	// Begin starts a read-only transaction or, if writable is set, a
+	// read-write one. At most one read-write transaction is open at a time.
+	Begin(writable bool) (engineTx, error)
+	// Close releases the engine once all open transactions have finished.
+	Close() error
+}
+
+type engineTx interface {
+	// Bucket returns the bucket with the given name, or nil if it does not exist.
+	Bucket(name []byte) engineBucket
+	// CreateBucketIfNotExists returns the bucket with the given name,
+	// creating it first if needed.
+	CreateBucketIfNotExists(name []byte) (engineBucket, error)
+	// ForEachBucket calls fn for every bucket, in ascending order of names.
+	ForEachBucket(fn func(name []byte, b engineBucket) error) error
+
+	// Size returns the size of the database seen by the transaction,
+	// which is also the number of bytes written by WriteTo.
+	Size() int64
+	// SizeInUse returns the part of Size holding live data.
+	SizeInUse() int64
+	// WriteTo writes the database seen by the transaction to w, in a form
+	// the same engine can open.
+	WriteTo(w io.Writer) (int64, error)
+
+	Commit() error
+	Rollback() error
+}
+
+type engineBucket interface {
+	Get(key []byte) []byte
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	// ForEach calls fn for every key in the bucket, in ascending order.
+	ForEach(fn func(k, v []byte) error) error
+	Cursor() engineCursor
+	// HintSequential tells the engine that keys are mostly appended to
+	// the bucket in ascending order.
+	HintSequential()
+}
+
+type engineCursor interface {
+	// Seek moves the cursor to the first key not less than seek.
+	Seek(seek []byte) (key, value []byte)
+	// Next moves the cursor to the next key, returning a nil key at the end.
+	Next() (key, value []byte)
+}
+
+// openEngine opens the database at path with the named storage engine.
+func openEngine(name, path string, mmapSize int) (engine, error) {
+	switch name {
+	case "", EngineBolt:
+		return openBoltEngine(path, mmapSize)
+	case EngineMemory:
+		return openMemoryEngine(path)
+	}
+	return nil, fmt.Errorf("backend: unknown storage engine %q", name)
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"io"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// boltEngine stores the backend in a bbolt database.
+type boltEngine struct {
+	db *bolt.DB
+}
+
+func openBoltEngine(path string, mmapSize int) (*boltEngine, error) {
+	bopts := &bolt.Options{}
+	if boltOpenOptions != nil {
+		*bopts = *boltOpenOptions
+	}
+	bopts.InitialMmapSize = mmapSize
+
+	db, err := bolt.Open(path, 0600, bopts)
+	if err != nil {
+		return nil, err
+	}
+	return &boltEngine{db: db}, nil
+}
+
+func (e *boltEngine) Path() string { return e.db.Path() }
+
+func (e *boltEngine) Begin(writable bool) (engineTx, error) {
+	tx, err := e.db.Begin(writable)
+	if err != nil {
+		return nil, err
+	}
+	return &boltTx{tx}, nil
+}
+
+func (e *boltEngine) Close() error { return e.db.Close() }
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) Bucket(name []byte) engineBucket {
+	b := t.tx.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	return &boltBucket{b}
+}
+
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (engineBucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{b}, nil
+}
+
+func (t *boltTx) ForEachBucket(fn func(name []byte, b engineBucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, &boltBucket{b})
+	})
+}
+
+func (t *boltTx) Size() int64 { return t.tx.Size() }
+
+func (t *boltTx) SizeInUse() int64 {
+	db := t.tx.DB()
+	return t.tx.Size() - int64(db.Stats().FreePageN)*int64(db.Info().PageSize)
+}
+
+func (t *boltTx) WriteTo(w io.Writer) (int64, error) { return t.tx.WriteTo(w) }
+
+func (t *boltTx) Commit() error {
+	// gofail: var beforeCommit struct{}
+	err := t.tx.Commit()
+	// gofail: var afterCommit struct{}
+
+	rebalanceSec.Observe(t.tx.Stats().RebalanceTime.Seconds())
+	spillSec.Observe(t.tx.Stats().SpillTime.Seconds())
+	writeSec.Observe(t.tx.Stats().WriteTime.Seconds())
+	return err
+}
+
+func (t *boltTx) Rollback() error { return t.tx.Rollback() }
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+func (b *boltBucket) Get(key []byte) []byte                    { return b.b.Get(key) }
+func (b *boltBucket) Put(key, value []byte) error              { return b.b.Put(key, value) }
+func (b *boltBucket) Delete(key []byte) error                  { return b.b.Delete(key) }
+func (b *boltBucket) ForEach(fn func(k, v []byte) error) error { return b.b.ForEach(fn) }
+func (b *boltBucket) Cursor() engineCursor                     { return b.b.Cursor() }
+
+// HintSequential increases the fill percent of the bucket, which delays page
+// splits and reduces space usage for mostly append-only workloads.
+func (b *boltBucket) HintSequential() { b.b.FillPercent = 0.9 }
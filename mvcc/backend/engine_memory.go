@@ -0,0 +1,536 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"go.etcd.io/etcd/pkg/fileutil"
+
+	"github.com/google/btree"
+)
+
+// The memory engine keeps every bucket in a copy-on-write B-tree, so that
+// transactions see a consistent view of the data without blocking each
+// other, and persists each commit as a record appended to its file:
+//
+//	file   = magic record*
+//	record = length(uint64) op* crc32c(uint32)
+//	op     = type(byte) bucket key value
+//
+// where bucket, key and value are uvarint length-prefixed byte strings. A
+// record cut short by a crash is discarded when the file is opened.
+//
+// A volatile memory engine has no file and loses its data once closed.
+
+const (
+	memoryOpCreateBucket byte = iota
+	memoryOpPut
+	memoryOpDelete
+)
+
+var (
+	memoryMagic = []byte("etcdmem\x01")
+
+	memoryBTreeDegree = 32
+
+	errMemoryTxClosed      = errors.New("backend: memory engine tx closed")
+	errMemoryTxNotWritable = errors.New("backend: memory engine tx not writable")
+)
+
+// memoryRecordOverhead is the size of the length and checksum of a record.
+const memoryRecordOverhead = 8 + 4
+
+type memoryEngine struct {
+	// f is nil if the engine is volatile.
+	f *fileutil.LockedFile
+
+	// closemu is held for reading by open transactions, so that Close
+	// waits for them to finish.
+	closemu sync.RWMutex
+	// wmu serializes writable transactions.
+	wmu sync.Mutex
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// buckets is the committed data; its trees are never modified in place.
+	buckets map[string]*btree.BTree
+	// size is the length of the file, or, if the engine is volatile, the
+	// length of a file holding just the committed data.
+	size int64
+	// liveSize is the size of the ops needed to recreate the committed data.
+	liveSize int64
+}
+
+// openMemoryEngine opens the memory engine database at path, or a volatile
+// one if path is empty.
+func openMemoryEngine(path string) (*memoryEngine, error) {
+	if path == "" {
+		return &memoryEngine{
+			buckets: make(map[string]*btree.BTree),
+			size:    int64(len(memoryMagic)) + memoryRecordOverhead,
+		}, nil
+	}
+	f, err := fileutil.LockFile(path, os.O_RDWR|os.O_CREATE, fileutil.PrivateFileMode)
+	if err != nil {
+		return nil, err
+	}
+	e := &memoryEngine{f: f, buckets: make(map[string]*btree.BTree)}
+	if err = e.load(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// load replays the records in the file, truncating a torn record at its end:
+// a partially written one, or a full-length one whose checksum does not match.
+func (e *memoryEngine) load() error {
+	fi, err := e.f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() == 0 {
+		if _, err = e.f.Write(memoryMagic); err != nil {
+			return err
+		}
+		e.size = int64(len(memoryMagic))
+		return fileutil.Fsync(e.f.File)
+	}
+
+	r := bufio.NewReader(io.NewSectionReader(e.f, 0, fi.Size()))
+	magic := make([]byte, len(memoryMagic))
+	if _, err = io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, memoryMagic) {
+		return fmt.Errorf("backend: %s is not a %s engine database", e.f.Name(), EngineMemory)
+	}
+	off := int64(len(memoryMagic))
+	for {
+		var hdr [8]byte
+		if _, err = io.ReadFull(r, hdr[:]); err != nil {
+			break
+		}
+		n := binary.LittleEndian.Uint64(hdr[:])
+		if n > uint64(fi.Size()-off) {
+			break
+		}
+		rec := make([]byte, n+4)
+		if _, err = io.ReadFull(r, rec); err != nil {
+			break
+		}
+		ops := rec[:n]
+		if crc32.Checksum(ops, crcTable) != binary.LittleEndian.Uint32(rec[n:]) {
+			if off+memoryRecordOverhead+int64(n) == fi.Size() {
+				// a crash may leave the last record at its full length
+				// but with garbage contents; it is torn as well
+				break
+			}
+			return fmt.Errorf("backend: %s is corrupted at offset %d", e.f.Name(), off)
+		}
+		if err = e.replay(ops); err != nil {
+			return err
+		}
+		off += memoryRecordOverhead + int64(n)
+	}
+	if err != io.EOF || off != fi.Size() {
+		// discard the torn record written by an interrupted commit
+		if err = e.f.Truncate(off); err != nil {
+			return err
+		}
+		if err = fileutil.Fsync(e.f.File); err != nil {
+			return err
+		}
+	}
+	e.size = off
+	_, err = e.f.Seek(off, io.SeekStart)
+	return err
+}
+
+func (e *memoryEngine) replay(ops []byte) error {
+	for len(ops) > 0 {
+		typ, bucket, key, value, rest, ok := decodeMemoryOp(ops)
+		if !ok {
+			return fmt.Errorf("backend: %s has a malformed record", e.f.Name())
+		}
+		ops = rest
+
+		t := e.buckets[string(bucket)]
+		if t == nil && typ != memoryOpCreateBucket {
+			return fmt.Errorf("backend: %s refers to missing bucket %q", e.f.Name(), bucket)
+		}
+		switch typ {
+		case memoryOpCreateBucket:
+			if t == nil {
+				e.buckets[string(bucket)] = btree.New(memoryBTreeDegree)
+				e.liveSize += memoryOpSize(bucket, nil, nil)
+			}
+		case memoryOpPut:
+			it := &memoryItem{key: copyBytes(key), value: copyBytes(value)}
+			if old := t.ReplaceOrInsert(it); old != nil {
+				e.liveSize -= memoryOpSize(bucket, key, old.(*memoryItem).value)
+			}
+			e.liveSize += memoryOpSize(bucket, key, value)
+		case memoryOpDelete:
+			if old := t.Delete(&memoryItem{key: key}); old != nil {
+				e.liveSize -= memoryOpSize(bucket, key, old.(*memoryItem).value)
+			}
+		default:
+			return fmt.Errorf("backend: %s has an unknown op %d", e.f.Name(), typ)
+		}
+	}
+	return nil
+}
+
+func (e *memoryEngine) Path() string {
+	if e.f == nil {
+		return ""
+	}
+	return e.f.Name()
+}
+
+func (e *memoryEngine) Begin(writable bool) (engineTx, error) {
+	e.closemu.RLock()
+	if writable {
+		e.wmu.Lock()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	tx := &memoryTx{
+		e:        e,
+		writable: writable,
+		buckets:  make(map[string]*btree.BTree, len(e.buckets)),
+		size:     e.size,
+		liveSize: e.liveSize,
+	}
+	for name, t := range e.buckets {
+		tx.buckets[name] = t.Clone()
+	}
+	return tx, nil
+}
+
+func (e *memoryEngine) Close() error {
+	e.closemu.Lock()
+	defer e.closemu.Unlock()
+	if e.f == nil {
+		return nil
+	}
+	return e.f.Close()
+}
+
+// commit appends the given ops to the file and makes the data of tx the
+// committed one.
+func (e *memoryEngine) commit(tx *memoryTx, ops []byte) error {
+	if e.f == nil {
+		tx.size = tx.SizeInUse()
+	} else if len(ops) > 0 {
+		rec := encodeMemoryRecord(ops)
+		if _, err := e.f.Write(rec); err != nil {
+			return err
+		}
+		if err := fileutil.Fdatasync(e.f.File); err != nil {
+			return err
+		}
+		tx.size += int64(len(rec))
+	}
+
+	e.mu.Lock()
+	e.buckets, e.size, e.liveSize = tx.buckets, tx.size, tx.liveSize
+	e.mu.Unlock()
+	return nil
+}
+
+type memoryTx struct {
+	e        *memoryEngine
+	writable bool
+	closed   bool
+
+	buckets  map[string]*btree.BTree
+	size     int64
+	liveSize int64
+
+	// ops are the changes made by a writable transaction.
+	ops []byte
+}
+
+func (t *memoryTx) Bucket(name []byte) engineBucket {
+	tr := t.buckets[string(name)]
+	if tr == nil {
+		return nil
+	}
+	return &memoryBucket{tx: t, name: copyBytes(name), t: tr}
+}
+
+func (t *memoryTx) CreateBucketIfNotExists(name []byte) (engineBucket, error) {
+	if b := t.Bucket(name); b != nil {
+		return b, nil
+	}
+	if !t.writable {
+		return nil, errMemoryTxNotWritable
+	}
+	tr := btree.New(memoryBTreeDegree)
+	t.buckets[string(name)] = tr
+	t.log(memoryOpCreateBucket, name, nil, nil)
+	t.liveSize += memoryOpSize(name, nil, nil)
+	return &memoryBucket{tx: t, name: copyBytes(name), t: tr}, nil
+}
+
+func (t *memoryTx) ForEachBucket(fn func(name []byte, b engineBucket) error) error {
+	names := make([]string, 0, len(t.buckets))
+	for name := range t.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fn([]byte(name), t.Bucket([]byte(name))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *memoryTx) Size() int64 { return t.size }
+
+func (t *memoryTx) SizeInUse() int64 {
+	return int64(len(memoryMagic)) + memoryRecordOverhead + t.liveSize
+}
+
+// WriteTo writes the part of the file holding the data seen by the
+// transaction; the file is only ever appended to while the engine is open.
+// A volatile engine writes the data as a file with a single record instead.
+func (t *memoryTx) WriteTo(w io.Writer) (int64, error) {
+	if t.closed {
+		return 0, errMemoryTxClosed
+	}
+	if t.e.f != nil {
+		return io.Copy(w, io.NewSectionReader(t.e.f, 0, t.size))
+	}
+
+	var ops []byte
+	t.ForEachBucket(func(name []byte, b engineBucket) error {
+		ops = appendMemoryOp(ops, memoryOpCreateBucket, name, nil, nil)
+		return b.ForEach(func(k, v []byte) error {
+			ops = appendMemoryOp(ops, memoryOpPut, name, k, v)
+			return nil
+		})
+	})
+	n, err := w.Write(append(copyBytes(memoryMagic), encodeMemoryRecord(ops)...))
+	return int64(n), err
+}
+
+func (t *memoryTx) Commit() error {
+	if t.closed {
+		return errMemoryTxClosed
+	}
+	if !t.writable {
+		return errMemoryTxNotWritable
+	}
+	err := t.e.commit(t, t.ops)
+	t.close()
+	return err
+}
+
+func (t *memoryTx) Rollback() error {
+	if t.closed {
+		return errMemoryTxClosed
+	}
+	t.close()
+	return nil
+}
+
+func (t *memoryTx) close() {
+	t.closed = true
+	if t.writable {
+		t.e.wmu.Unlock()
+	}
+	t.e.closemu.RUnlock()
+}
+
+func (t *memoryTx) log(typ byte, bucket, key, value []byte) {
+	t.ops = appendMemoryOp(t.ops, typ, bucket, key, value)
+}
+
+type memoryBucket struct {
+	tx   *memoryTx
+	name []byte
+	t    *btree.BTree
+}
+
+func (b *memoryBucket) Get(key []byte) []byte {
+	if it := b.t.Get(&memoryItem{key: key}); it != nil {
+		return it.(*memoryItem).value
+	}
+	return nil
+}
+
+func (b *memoryBucket) Put(key, value []byte) error {
+	if !b.tx.writable {
+		return errMemoryTxNotWritable
+	}
+	it := &memoryItem{key: copyBytes(key), value: copyBytes(value)}
+	if old := b.t.ReplaceOrInsert(it); old != nil {
+		b.tx.liveSize -= memoryOpSize(b.name, key, old.(*memoryItem).value)
+	}
+	b.tx.liveSize += memoryOpSize(b.name, key, value)
+	b.tx.log(memoryOpPut, b.name, key, value)
+	return nil
+}
+
+func (b *memoryBucket) Delete(key []byte) error {
+	if !b.tx.writable {
+		return errMemoryTxNotWritable
+	}
+	old := b.t.Delete(&memoryItem{key: key})
+	if old == nil {
+		return nil
+	}
+	b.tx.liveSize -= memoryOpSize(b.name, key, old.(*memoryItem).value)
+	b.tx.log(memoryOpDelete, b.name, key, nil)
+	return nil
+}
+
+func (b *memoryBucket) ForEach(fn func(k, v []byte) error) error {
+	var err error
+	b.t.Ascend(func(i btree.Item) bool {
+		it := i.(*memoryItem)
+		err = fn(it.key, it.value)
+		return err == nil
+	})
+	return err
+}
+
+func (b *memoryBucket) Cursor() engineCursor { return &memoryCursor{t: b.t} }
+
+func (b *memoryBucket) HintSequential() {}
+
+type memoryCursor struct {
+	t *btree.BTree
+	// last is the item the cursor is at, or nil once it is past the end.
+	last *memoryItem
+}
+
+func (c *memoryCursor) Seek(seek []byte) ([]byte, []byte) {
+	c.last = nil
+	c.t.AscendGreaterOrEqual(&memoryItem{key: seek}, func(i btree.Item) bool {
+		c.last = i.(*memoryItem)
+		return false
+	})
+	return c.item()
+}
+
+func (c *memoryCursor) Next() ([]byte, []byte) {
+	if c.last == nil {
+		return nil, nil
+	}
+	prev := c.last
+	c.last = nil
+	c.t.AscendGreaterOrEqual(prev, func(i btree.Item) bool {
+		if it := i.(*memoryItem); it.Less(prev) || prev.Less(it) {
+			c.last = it
+			return false
+		}
+		return true
+	})
+	return c.item()
+}
+
+func (c *memoryCursor) item() ([]byte, []byte) {
+	if c.last == nil {
+		return nil, nil
+	}
+	return c.last.key, c.last.value
+}
+
+type memoryItem struct {
+	key   []byte
+	value []byte
+}
+
+func (a *memoryItem) Less(b btree.Item) bool {
+	return bytes.Compare(a.key, b.(*memoryItem).key) < 0
+}
+
+// encodeMemoryRecord returns the record holding the given ops.
+func encodeMemoryRecord(ops []byte) []byte {
+	rec := make([]byte, 8, memoryRecordOverhead+len(ops))
+	binary.LittleEndian.PutUint64(rec, uint64(len(ops)))
+	rec = append(rec, ops...)
+	rec = append(rec, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(rec[len(rec)-4:], crc32.Checksum(ops, crcTable))
+	return rec
+}
+
+func appendMemoryOp(b []byte, typ byte, bucket, key, value []byte) []byte {
+	b = append(b, typ)
+	for _, f := range [][]byte{bucket, key, value} {
+		b = appendUvarint(b, uint64(len(f)))
+		b = append(b, f...)
+	}
+	return b
+}
+
+// memoryOpSize returns the encoded size of an op on the given bucket.
+func memoryOpSize(bucket, key, value []byte) int64 {
+	n := 1
+	for _, b := range [][]byte{bucket, key, value} {
+		n += uvarintSize(uint64(len(b))) + len(b)
+	}
+	return int64(n)
+}
+
+func decodeMemoryOp(b []byte) (typ byte, bucket, key, value, rest []byte, ok bool) {
+	if len(b) == 0 {
+		return 0, nil, nil, nil, nil, false
+	}
+	typ, b = b[0], b[1:]
+	var fields [3][]byte
+	for i := range fields {
+		n, sz := binary.Uvarint(b)
+		if sz <= 0 || uint64(len(b)-sz) < n {
+			return 0, nil, nil, nil, nil, false
+		}
+		fields[i], b = b[sz:sz+int(n)], b[sz+int(n):]
+	}
+	return typ, fields[0], fields[1], fields[2], b, true
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func uvarintSize(v uint64) int {
+	n := 1
+	for ; v >= 0x80; v >>= 7 {
+		n++
+	}
+	return n
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
@@ -0,0 +1,225 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestMemoryEngineReopen ensures the memory engine recovers committed data
+// from its log, and drops a commit that was only partially written.
+func TestMemoryEngineReopen(t *testing.T) {
+	b, tmpPath := newTmpEngineBackend(EngineMemory, time.Hour, 10000)
+	defer os.Remove(tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("key"))
+	for i := 0; i < 10; i++ {
+		tx.UnsafePut([]byte("key"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.UnsafeDelete([]byte("key"), []byte("foo_0"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	tx.Lock()
+	tx.UnsafePut([]byte("key"), []byte("torn"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	size, sizeInUse := b.Size(), b.SizeInUse()
+	if sizeInUse >= size {
+		t.Errorf("size in use = %d, want < %d", sizeInUse, size)
+	}
+	b.Close()
+
+	// cut the last commit short, as a crash in the middle of it would
+	if err := os.Truncate(tmpPath, size-1); err != nil {
+		t.Fatal(err)
+	}
+
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.Engine = tmpPath, EngineMemory
+	nb := newBackend(bcfg)
+	defer nb.Close()
+
+	rtx := nb.ReadTx()
+	rtx.Lock()
+	ks, _ := rtx.UnsafeRange([]byte("key"), []byte("foo_0"), []byte("foo_a"), 0)
+	torn, _ := rtx.UnsafeRange([]byte("key"), []byte("torn"), nil, 0)
+	rtx.Unlock()
+	if len(ks) != 9 {
+		t.Errorf("len(keys) = %d, want 9", len(ks))
+	}
+	if len(torn) != 0 {
+		t.Errorf("keys of torn commit = %q, want none", torn)
+	}
+
+	// the log is appended to after the torn commit has been dropped
+	tx = nb.BatchTx()
+	tx.Lock()
+	tx.UnsafePut([]byte("key"), []byte("more"), []byte("bar"))
+	tx.Unlock()
+	nb.ForceCommit()
+	if !hasCommittedKey(t, nb, []byte("key"), []byte("more")) {
+		t.Errorf("more key failed to written in backend")
+	}
+}
+
+// TestMemoryEngineCorruptRecord ensures the memory engine drops a last record
+// whose checksum does not match as torn, but fails on any earlier one.
+func TestMemoryEngineCorruptRecord(t *testing.T) {
+	b, tmpPath := newTmpEngineBackend(EngineMemory, time.Hour, 10000)
+	defer os.Remove(tmpPath)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("key"))
+	tx.UnsafePut([]byte("key"), []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	tx.Lock()
+	tx.UnsafePut([]byte("key"), []byte("torn"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+	size := b.Size()
+	b.Close()
+
+	data, err := ioutil.ReadFile(tmpPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(len(data)) != size {
+		t.Fatalf("file size = %d, want %d", len(data), size)
+	}
+
+	// garbage in the last record at its full length
+	tail := append([]byte{}, data...)
+	tail[len(tail)-1] ^= 0xff
+	if err = ioutil.WriteFile(tmpPath, tail, 0600); err != nil {
+		t.Fatal(err)
+	}
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.Engine = tmpPath, EngineMemory
+	nb := newBackend(bcfg)
+	if !hasCommittedKey(t, nb, []byte("key"), []byte("foo")) {
+		t.Errorf("foo key of the first commit is missing")
+	}
+	if hasCommittedKey(t, nb, []byte("key"), []byte("torn")) {
+		t.Errorf("torn key of the corrupted last commit is present")
+	}
+	nb.Close()
+
+	// garbage in the first record
+	head := append([]byte{}, data...)
+	head[len(memoryMagic)+memoryRecordOverhead] ^= 0xff
+	if err = ioutil.WriteFile(tmpPath, head, 0600); err != nil {
+		t.Fatal(err)
+	}
+	e, err := openMemoryEngine(tmpPath)
+	if err == nil {
+		e.Close()
+		t.Fatal("expected error opening a database corrupted before its last record")
+	}
+}
+
+// TestMemoryEngineWrongFormat ensures the memory engine does not open
+// TestVolatileBackend ensures a volatile backend keeps its data without a
+// file, snapshots it in the memory engine format, and refuses to defragment.
+func TestVolatileBackend(t *testing.T) {
+	b := NewVolatileBackend(nil).(*backend)
+	defer b.Close()
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket([]byte("key"))
+	for i := 0; i < 10; i++ {
+		tx.UnsafePut([]byte("key"), []byte(fmt.Sprintf("foo_%d", i)), []byte("bar"))
+	}
+	tx.UnsafeDelete([]byte("key"), []byte("foo_0"))
+	tx.Unlock()
+	b.ForceCommit()
+	if !hasCommittedKey(t, b, []byte("key"), []byte("foo_1")) {
+		t.Errorf("foo_1 key failed to written in backend")
+	}
+	if b.Size() != b.SizeInUse() {
+		t.Errorf("size = %d, want size in use %d", b.Size(), b.SizeInUse())
+	}
+
+	f, err := ioutil.TempFile("", "etcd_backend_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	snap := b.Snapshot()
+	n, err := snap.WriteTo(f)
+	snap.Close()
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != b.Size() {
+		t.Errorf("snapshot size = %d, want %d", n, b.Size())
+	}
+
+	bcfg := DefaultBackendConfig()
+	bcfg.Path, bcfg.Engine = f.Name(), EngineMemory
+	nb := newBackend(bcfg)
+	defer nb.Close()
+	rtx := nb.ReadTx()
+	rtx.Lock()
+	ks, _ := rtx.UnsafeRange([]byte("key"), []byte("foo_0"), []byte("foo_a"), 0)
+	rtx.Unlock()
+	if len(ks) != 9 {
+		t.Errorf("len(keys) = %d, want 9", len(ks))
+	}
+
+	if err = b.Defrag(); err != errDefragVolatile {
+		t.Errorf("Defrag error = %v, want %v", err, errDefragVolatile)
+	}
+	if err = b.DefragOnline(nil); err != errDefragVolatile {
+		t.Errorf("DefragOnline error = %v, want %v", err, errDefragVolatile)
+	}
+}
+
+// a database written by another engine.
+func TestMemoryEngineWrongFormat(t *testing.T) {
+	b, tmpPath := newTmpEngineBackend(EngineBolt, time.Hour, 10000)
+	defer os.Remove(tmpPath)
+	b.Close()
+
+	e, err := openMemoryEngine(tmpPath)
+	if err == nil {
+		e.Close()
+		t.Fatalf("expected error opening a %s database", EngineBolt)
+	}
+}
+
+func TestDetectEngine(t *testing.T) {
+	for _, engine := range testEngines {
+		b, tmpPath := newTmpEngineBackend(engine, time.Hour, 10000)
+		b.Close()
+		got, err := DetectEngine(tmpPath)
+		os.Remove(tmpPath)
+		if err != nil || got != engine {
+			t.Errorf("engine = %q (%v), want %q", got, err, engine)
+		}
+	}
+}
@@ -73,6 +73,38 @@ var (
 		Buckets: prometheus.ExponentialBuckets(.1, 2, 13),
 	})
 
+	defragPauseSec = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "backend_defrag_pause_duration_seconds",
+		Help:      "The latency distribution of the time online backend defragmentation blocks reads and writes.",
+
+		// lowest bucket start of upper bound 0.001 sec (1 ms) with factor 2
+		// highest bucket start of 0.001 sec * 2^13 == 8.192 sec
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+
+	defragInProgress = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "backend_defrag_in_progress",
+		Help:      "Whether an online backend defragmentation is in progress. 1 is in progress, 0 is not.",
+	})
+
+	defragCopiedKeys = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "backend_defrag_copied_keys_total",
+		Help:      "The total number of keys copied by online backend defragmentation.",
+	})
+
+	defragReplayedWrites = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "disk",
+		Name:      "backend_defrag_replayed_writes_total",
+		Help:      "The total number of writes made during online backend defragmentation that were replayed onto the defragmented database.",
+	})
+
 	snapshotTransferSec = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Namespace: "etcd",
 		Subsystem: "disk",
@@ -91,5 +123,9 @@ func init() {
 	prometheus.MustRegister(spillSec)
 	prometheus.MustRegister(writeSec)
 	prometheus.MustRegister(defragSec)
+	prometheus.MustRegister(defragPauseSec)
+	prometheus.MustRegister(defragInProgress)
+	prometheus.MustRegister(defragCopiedKeys)
+	prometheus.MustRegister(defragReplayedWrites)
 	prometheus.MustRegister(snapshotTransferSec)
 }
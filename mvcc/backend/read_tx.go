@@ -18,8 +18,6 @@ import (
 	"bytes"
 	"math"
 	"sync"
-
-	bolt "go.etcd.io/bbolt"
 )
 
 // safeRangeBucket is a hack to avoid inadvertently reading duplicate keys;
@@ -35,21 +33,18 @@ type ReadTx interface {
 	UnsafeForEach(bucketName []byte, visitor func(k, v []byte) error) error
 }
 
-type readTx struct {
-	// mu protects accesses to the txReadBuffer
-	mu  sync.RWMutex
+// baseReadTx reads from a read buffer and the engine tx the buffered writes
+// have not been committed to yet.
+type baseReadTx struct {
 	buf txReadBuffer
 
-	// txmu protects accesses to buckets and tx on Range requests.
-	txmu    sync.RWMutex
-	tx      *bolt.Tx
-	buckets map[string]*bolt.Bucket
+	// txMu protects accesses to buckets and tx on Range requests.
+	txMu    *sync.RWMutex
+	tx      engineTx
+	buckets map[string]engineBucket
 }
 
-func (rt *readTx) Lock()   { rt.mu.RLock() }
-func (rt *readTx) Unlock() { rt.mu.RUnlock() }
-
-func (rt *readTx) UnsafeRange(bucketName, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
+func (rt *baseReadTx) UnsafeRange(bucketName, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
 	if endKey == nil {
 		// forbid duplicates for single keys
 		limit = 1
@@ -67,29 +62,29 @@ func (rt *readTx) UnsafeRange(bucketName, key, endKey []byte, limit int64) ([][]
 
 	// find/cache bucket
 	bn := string(bucketName)
-	rt.txmu.RLock()
+	rt.txMu.RLock()
 	bucket, ok := rt.buckets[bn]
-	rt.txmu.RUnlock()
+	rt.txMu.RUnlock()
 	if !ok {
-		rt.txmu.Lock()
+		rt.txMu.Lock()
 		bucket = rt.tx.Bucket(bucketName)
 		rt.buckets[bn] = bucket
-		rt.txmu.Unlock()
+		rt.txMu.Unlock()
 	}
 
 	// ignore missing bucket since may have been created in this batch
 	if bucket == nil {
 		return keys, vals
 	}
-	rt.txmu.Lock()
+	rt.txMu.Lock()
 	c := bucket.Cursor()
-	rt.txmu.Unlock()
+	rt.txMu.Unlock()
 
 	k2, v2 := unsafeRange(c, key, endKey, limit-int64(len(keys)))
 	return append(k2, keys...), append(v2, vals...)
 }
 
-func (rt *readTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) error) error {
+func (rt *baseReadTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) error) error {
 	dups := make(map[string]struct{})
 	getDups := func(k, v []byte) error {
 		dups[string(k)] = struct{}{}
@@ -104,17 +99,63 @@ func (rt *readTx) UnsafeForEach(bucketName []byte, visitor func(k, v []byte) err
 	if err := rt.buf.ForEach(bucketName, getDups); err != nil {
 		return err
 	}
-	rt.txmu.Lock()
+	rt.txMu.Lock()
 	err := unsafeForEach(rt.tx, bucketName, visitNoDup)
-	rt.txmu.Unlock()
+	rt.txMu.Unlock()
 	if err != nil {
 		return err
 	}
 	return rt.buf.ForEach(bucketName, visitor)
 }
 
+type readTx struct {
+	baseReadTx
+
+	// mu protects accesses to the txReadBuffer
+	mu sync.RWMutex
+	// txWg counts the concurrent read txs reading from tx, which is only
+	// rolled back once they are all done.
+	txWg *sync.WaitGroup
+
+	// bufCopy is a copy of buf shared by the concurrent read txs, since
+	// they only read it. It is dropped whenever buf changes.
+	bufCopyMu sync.Mutex
+	bufCopy   *txReadBuffer
+}
+
+func (rt *readTx) Lock()   { rt.mu.RLock() }
+func (rt *readTx) Unlock() { rt.mu.RUnlock() }
+
+// copyBuf returns a copy of the read buffer. The caller must hold mu for reading.
+func (rt *readTx) copyBuf() txReadBuffer {
+	rt.bufCopyMu.Lock()
+	defer rt.bufCopyMu.Unlock()
+	if rt.bufCopy == nil {
+		cp := rt.buf.unsafeCopy()
+		rt.bufCopy = &cp
+	}
+	return *rt.bufCopy
+}
+
 func (rt *readTx) reset() {
 	rt.buf.reset()
-	rt.buckets = make(map[string]*bolt.Bucket)
+	rt.bufCopy = nil
+	rt.buckets = make(map[string]engineBucket)
 	rt.tx = nil
+	rt.txWg = new(sync.WaitGroup)
+}
+
+// concurrentReadTx reads from its own copy of the read buffer, so that
+// neither it nor the batch tx committing waits for the other. It keeps the
+// engine tx it was created with open until it ends, even if the batch tx
+// commits meanwhile.
+type concurrentReadTx struct {
+	baseReadTx
+	txWg *sync.WaitGroup
 }
+
+// Lock is a no-op; the tx needs no locking once created.
+func (rt *concurrentReadTx) Lock() {}
+
+// Unlock ends the tx. It must be called exactly once.
+func (rt *concurrentReadTx) Unlock() { rt.txWg.Done() }
@@ -81,6 +81,16 @@ func (txr *txReadBuffer) Range(bucketName, key, endKey []byte, limit int64) ([][
 	return nil, nil
 }
 
+// unsafeCopy returns a copy of the buffer, which the caller must hold the
+// readTx lock for.
+func (txr *txReadBuffer) unsafeCopy() txReadBuffer {
+	cp := txReadBuffer{txBuffer{make(map[string]*bucketBuffer, len(txr.buckets))}}
+	for name, bb := range txr.buckets {
+		cp.buckets[name] = bb.copy()
+	}
+	return cp
+}
+
 func (txr *txReadBuffer) ForEach(bucketName []byte, visitor func(k, v []byte) error) error {
 	if b := txr.buckets[string(bucketName)]; b != nil {
 		return b.ForEach(visitor)
@@ -107,7 +117,7 @@ func newBucketBuffer() *bucketBuffer {
 func (bb *bucketBuffer) Range(key, endKey []byte, limit int64) (keys [][]byte, vals [][]byte) {
 	f := func(i int) bool { return bytes.Compare(bb.buf[i].key, key) >= 0 }
 	idx := sort.Search(bb.used, f)
-	if idx < 0 {
+	if idx < 0 || idx >= bb.used {
 		return nil, nil
 	}
 	if len(endKey) == 0 {
@@ -139,6 +149,13 @@ func (bb *bucketBuffer) ForEach(visitor func(k, v []byte) error) error {
 	return nil
 }
 
+// copy returns a copy of bb to read from; it cannot be added to.
+func (bb *bucketBuffer) copy() *bucketBuffer {
+	buf := make([]kv, bb.used)
+	copy(buf, bb.buf[:bb.used])
+	return &bucketBuffer{buf: buf, used: bb.used}
+}
+
 func (bb *bucketBuffer) add(k, v []byte) {
 	bb.buf[bb.used].key, bb.buf[bb.used].val = k, v
 	bb.used++
@@ -26,15 +26,19 @@ type index interface {
 	Get(key []byte, atRev int64) (rev, created revision, ver int64, err error)
 	Range(key, end []byte, atRev int64) ([][]byte, []revision)
 	Revisions(key, end []byte, atRev int64) []revision
+	CountRevisions(key, end []byte, atRev int64) int
 	Put(key []byte, rev revision)
 	Tombstone(key []byte, rev revision) error
 	RangeSince(key, end []byte, rev int64) []revision
-	Compact(rev int64) map[revision]struct{}
-	Keep(rev int64) map[revision]struct{}
+	Compact(rev int64, r retention) map[revision]struct{}
+	Keep(rev int64, r retention) map[revision]struct{}
 	Equal(b index) bool
 
 	Insert(ki *keyIndex)
 	KeyIndex(ki *keyIndex) *keyIndex
+	// VisitAt calls f with a copy of every key index as it was at the given
+	// revision, in key order, until f returns an error.
+	VisitAt(rev int64, f func(ki *keyIndex) error) error
 }
 
 type treeIndex struct {
@@ -119,6 +123,25 @@ func (ti *treeIndex) Revisions(key, end []byte, atRev int64) (revs []revision) {
 	return revs
 }
 
+// CountRevisions returns the number of keys in the range at the given
+// revision, without collecting their revisions.
+func (ti *treeIndex) CountRevisions(key, end []byte, atRev int64) int {
+	if end == nil {
+		_, _, _, err := ti.Get(key, atRev)
+		if err != nil {
+			return 0
+		}
+		return 1
+	}
+	total := 0
+	ti.visit(key, end, func(ki *keyIndex) {
+		if _, _, _, err := ki.get(ti.lg, atRev); err == nil {
+			total++
+		}
+	})
+	return total
+}
+
 func (ti *treeIndex) Range(key, end []byte, atRev int64) (keys [][]byte, revs []revision) {
 	if end == nil {
 		rev, _, _, err := ti.Get(key, atRev)
@@ -183,7 +206,10 @@ func (ti *treeIndex) RangeSince(key, end []byte, rev int64) []revision {
 	return revs
 }
 
-func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
+// Compact compacts every key index up to the given rev, or the older
+// revision the retention compacts its key up to, and returns the revisions
+// to keep in the backend.
+func (ti *treeIndex) Compact(rev int64, r retention) map[revision]struct{} {
 	available := make(map[revision]struct{})
 	if ti.lg != nil {
 		ti.lg.Info("compact tree index", zap.Int64("revision", rev))
@@ -199,7 +225,9 @@ func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 		//Lock is needed here to prevent modification to the keyIndex while
 		//compaction is going on or revision added to empty before deletion
 		ti.Lock()
-		keyi.compact(ti.lg, rev, available)
+		at := r.compactRev(keyi.key, rev)
+		keyi.compact(ti.lg, at, available)
+		keyi.retain(at, rev, available)
 		if keyi.isEmpty() {
 			item := ti.tree.Delete(keyi)
 			if item == nil {
@@ -217,13 +245,15 @@ func (ti *treeIndex) Compact(rev int64) map[revision]struct{} {
 }
 
 // Keep finds all revisions to be kept for a Compaction at the given rev.
-func (ti *treeIndex) Keep(rev int64) map[revision]struct{} {
+func (ti *treeIndex) Keep(rev int64, r retention) map[revision]struct{} {
 	available := make(map[revision]struct{})
 	ti.RLock()
 	defer ti.RUnlock()
 	ti.tree.Ascend(func(i btree.Item) bool {
 		keyi := i.(*keyIndex)
-		keyi.keep(rev, available)
+		at := r.compactRev(keyi.key, rev)
+		keyi.keep(at, available)
+		keyi.retain(at, rev, available)
 		return true
 	})
 	return available
@@ -251,6 +281,47 @@ func (ti *treeIndex) Equal(bi index) bool {
 	return equal
 }
 
+// visitChunkKeys is the number of key indexes VisitAt copies per lock of the
+// tree, so that visiting a large tree does not block writes for long.
+const visitChunkKeys = 10000
+
+func (ti *treeIndex) VisitAt(rev int64, f func(ki *keyIndex) error) error {
+	var (
+		chunk []*keyIndex
+		from  btree.Item
+	)
+	for {
+		chunk = chunk[:0]
+		visit := func(item btree.Item) bool {
+			ki := item.(*keyIndex)
+			// the chunk after the first starts from its last key
+			if from != nil && !from.Less(ki) {
+				return true
+			}
+			from = ki
+			if c := ki.at(rev); c != nil {
+				chunk = append(chunk, c)
+			}
+			return len(chunk) < visitChunkKeys
+		}
+		ti.RLock()
+		if from == nil {
+			ti.tree.Ascend(visit)
+		} else {
+			ti.tree.AscendGreaterOrEqual(from, visit)
+		}
+		ti.RUnlock()
+		for _, ki := range chunk {
+			if err := f(ki); err != nil {
+				return err
+			}
+		}
+		if len(chunk) < visitChunkKeys {
+			return nil
+		}
+	}
+}
+
 func (ti *treeIndex) Insert(ki *keyIndex) {
 	ti.Lock()
 	defer ti.Unlock()
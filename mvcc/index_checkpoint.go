@@ -0,0 +1,394 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"go.etcd.io/etcd/lease"
+	"go.etcd.io/etcd/mvcc/backend"
+	"go.etcd.io/etcd/pkg/fileutil"
+)
+
+// An index checkpoint is laid out as
+//
+//	magic | uvarint rev | uint32 revCRC | key records | 0 | varint compactRev | uint32 crc
+//
+// where each key record is
+//
+//	uvarint len(key)+1 | key | varint lease | uvarint generations |
+//	  (uvarint ver | created | uvarint revisions | revisions)...
+//
+// and each revision is its main and sub revisions as uvarints. The key
+// indexes are those at rev. revCRC is the checksum of the first backend entry
+// at rev; it ties the checkpoint to the backend it was taken from. compactRev
+// is the compaction revision once all keys were written, so it covers every
+// compaction the key indexes went through. crc is the checksum of everything
+// before it.
+var indexCheckpointMagic = []byte("etcdidx1")
+
+var (
+	errIndexCheckpointCorrupt = errors.New("mvcc: index checkpoint is corrupted")
+	errIndexCheckpointStale   = errors.New("mvcc: index checkpoint does not match the backend")
+)
+
+// at returns a copy of the key index as it was at the given revision, or nil
+// if the key had no revisions by then.
+func (ki *keyIndex) at(rev int64) *keyIndex {
+	c := &keyIndex{key: ki.key}
+	for i, g := range ki.generations {
+		n := sort.Search(len(g.revs), func(j int) bool { return g.revs[j].main > rev })
+		if n == 0 {
+			break
+		}
+		if l := len(c.generations); l > 0 && c.generations[l-1].isEmpty() {
+			c.generations = c.generations[:l-1]
+		}
+		revs := make([]revision, n)
+		copy(revs, g.revs[:n])
+		c.generations = append(c.generations, generation{
+			ver:     g.ver - int64(len(g.revs)-n),
+			created: g.created,
+			revs:    revs,
+		})
+		c.modified = revs[n-1]
+		if n < len(g.revs) {
+			break
+		}
+		if i < len(ki.generations)-1 {
+			// the generation ended with a tombstone
+			c.generations = append(c.generations, generation{})
+		}
+	}
+	if len(c.generations) == 0 {
+		return nil
+	}
+	return c
+}
+
+// unsafeRevisionCRC returns the checksum of the first backend entry at the
+// given main revision. It returns false if there is none.
+func unsafeRevisionCRC(tx backend.ReadTx, rev int64) (uint32, bool) {
+	min, max := newRevBytes(), newRevBytes()
+	revToBytes(revision{main: rev}, min)
+	revToBytes(revision{main: rev + 1}, max)
+	keys, vals := tx.UnsafeRange(keyBucketName, min, max, 1)
+	if len(keys) == 0 {
+		return 0, false
+	}
+	crc := crc32.NewIEEE()
+	crc.Write(keys[0])
+	crc.Write(vals[0])
+	return crc.Sum32(), true
+}
+
+// CheckpointIndex implements KV.
+func (s *store) CheckpointIndex() error {
+	if s.cfg.IndexCheckpointPath == "" {
+		return nil
+	}
+	s.checkpointMu.Lock()
+	defer s.checkpointMu.Unlock()
+
+	start := time.Now()
+	// the key index is visited without holding the store lock, so that
+	// compactions are not blocked meanwhile
+	s.mu.RLock()
+	b, kvindex := s.b, s.kvindex
+	s.revMu.RLock()
+	rev := s.currentRev
+	s.revMu.RUnlock()
+	s.mu.RUnlock()
+
+	tx := b.ReadTx()
+	tx.Lock()
+	revCRC, ok := unsafeRevisionCRC(tx, rev)
+	tx.Unlock()
+	if !ok {
+		// nothing was written yet, or the revision was compacted away
+		return nil
+	}
+
+	path := s.cfg.IndexCheckpointPath
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if f != nil {
+			f.Close()
+			os.Remove(tmp)
+		}
+	}()
+
+	crc := crc32.NewIEEE()
+	w := bufio.NewWriter(io.MultiWriter(f, crc))
+	buf := make([]byte, binary.MaxVarintLen64)
+	putUvarint := func(v uint64) { w.Write(buf[:binary.PutUvarint(buf, v)]) }
+	putRev := func(r revision) {
+		putUvarint(uint64(r.main))
+		putUvarint(uint64(r.sub))
+	}
+
+	w.Write(indexCheckpointMagic)
+	putUvarint(uint64(rev))
+	binary.BigEndian.PutUint32(buf, revCRC)
+	w.Write(buf[:4])
+	err = kvindex.VisitAt(rev, func(ki *keyIndex) error {
+		lid := lease.NoLease
+		if s.le != nil && !ki.generations[len(ki.generations)-1].isEmpty() {
+			lid = s.le.GetLease(lease.LeaseItem{Key: string(ki.key)})
+		}
+		putUvarint(uint64(len(ki.key)) + 1)
+		w.Write(ki.key)
+		w.Write(buf[:binary.PutVarint(buf, int64(lid))])
+		putUvarint(uint64(len(ki.generations)))
+		for _, g := range ki.generations {
+			putUvarint(uint64(g.ver))
+			putRev(g.created)
+			putUvarint(uint64(len(g.revs)))
+			for _, r := range g.revs {
+				putRev(r)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	putUvarint(0)
+	s.revMu.RLock()
+	compactRev := s.compactMainRev
+	s.revMu.RUnlock()
+	w.Write(buf[:binary.PutVarint(buf, compactRev)])
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	binary.BigEndian.PutUint32(buf, crc.Sum32())
+	if _, err = f.Write(buf[:4]); err != nil {
+		return err
+	}
+	if err = fileutil.Fsync(f); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	f = nil
+	if err = os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	indexCheckpointSec.Observe(time.Since(start).Seconds())
+	return nil
+}
+
+// indexCheckpoint is a key index loaded from a checkpoint.
+type indexCheckpoint struct {
+	rev        int64
+	revCRC     uint32
+	compactRev int64
+	keys       []*keyIndex
+	leases     map[string]lease.LeaseID
+}
+
+// readIndexCheckpoint reads the checkpoint at the given path. It returns
+// errIndexCheckpointCorrupt if the checkpoint fails to verify.
+func readIndexCheckpoint(path string) (*indexCheckpoint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := fi.Size()
+	if size < int64(len(indexCheckpointMagic))+4 {
+		return nil, errIndexCheckpointCorrupt
+	}
+
+	// verify the checksum before decoding anything
+	crc := crc32.NewIEEE()
+	if _, err = io.Copy(crc, io.LimitReader(f, size-4)); err != nil {
+		return nil, err
+	}
+	sum := make([]byte, 4)
+	if _, err = io.ReadFull(f, sum); err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint32(sum) != crc.Sum32() {
+		return nil, errIndexCheckpointCorrupt
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	ck, err := decodeIndexCheckpoint(bufio.NewReader(io.LimitReader(f, size-4)), size)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = errIndexCheckpointCorrupt
+	}
+	return ck, err
+}
+
+func decodeIndexCheckpoint(r *bufio.Reader, size int64) (*indexCheckpoint, error) {
+	magic := make([]byte, len(indexCheckpointMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+	if string(magic) != string(indexCheckpointMagic) {
+		return nil, errIndexCheckpointCorrupt
+	}
+
+	var err error
+	// uvarint reads a value no larger than max, so that corrupted lengths
+	// and revisions are not trusted
+	uvarint := func(max int64) int64 {
+		if err != nil {
+			return 0
+		}
+		var v uint64
+		if v, err = binary.ReadUvarint(r); err == nil && v > uint64(max) {
+			err = errIndexCheckpointCorrupt
+		}
+		return int64(v)
+	}
+	ck := &indexCheckpoint{leases: make(map[string]lease.LeaseID)}
+	ck.rev = uvarint(math.MaxInt64)
+	sum := make([]byte, 4)
+	if err == nil {
+		_, err = io.ReadFull(r, sum)
+		ck.revCRC = binary.BigEndian.Uint32(sum)
+	}
+	rev := func() revision { return revision{main: uvarint(ck.rev), sub: uvarint(math.MaxInt64)} }
+
+	var last []byte
+	for err == nil {
+		n := uvarint(size)
+		if err != nil || n == 0 {
+			break
+		}
+		ki := &keyIndex{key: make([]byte, n-1)}
+		if _, err = io.ReadFull(r, ki.key); err != nil {
+			break
+		}
+		if last != nil && string(ki.key) <= string(last) {
+			err = errIndexCheckpointCorrupt
+			break
+		}
+		last = ki.key
+		var lid int64
+		if lid, err = binary.ReadVarint(r); err != nil {
+			break
+		}
+		ki.generations = make([]generation, uvarint(size))
+		for i := range ki.generations {
+			g := &ki.generations[i]
+			g.ver = uvarint(math.MaxInt64)
+			g.created = rev()
+			g.revs = make([]revision, uvarint(size))
+			for j := range g.revs {
+				g.revs[j] = rev()
+				if err == nil && !g.revs[j].GreaterThan(ki.modified) {
+					err = errIndexCheckpointCorrupt
+				}
+				ki.modified = g.revs[j]
+			}
+			// only the last generation may be empty
+			if err == nil && g.isEmpty() && i != len(ki.generations)-1 {
+				err = errIndexCheckpointCorrupt
+			}
+		}
+		if err == nil && (len(ki.generations) == 0 || ki.modified.main == 0) {
+			err = errIndexCheckpointCorrupt
+		}
+		if err != nil {
+			break
+		}
+		if lid != int64(lease.NoLease) && !ki.generations[len(ki.generations)-1].isEmpty() {
+			ck.leases[string(ki.key)] = lease.LeaseID(lid)
+		}
+		ck.keys = append(ck.keys, ki)
+	}
+	if err == nil {
+		ck.compactRev, err = binary.ReadVarint(r)
+	}
+	if err == nil {
+		if _, perr := r.Peek(1); perr != io.EOF {
+			err = errIndexCheckpointCorrupt
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ck, nil
+}
+
+// unsafeRestoreIndexCheckpoint loads the index checkpoint into the key index, if
+// it matches the backend. The compaction revisions are those the backend
+// finished and scheduled. It returns nil with the reason the checkpoint was
+// not used otherwise.
+func (s *store) unsafeRestoreIndexCheckpoint(tx backend.ReadTx, finishedCompact, scheduledCompact int64) (*indexCheckpoint, error) {
+	ck, err := readIndexCheckpoint(s.cfg.IndexCheckpointPath)
+	if err != nil {
+		return nil, err
+	}
+	if revCRC, ok := unsafeRevisionCRC(tx, ck.rev); !ok || revCRC != ck.revCRC {
+		return nil, errIndexCheckpointStale
+	}
+	// the backend must have gone through every compaction the checkpoint
+	// has, and none that removed revisions after it; those may have been
+	// tombstones the key indexes would miss
+	compacted := finishedCompact
+	if scheduledCompact > compacted {
+		compacted = scheduledCompact
+	}
+	if compacted < ck.compactRev || compacted > ck.rev {
+		return nil, errIndexCheckpointStale
+	}
+
+	for _, ki := range ck.keys {
+		s.kvindex.Insert(ki)
+		if !ki.generations[len(ki.generations)-1].isEmpty() {
+			keysGauge.Inc()
+		}
+		if s.revindex != nil {
+			for i, g := range ki.generations {
+				revs := g.revs
+				if i != len(ki.generations)-1 && len(revs) > 0 {
+					// skip the tombstone ending the generation
+					revs = revs[:len(revs)-1]
+				}
+				for _, r := range revs {
+					s.revindex.Put(ki.key, r)
+				}
+			}
+		}
+	}
+	if finishedCompact > ck.compactRev {
+		s.kvindex.Compact(finishedCompact, s.retention)
+	}
+	return ck, nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/lease"
+	"go.etcd.io/etcd/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+func TestKeyIndexAt(t *testing.T) {
+	ki := newTestKeyIndex()
+	tests := []struct {
+		rev int64
+		w   *keyIndex
+	}{
+		{1, nil},
+		{2, &keyIndex{
+			key:         []byte("foo"),
+			modified:    revision{2, 0},
+			generations: []generation{{ver: 1, created: revision{2, 0}, revs: []revision{{2, 0}}}},
+		}},
+		{5, &keyIndex{
+			key:         []byte("foo"),
+			modified:    revision{4, 0},
+			generations: []generation{{ver: 2, created: revision{2, 0}, revs: []revision{{2, 0}, {4, 0}}}},
+		}},
+		// a generation ending with a tombstone is followed by an empty one
+		{7, &keyIndex{
+			key:      []byte("foo"),
+			modified: revision{6, 0},
+			generations: []generation{
+				{ver: 3, created: revision{2, 0}, revs: []revision{{2, 0}, {4, 0}, {6, 0}}},
+				{},
+			},
+		}},
+		{8, &keyIndex{
+			key:      []byte("foo"),
+			modified: revision{8, 0},
+			generations: []generation{
+				{ver: 3, created: revision{2, 0}, revs: []revision{{2, 0}, {4, 0}, {6, 0}}},
+				{ver: 1, created: revision{8, 0}, revs: []revision{{8, 0}}},
+			},
+		}},
+		{16, ki},
+		{100, ki},
+	}
+	for i, tt := range tests {
+		if g := ki.at(tt.rev); !reflect.DeepEqual(g, tt.w) {
+			t.Errorf("#%d: at(%d) = %+v, want %+v", i, tt.rev, g, tt.w)
+		}
+	}
+}
+
+// TestIndexCheckpointRestore ensures a store restored from an index checkpoint
+// serves the same ranges as the store it was taken from, including the writes,
+// leases and compactions after the checkpoint.
+func TestIndexCheckpointRestore(t *testing.T) {
+	oldChunk := restoreChunkKeys
+	restoreChunkKeys = 3
+	defer func() { restoreChunkKeys = oldChunk }()
+
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	dir, err := ioutil.TempDir(os.TempDir(), "index_checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := StoreConfig{IndexCheckpointPath: filepath.Join(dir, "db.index")}
+
+	le := lease.NewLessor(zap.NewExample(), b, lease.LessorConfig{MinLeaseTTL: 1000})
+	defer le.Stop()
+	if _, err = le.Grant(1, 1000); err != nil {
+		t.Fatal(err)
+	}
+	s := NewStoreWithConfig(zap.NewExample(), b, le, nil, cfg)
+	defer s.Close()
+
+	write := func(n int) {
+		for i := 0; i < n; i++ {
+			k := []byte(fmt.Sprintf("foo-%d", i%7))
+			switch i % 5 {
+			case 3:
+				s.DeleteRange(k, nil)
+			case 4:
+				s.Put(k, []byte(fmt.Sprint(i)), 1)
+			default:
+				s.Put(k, []byte(fmt.Sprint(i)), lease.NoLease)
+			}
+		}
+	}
+	write(40)
+	done, err := s.Compact(20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if err = s.CheckpointIndex(); err != nil {
+		t.Fatal(err)
+	}
+	ckRev := s.Rev()
+	write(23)
+	// compactions up to the checkpoint revision keep it usable
+	if done, err = s.Compact(ckRev - 2); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	s.Commit()
+
+	ck, err := readIndexCheckpoint(cfg.IndexCheckpointPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ck.rev != ckRev || ck.compactRev != 20 {
+		t.Fatalf("checkpoint at (%d, %d), want (%d, 20)", ck.rev, ck.compactRev, ckRev)
+	}
+	tx := b.BatchTx()
+	tx.Lock()
+	_, err = newIndexOnlyStore(b, cfg).unsafeRestoreIndexCheckpoint(tx, ckRev-2, 0)
+	tx.Unlock()
+	if err != nil {
+		t.Fatalf("checkpoint not restorable: %v", err)
+	}
+
+	le2 := lease.NewLessor(zap.NewExample(), b, lease.LessorConfig{MinLeaseTTL: 1000})
+	defer le2.Stop()
+	s2 := NewStoreWithConfig(zap.NewExample(), b, le2, nil, cfg)
+	defer s2.Close()
+	testStoresEqual(t, s, s2)
+	for i := 0; i < 7; i++ {
+		item := lease.LeaseItem{Key: fmt.Sprintf("foo-%d", i)}
+		if g, w := le2.GetLease(item), le.GetLease(item); g != w {
+			t.Errorf("lease of %q = %v, want %v", item.Key, g, w)
+		}
+	}
+}
+
+// TestIndexCheckpointFallback ensures a corrupted checkpoint, or one the
+// backend was compacted past, is not used and the backend is scanned instead.
+func TestIndexCheckpointFallback(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	dir, err := ioutil.TempDir(os.TempDir(), "index_checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := StoreConfig{IndexCheckpointPath: filepath.Join(dir, "db.index")}
+
+	s := NewStoreWithConfig(zap.NewExample(), b, &lease.FakeLessor{}, nil, cfg)
+	defer s.Close()
+	for i := 0; i < 10; i++ {
+		s.Put([]byte(fmt.Sprintf("foo-%d", i%3)), []byte("bar"), lease.NoLease)
+	}
+	if err = s.CheckpointIndex(); err != nil {
+		t.Fatal(err)
+	}
+	s.DeleteRange([]byte("foo-0"), nil)
+	s.Put([]byte("foo-1"), []byte("baz"), lease.NoLease)
+	// compacting past the checkpoint drops the tombstone of foo-0
+	done, err := s.Compact(s.Rev())
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	s.Commit()
+
+	tx := b.BatchTx()
+	tx.Lock()
+	_, err = newIndexOnlyStore(b, cfg).unsafeRestoreIndexCheckpoint(tx, s.Rev(), 0)
+	tx.Unlock()
+	if err != errIndexCheckpointStale {
+		t.Fatalf("err = %v, want %v", err, errIndexCheckpointStale)
+	}
+	s2 := NewStoreWithConfig(zap.NewExample(), b, &lease.FakeLessor{}, nil, cfg)
+	defer s2.Close()
+	testStoresEqual(t, s, s2)
+
+	data, err := ioutil.ReadFile(cfg.IndexCheckpointPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)/2]++
+	if err = ioutil.WriteFile(cfg.IndexCheckpointPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = readIndexCheckpoint(cfg.IndexCheckpointPath); err != errIndexCheckpointCorrupt {
+		t.Fatalf("err = %v, want %v", err, errIndexCheckpointCorrupt)
+	}
+	s3 := NewStoreWithConfig(zap.NewExample(), b, &lease.FakeLessor{}, nil, cfg)
+	defer s3.Close()
+	testStoresEqual(t, s, s3)
+}
+
+// newIndexOnlyStore returns a store with an empty key index, to restore
+// checkpoints into.
+func newIndexOnlyStore(b backend.Backend, cfg StoreConfig) *store {
+	return &store{cfg: cfg, b: b, kvindex: newTreeIndex(zap.NewExample())}
+}
+
+// testStoresEqual checks the stores serve the same keys at every revision
+// since the compaction of the first.
+func testStoresEqual(t *testing.T, a, b *store) {
+	if a.Rev() != b.Rev() {
+		t.Fatalf("revision = %d, want %d", b.Rev(), a.Rev())
+	}
+	for rev := a.compactMainRev + 1; rev <= a.Rev(); rev++ {
+		ra, err := a.Range([]byte("a"), []byte("z"), RangeOptions{Rev: rev})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rb, err := b.Range([]byte("a"), []byte("z"), RangeOptions{Rev: rev})
+		if err != nil {
+			t.Fatalf("range at %d: %v", rev, err)
+		}
+		if !reflect.DeepEqual(ra.KVs, rb.KVs) {
+			t.Errorf("range at %d = %+v, want %+v", rev, rb.KVs, ra.KVs)
+		}
+	}
+}
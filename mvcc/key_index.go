@@ -268,6 +268,21 @@ func (ki *keyIndex) keep(atRev int64, available map[revision]struct{}) {
 	}
 }
 
+// retain adds the revisions of the key made after fromRev and up to atRev
+// to available, so that compacting past them keeps them.
+func (ki *keyIndex) retain(fromRev, atRev int64, available map[revision]struct{}) {
+	if fromRev >= atRev {
+		return
+	}
+	for _, g := range ki.generations {
+		for _, rev := range g.revs {
+			if rev.main > fromRev && rev.main <= atRev {
+				available[rev] = struct{}{}
+			}
+		}
+	}
+}
+
 func (ki *keyIndex) doCompact(atRev int64, available map[revision]struct{}) (genIdx int, revIndex int) {
 	// walk until reaching the first revision smaller or equal to "atRev",
 	// and add the revision to the available map
@@ -24,6 +24,15 @@ type RangeOptions struct {
 	Limit int64
 	Rev   int64
 	Count bool
+	// MinModRev and MinCreateRev restrict the returned keys to those
+	// modified and created at or after the given revisions, before Limit
+	// applies. They do not change the count of keys in the range, unless
+	// CountFiltered is set: then the count is that of the keys passing both
+	// minimums, which the revision index can answer without visiting every
+	// key in the range.
+	MinModRev     int64
+	MinCreateRev  int64
+	CountFiltered bool
 }
 
 type RangeResult struct {
@@ -116,9 +125,28 @@ type KV interface {
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(rev int64) (<-chan struct{}, error)
 
+	// CompactRetaining compacts like Compact, but only frees the revisions
+	// of the keys under each retained prefix up to the retained revision.
+	// The history a previous compaction freed stays freed.
+	CompactRetaining(rev int64, retain []PrefixRetention) (<-chan struct{}, error)
+
 	// Commit commits outstanding txns into the underlying backend.
 	Commit()
 
+	// CheckpointIndex saves the key index, so that restoring the KV from
+	// the same backend only scans the revisions made since. It does nothing
+	// unless the KV is configured with an index checkpoint path.
+	CheckpointIndex() error
+
+	// CompactionStatus returns the revision the KV is compacting its backend
+	// to, and the revision it has compacted the backend up to so far. Both
+	// are 0 if no compaction is running.
+	CompactionStatus() (rev, compactedUpTo int64)
+
+	// ValueCompressionRatio returns the bytes stored per byte of the values
+	// put since the KV was created, which is 1 without value compression.
+	ValueCompressionRatio() float64
+
 	// Restore restores the KV store from a backend.
 	Restore(b backend.Backend) error
 	Close() error
@@ -135,6 +163,11 @@ type Watchable interface {
 	// NewWatchStream returns a WatchStream that can be used to
 	// watch events happened or happening on the KV.
 	NewWatchStream() WatchStream
+
+	// NewLimitedWatchStream returns a WatchStream like NewWatchStream whose
+	// responses hold their bytes in the given limiter from when they are
+	// produced until they are sent.
+	NewLimitedWatchStream(bl WatchBufferLimiter) WatchStream
 }
 
 // ConsistentWatchableKV is a WatchableKV that understands the consistency
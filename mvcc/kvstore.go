@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"math"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -68,6 +69,41 @@ type ConsistentIndexGetter interface {
 	ConsistentIndex() uint64
 }
 
+// StoreConfig configures the optional features of a store.
+type StoreConfig struct {
+	// RevisionIndex orders the keys by the revisions they were put at, so
+	// that ranges restricted to keys modified or created since a revision
+	// only visit the keys put since then.
+	RevisionIndex bool
+	// IndexCheckpointPath is the file CheckpointIndex saves the key index
+	// to. If set, restoring the store loads the key index from it and only
+	// scans the backend for the revisions made since. Empty disables it.
+	IndexCheckpointPath string
+
+	// CompactionBatchLimit is the maximum number of revisions a compaction
+	// visits per batch. 0 uses the default of 10000.
+	CompactionBatchLimit int
+	// CompactionMaxPause bounds how long a batch of a compaction holds the
+	// backend transaction; a batch that takes longer ends early. 0 does not
+	// bound it.
+	CompactionMaxPause time.Duration
+	// CompactionKeysPerSecond and CompactionBytesPerSecond limit the rate
+	// a compaction deletes revisions at, waiting as long as needed between
+	// batches. Without either, a compaction waits 100ms between batches.
+	CompactionKeysPerSecond  int64
+	CompactionBytesPerSecond int64
+
+	// ValueCompression is the compression of the values put, one of
+	// ValueCompressionNone and ValueCompressionFlate. Values stored with
+	// any compression are read back regardless of it.
+	ValueCompression string
+
+	// WatchCacheRevisions is the number of most recent revisions whose
+	// events the watchable store holds in memory, so that watchers catch
+	// up without reading the backend. 0 disables it.
+	WatchCacheRevisions int64
+}
+
 type store struct {
 	ReadView
 	WriteView
@@ -81,8 +117,21 @@ type store struct {
 
 	ig ConsistentIndexGetter
 
+	cfg StoreConfig
+
 	b       backend.Backend
 	kvindex index
+	// revindex is nil unless cfg.RevisionIndex is set.
+	revindex *revIndex
+	// checkpointMu serializes index checkpoints.
+	checkpointMu sync.Mutex
+	// compressor is nil unless cfg.ValueCompression is set.
+	compressor *valueCompressor
+
+	// compactionMu protects the status of the running compaction.
+	compactionMu     sync.Mutex
+	compactingRev    int64
+	compactedUpToRev int64
 
 	le lease.Lessor
 
@@ -94,6 +143,8 @@ type store struct {
 	currentRev int64
 	// compactMainRev is the main revision of the last compaction.
 	compactMainRev int64
+	// retention is the retention of the last compaction.
+	retention retention
 
 	// bytesBuf8 is a byte slice of length 8
 	// to avoid a repetitive allocation in saveIndex.
@@ -109,7 +160,13 @@ type store struct {
 // NewStore returns a new store. It is useful to create a store inside
 // mvcc pkg. It should only be used for testing externally.
 func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *store {
+	return NewStoreWithConfig(lg, b, le, ig, StoreConfig{})
+}
+
+// NewStoreWithConfig is like NewStore but enables the optional features in cfg.
+func NewStoreWithConfig(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) *store {
 	s := &store{
+		cfg:     cfg,
 		b:       b,
 		ig:      ig,
 		kvindex: newTreeIndex(lg),
@@ -128,6 +185,14 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentI
 	}
 	s.ReadView = &readView{s}
 	s.WriteView = &writeView{s}
+	var err error
+	if s.compressor, err = newValueCompressor(cfg.ValueCompression); err != nil {
+		if lg != nil {
+			lg.Panic("failed to create value compressor", zap.Error(err))
+		} else {
+			plog.Panicf("cannot create value compressor: %v", err)
+		}
+	}
 	if s.le != nil {
 		s.le.SetRangeDeleter(func() lease.TxnDelete { return s.Write() })
 	}
@@ -178,6 +243,7 @@ func (s *store) HashByRev(rev int64) (hash uint32, currentRev int64, compactRev
 	s.mu.RLock()
 	s.revMu.RLock()
 	compactRev, currentRev = s.compactMainRev, s.currentRev
+	r := s.retention
 	s.revMu.RUnlock()
 
 	if rev > 0 && rev <= compactRev {
@@ -191,7 +257,7 @@ func (s *store) HashByRev(rev int64) (hash uint32, currentRev int64, compactRev
 	if rev == 0 {
 		rev = currentRev
 	}
-	keep := s.kvindex.Keep(rev)
+	keep := s.kvindex.Keep(rev, r)
 
 	tx := s.b.ReadTx()
 	tx.Lock()
@@ -210,11 +276,24 @@ func (s *store) HashByRev(rev int64) (hash uint32, currentRev int64, compactRev
 		}
 		// skip revisions that are scheduled for deletion
 		// due to compacting; don't skip if there isn't one.
+		// The revisions of retained prefixes are kept.
 		if lower.GreaterThan(kr) && len(keep) > 0 {
 			if _, ok := keep[kr]; !ok {
 				return nil
 			}
 		}
+		if len(v) > 0 && v[0] == compressedKVMark {
+			// hash the pair as stored uncompressed, so that members
+			// compressing values differently agree
+			var kv mvccpb.KeyValue
+			if err := UnmarshalKeyValue(v, &kv); err != nil {
+				return err
+			}
+			var err error
+			if v, err = kv.Marshal(); err != nil {
+				return err
+			}
+		}
 		h.Write(k)
 		h.Write(v)
 		return nil
@@ -226,6 +305,10 @@ func (s *store) HashByRev(rev int64) (hash uint32, currentRev int64, compactRev
 }
 
 func (s *store) Compact(rev int64) (<-chan struct{}, error) {
+	return s.CompactRetaining(rev, nil)
+}
+
+func (s *store) CompactRetaining(rev int64, retain []PrefixRetention) (<-chan struct{}, error) {
 	s.mu.Lock()
 	s.revMu.Lock()
 	if rev <= s.compactMainRev {
@@ -244,6 +327,8 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 
 	start := time.Now()
 
+	r := s.retention.next(rev, s.compactMainRev, retain)
+	s.retention = r
 	s.compactMainRev = rev
 
 	rbytes := newRevBytes()
@@ -251,6 +336,10 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 
 	tx := s.b.BatchTx()
 	tx.Lock()
+	if len(r) > 0 {
+		// the retention follows the revision, which older versions ignore
+		rbytes = append(rbytes, r.marshal()...)
+	}
 	tx.UnsafePut(metaBucketName, scheduledCompactKeyName, rbytes)
 	tx.Unlock()
 	// ensure that desired compaction is persisted
@@ -258,7 +347,10 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 
 	s.mu.Unlock()
 	s.revMu.Unlock()
-	keep := s.kvindex.Compact(rev)
+	keep := s.kvindex.Compact(rev, r)
+	if s.revindex != nil {
+		s.revindex.Compact(rev)
+	}
 	ch := make(chan struct{})
 	var j = func(ctx context.Context) {
 		if ctx.Err() != nil {
@@ -312,6 +404,7 @@ func (s *store) Restore(b backend.Backend) error {
 	s.kvindex = newTreeIndex(s.lg)
 	s.currentRev = 1
 	s.compactMainRev = -1
+	s.retention = nil
 	s.fifoSched = schedule.NewFIFOScheduler()
 	s.stopc = make(chan struct{})
 
@@ -359,10 +452,48 @@ func (s *store) restore() error {
 	scheduledCompact := int64(0)
 	if len(scheduledCompactBytes) != 0 {
 		scheduledCompact = bytesToRev(scheduledCompactBytes[0]).main
+		if len(scheduledCompactBytes[0]) > revBytesLen {
+			r, err := unmarshalRetention(scheduledCompactBytes[0][revBytesLen:])
+			if err != nil {
+				tx.Unlock()
+				return err
+			}
+			s.retention = r
+		}
 	}
 
-	// index keys concurrently as they're loaded in from tx
+	if s.cfg.RevisionIndex {
+		s.revindex = newRevIndex(s.compactMainRev)
+	}
+
+	start := time.Now()
+	source := "scan"
 	keysGauge.Set(0)
+	var ck *indexCheckpoint
+	if s.cfg.IndexCheckpointPath != "" {
+		var err error
+		ck, err = s.unsafeRestoreIndexCheckpoint(tx, s.compactMainRev, scheduledCompact)
+		switch {
+		case err == nil:
+			// only scan the revisions made since the checkpoint
+			source = "checkpoint"
+			keyToLease = ck.leases
+			revToBytes(revision{main: ck.rev + 1}, min)
+		case os.IsNotExist(err):
+		default:
+			if s.lg != nil {
+				s.lg.Warn(
+					"failed to restore key index from checkpoint; scanning backend",
+					zap.String("path", s.cfg.IndexCheckpointPath),
+					zap.Error(err),
+				)
+			} else {
+				plog.Warningf("cannot restore key index from checkpoint %q (%v); scanning backend", s.cfg.IndexCheckpointPath, err)
+			}
+		}
+	}
+
+	// index keys concurrently as they're loaded in from tx
 	rkvc, revc := restoreIntoIndex(s.lg, s.kvindex)
 	for {
 		keys, vals := tx.UnsafeRange(keyBucketName, min, max, int64(restoreChunkKeys))
@@ -371,7 +502,7 @@ func (s *store) restore() error {
 		}
 		// rkvc blocks if the total pending keys exceeds the restore
 		// chunk size to keep keys from consuming too much memory.
-		restoreChunk(s.lg, rkvc, keys, vals, keyToLease)
+		restoreChunk(s.lg, rkvc, keys, vals, keyToLease, s.revindex)
 		if len(keys) < restoreChunkKeys {
 			// partial set implies final set
 			break
@@ -383,6 +514,20 @@ func (s *store) restore() error {
 	}
 	close(rkvc)
 	s.currentRev = <-revc
+	if ck != nil && s.currentRev < ck.rev {
+		s.currentRev = ck.rev
+	}
+	indexRestoreSec.WithLabelValues(source).Observe(time.Since(start).Seconds())
+	if s.lg != nil {
+		s.lg.Info(
+			"restored key index",
+			zap.String("source", source),
+			zap.Int64("current-revision", s.currentRev),
+			zap.Duration("took", time.Since(start)),
+		)
+	} else {
+		plog.Infof("restored key index from %s in %v", source, time.Since(start))
+	}
 
 	// keys in the range [compacted revision -N, compaction] might all be deleted due to compaction.
 	// the correct revision should be set to compaction revision in the case, not the largest revision
@@ -415,7 +560,8 @@ func (s *store) restore() error {
 	tx.Unlock()
 
 	if scheduledCompact != 0 {
-		s.Compact(scheduledCompact)
+		// the retention was saved along with the scheduled compaction
+		s.CompactRetaining(scheduledCompact, s.retention)
 
 		if s.lg != nil {
 			s.lg.Info(
@@ -483,10 +629,11 @@ func restoreIntoIndex(lg *zap.Logger, idx index) (chan<- revKeyValue, <-chan int
 	return rkvc, revc
 }
 
-func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID) {
+func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, keyToLease map[string]lease.LeaseID, ri *revIndex) {
 	for i, key := range keys {
 		rkv := revKeyValue{key: key}
-		if err := rkv.kv.Unmarshal(vals[i]); err != nil {
+		// only the key and lease are restored; the value may stay compressed
+		if _, err := unmarshalKeyValue(vals[i], &rkv.kv); err != nil {
 			if lg != nil {
 				lg.Fatal("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 			} else {
@@ -494,6 +641,9 @@ func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, k
 			}
 		}
 		rkv.kstr = string(rkv.kv.Key)
+		if ri != nil && !isTombstone(key) {
+			ri.Put(rkv.kv.Key, bytesToRev(key))
+		}
 		if isTombstone(key) {
 			delete(keyToLease, rkv.kstr)
 		} else if lid := lease.LeaseID(rkv.kv.Lease); lid != lease.NoLease {
@@ -505,6 +655,8 @@ func restoreChunk(lg *zap.Logger, kvc chan<- revKeyValue, keys, vals [][]byte, k
 	}
 }
 
+func (s *store) ValueCompressionRatio() float64 { return s.compressor.ratio() }
+
 func (s *store) Close() error {
 	close(s.stopc)
 	s.fifoSched.Stop()
@@ -528,16 +680,26 @@ func (s *store) ConsistentIndex() uint64 {
 	if ci := atomic.LoadUint64(&s.consistentIndex); ci > 0 {
 		return ci
 	}
-	tx := s.b.BatchTx()
+	v := ReadConsistentIndex(s.b)
+	if v > 0 {
+		atomic.StoreUint64(&s.consistentIndex, v)
+	}
+	return v
+}
+
+// ReadConsistentIndex returns the consistent index saved in the backend,
+// without restoring a store from it, or 0 if none is saved.
+func ReadConsistentIndex(b backend.Backend) uint64 {
+	tx := b.BatchTx()
 	tx.Lock()
 	defer tx.Unlock()
+	// a fresh backend has no buckets yet
+	tx.UnsafeCreateBucket(metaBucketName)
 	_, vs := tx.UnsafeRange(metaBucketName, consistentIndexKeyName, nil, 0)
 	if len(vs) == 0 {
 		return 0
 	}
-	v := binary.BigEndian.Uint64(vs[0])
-	atomic.StoreUint64(&s.consistentIndex, v)
-	return v
+	return binary.BigEndian.Uint64(vs[0])
 }
 
 // appendMarkTombstone appends tombstone mark to normal revision bytes.
@@ -15,8 +15,11 @@
 package mvcc
 
 import (
+	"sort"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"go.etcd.io/etcd/lease"
 	"go.etcd.io/etcd/mvcc/backend"
@@ -78,6 +81,40 @@ func benchmarkStoreRange(b *testing.B, n int) {
 	}
 }
 
+func BenchmarkStoreRangeMinModRev(b *testing.B) { benchmarkStoreRangeMinModRev(b, 10000, false) }
+func BenchmarkStoreRangeMinModRevIndexed(b *testing.B) {
+	benchmarkStoreRangeMinModRev(b, 10000, true)
+}
+
+// BenchmarkStoreRangeMinModRevIndexed100000 ranges over 10 times as many
+// keys as BenchmarkStoreRangeMinModRevIndexed, which takes about as long, since
+// neither the backend nor the key index is walked over the whole range.
+func BenchmarkStoreRangeMinModRevIndexed100000(b *testing.B) {
+	benchmarkStoreRangeMinModRev(b, 100000, true)
+}
+
+// benchmarkStoreRangeMinModRev ranges over the 100 keys modified last out
+// of n, counting only those keys.
+func benchmarkStoreRangeMinModRev(b *testing.B, n int, indexed bool) {
+	var i fakeConsistentIndex
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStoreWithConfig(zap.NewExample(), be, &lease.FakeLessor{}, &i, StoreConfig{RevisionIndex: indexed})
+	defer cleanup(s, be, tmpPath)
+
+	keys, val := createBytesSlice(64, n), createBytesSlice(64, 1)
+	for i := range keys {
+		s.Put(keys[i], val[0], lease.NoLease)
+	}
+	s.Commit()
+	ro := RangeOptions{MinModRev: s.Rev() - 99, CountFiltered: true}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Range([]byte{}, []byte{}, ro)
+	}
+}
+
 func BenchmarkConsistentIndex(b *testing.B) {
 	fci := fakeConsistentIndex(10)
 	be, tmpPath := backend.NewDefaultTmpBackend()
@@ -136,6 +173,100 @@ func BenchmarkStoreTxnPut(b *testing.B) {
 	}
 }
 
+// BenchmarkStoreMixedLoadSharedReadTx and BenchmarkStoreMixedLoadConcurrentReadTx
+// measure the latency of puts and of single key ranges, while other read txns
+// keep ranging over many keys. Their p99 metrics compare read txns holding the
+// backend read tx for their whole lifetime, as they used to, with concurrent
+// read txns.
+func BenchmarkStoreMixedLoadSharedReadTx(b *testing.B) {
+	benchmarkStoreMixedLoad(b, sharedRead)
+}
+func BenchmarkStoreMixedLoadConcurrentReadTx(b *testing.B) {
+	benchmarkStoreMixedLoad(b, func(s *store) TxnRead { return s.Read() })
+}
+
+// sharedRead opens a read txn on the backend read tx shared with the batch
+// tx, which it holds locked until it ends.
+func sharedRead(s *store) TxnRead {
+	s.mu.RLock()
+	s.revMu.RLock()
+	tx := s.b.ReadTx()
+	tx.Lock()
+	firstRev, rev := s.compactMainRev, s.currentRev
+	s.revMu.RUnlock()
+	return &storeTxnRead{s, tx, firstRev, rev}
+}
+
+func benchmarkStoreMixedLoad(b *testing.B, read func(*store) TxnRead) {
+	var i fakeConsistentIndex
+	be, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), be, &lease.FakeLessor{}, &i)
+	defer cleanup(s, be, tmpPath)
+
+	// 64 byte key/val
+	keys, val := createBytesSlice(64, 1000), createBytesSlice(64, 1)
+	for i := range keys {
+		s.Put(keys[i], val[0], lease.NoLease)
+	}
+	s.Commit()
+
+	// long read txns over all the keys; the sleep stands in for the time
+	// large ranges spend off the CPU, such as faulting in database pages
+	stopc := make(chan struct{})
+	var wg, started sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; ; n++ {
+				select {
+				case <-stopc:
+					return
+				default:
+				}
+				txn := read(s)
+				txn.Range([]byte{}, []byte{}, RangeOptions{})
+				if n == 0 {
+					started.Done()
+				}
+				time.Sleep(time.Millisecond)
+				txn.End()
+			}
+		}()
+	}
+	started.Wait()
+
+	puts := make([]time.Duration, 0, b.N)
+	ranges := make([]time.Duration, 0, b.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i%len(keys)]
+
+		start := time.Now()
+		txn := s.Write()
+		txn.Put(k, val[0], lease.NoLease)
+		txn.End()
+		puts = append(puts, time.Since(start))
+
+		start = time.Now()
+		rtxn := read(s)
+		rtxn.Range(k, nil, RangeOptions{})
+		rtxn.End()
+		ranges = append(ranges, time.Since(start))
+	}
+	b.StopTimer()
+	close(stopc)
+	wg.Wait()
+
+	b.Logf("p99 put latency %v, p99 range latency %v", p99(puts), p99(ranges))
+}
+
+func p99(ds []time.Duration) time.Duration {
+	sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+	return ds[len(ds)*99/100]
+}
+
 // benchmarkStoreRestore benchmarks the restore operation
 func benchmarkStoreRestore(revsPerKey int, b *testing.B) {
 	var i fakeConsistentIndex
@@ -21,6 +21,83 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// defaultCompactionBatchLimit is the number of revisions a compaction
+	// visits per batch, unless configured otherwise.
+	defaultCompactionBatchLimit = 10000
+	// defaultCompactionBatchInterval is the wait between the batches of a
+	// compaction that is not rate limited.
+	defaultCompactionBatchInterval = 100 * time.Millisecond
+)
+
+// compactionController paces the batches of a compaction, so that it does
+// not hold the backend for long nor delete faster than the configured rates.
+type compactionController struct {
+	batchLimit     int64
+	maxPause       time.Duration
+	keysPerSecond  int64
+	bytesPerSecond int64
+}
+
+func newCompactionController(cfg StoreConfig) compactionController {
+	c := compactionController{
+		batchLimit:     int64(cfg.CompactionBatchLimit),
+		maxPause:       cfg.CompactionMaxPause,
+		keysPerSecond:  cfg.CompactionKeysPerSecond,
+		bytesPerSecond: cfg.CompactionBytesPerSecond,
+	}
+	if c.batchLimit <= 0 {
+		c.batchLimit = defaultCompactionBatchLimit
+	}
+	return c
+}
+
+// paused returns true if a batch that started at start has held the backend
+// long enough to end early.
+func (c compactionController) paused(start time.Time) bool {
+	return c.maxPause > 0 && time.Since(start) >= c.maxPause
+}
+
+// delay returns how long to wait after a batch that took the given duration
+// to delete the given number of revisions and bytes, to keep the compaction
+// under the configured rates.
+func (c compactionController) delay(keys, bytes int64, took time.Duration) time.Duration {
+	if c.keysPerSecond <= 0 && c.bytesPerSecond <= 0 {
+		return defaultCompactionBatchInterval
+	}
+	var d time.Duration
+	if c.keysPerSecond > 0 {
+		d = time.Duration(float64(keys) / float64(c.keysPerSecond) * float64(time.Second))
+	}
+	if c.bytesPerSecond > 0 {
+		if bd := time.Duration(float64(bytes) / float64(c.bytesPerSecond) * float64(time.Second)); bd > d {
+			d = bd
+		}
+	}
+	if d -= took; d < 0 {
+		d = 0
+	}
+	return d
+}
+
+// CompactionStatus implements KV.
+func (s *store) CompactionStatus() (rev, compactedUpTo int64) {
+	s.compactionMu.Lock()
+	defer s.compactionMu.Unlock()
+	return s.compactingRev, s.compactedUpToRev
+}
+
+// setCompactionStatus records that the compaction to rev has compacted the
+// backend up to compactedUpTo, which is the given ratio of the revisions up
+// to rev.
+func (s *store) setCompactionStatus(rev, compactedUpTo int64, progress float64) {
+	s.compactionMu.Lock()
+	s.compactingRev, s.compactedUpToRev = rev, compactedUpTo
+	s.compactionMu.Unlock()
+	dbCompactionRevision.Set(float64(rev))
+	dbCompactionProgress.Set(progress)
+}
+
 func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struct{}) bool {
 	totalStart := time.Now()
 	defer dbCompactionTotalMs.Observe(float64(time.Since(totalStart) / time.Millisecond))
@@ -30,29 +107,62 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 	end := make([]byte, 8)
 	binary.BigEndian.PutUint64(end, uint64(compactMainRev+1))
 
-	batchsize := int64(10000)
+	c := newCompactionController(s.cfg)
 	last := make([]byte, 8+1+8)
+
+	// the progress is counted from the oldest revision in the backend
+	first := int64(0)
+	s.setCompactionStatus(compactMainRev, 0, 0)
+	defer s.setCompactionStatus(0, 0, 0)
+
 	for {
-		var rev revision
+		var (
+			rev     revision
+			deleted int64
+			bytes   int64
+			done    bool
+		)
 
 		start := time.Now()
 		tx := s.b.BatchTx()
 		tx.Lock()
 
-		keys, _ := tx.UnsafeRange(keyBucketName, last, end, batchsize)
-		for _, key := range keys {
+		keys, vals := tx.UnsafeRange(keyBucketName, last, end, c.batchLimit)
+		if first == 0 && len(keys) != 0 {
+			first = bytesToRev(keys[0]).main
+		}
+		visited := len(keys)
+		for i, key := range keys {
 			rev = bytesToRev(key)
 			if _, ok := keep[rev]; !ok {
 				tx.UnsafeDelete(keyBucketName, key)
 				keyCompactions++
+				deleted++
+				bytes += int64(len(key))
+				if i < len(vals) {
+					bytes += int64(len(vals[i]))
+				}
+			}
+			if i+1 < len(keys) && c.paused(start) {
+				visited = i + 1
+				break
 			}
 		}
 
-		if len(keys) < int(batchsize) {
+		if len(keys) < int(c.batchLimit) && visited == len(keys) {
 			rbytes := make([]byte, 8+1+8)
 			revToBytes(revision{main: compactMainRev}, rbytes)
 			tx.UnsafePut(metaBucketName, finishedCompactKeyName, rbytes)
-			tx.Unlock()
+			done = true
+		} else {
+			// update last
+			revToBytes(revision{main: rev.main, sub: rev.sub + 1}, last)
+		}
+		tx.Unlock()
+		took := time.Since(start)
+		dbCompactionPauseMs.Observe(float64(took / time.Millisecond))
+
+		if done {
 			if s.lg != nil {
 				s.lg.Info(
 					"finished scheduled compaction",
@@ -64,14 +174,10 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 			}
 			return true
 		}
-
-		// update last
-		revToBytes(revision{main: rev.main, sub: rev.sub + 1}, last)
-		tx.Unlock()
-		dbCompactionPauseMs.Observe(float64(time.Since(start) / time.Millisecond))
+		s.setCompactionStatus(compactMainRev, rev.main, float64(rev.main-first+1)/float64(compactMainRev-first+1))
 
 		select {
-		case <-time.After(100 * time.Millisecond):
+		case <-time.After(c.delay(deleted, bytes, took)):
 		case <-s.stopc:
 			return false
 		}
@@ -97,6 +97,78 @@ func TestScheduleCompaction(t *testing.T) {
 	}
 }
 
+// TestScheduleCompactionMaxPause ensures batches ended early by the maximum
+// pause resume where they stopped, and the status follows the compaction.
+func TestScheduleCompactionMaxPause(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStoreWithConfig(zap.NewExample(), b, &lease.FakeLessor{}, nil, StoreConfig{CompactionMaxPause: time.Nanosecond})
+	defer cleanup(s, b, tmpPath)
+
+	tx := s.b.BatchTx()
+	tx.Lock()
+	ibytes := newRevBytes()
+	for i := int64(1); i <= 4; i++ {
+		revToBytes(revision{main: i}, ibytes)
+		tx.UnsafePut(keyBucketName, ibytes, []byte("bar"))
+	}
+	tx.Unlock()
+
+	donec := make(chan struct{})
+	go func() {
+		s.scheduleCompaction(3, map[revision]struct{}{{main: 3}: {}})
+		close(donec)
+	}()
+	// every batch visits a single revision and waits 100ms
+	for {
+		rev, upTo := s.CompactionStatus()
+		if rev == 3 && upTo >= 1 && upTo < 3 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	<-donec
+	if rev, upTo := s.CompactionStatus(); rev != 0 || upTo != 0 {
+		t.Errorf("status = (%d, %d), want (0, 0)", rev, upTo)
+	}
+
+	tx.Lock()
+	defer tx.Unlock()
+	keys, _ := tx.UnsafeRange(keyBucketName, newRevBytes(), ibytes, 0)
+	var revs []revision
+	for _, k := range keys {
+		revs = append(revs, bytesToRev(k))
+	}
+	if w := []revision{{main: 3}}; !reflect.DeepEqual(revs, w) {
+		t.Errorf("revisions = %v, want %v", revs, w)
+	}
+}
+
+func TestCompactionControllerDelay(t *testing.T) {
+	tests := []struct {
+		c     compactionController
+		keys  int64
+		bytes int64
+		took  time.Duration
+
+		w time.Duration
+	}{
+		// no rates waits the default interval
+		{compactionController{}, 1000, 1000, time.Second, defaultCompactionBatchInterval},
+		{compactionController{keysPerSecond: 100}, 50, 1000, 0, 500 * time.Millisecond},
+		{compactionController{bytesPerSecond: 100}, 50, 1000, 0, 10 * time.Second},
+		// the slower rate wins
+		{compactionController{keysPerSecond: 100, bytesPerSecond: 1000}, 50, 1000, 0, time.Second},
+		// the time the batch took counts
+		{compactionController{keysPerSecond: 100}, 50, 1000, 200 * time.Millisecond, 300 * time.Millisecond},
+		{compactionController{keysPerSecond: 100}, 50, 1000, time.Second, 0},
+	}
+	for i, tt := range tests {
+		if d := tt.c.delay(tt.keys, tt.bytes, tt.took); d != tt.w {
+			t.Errorf("#%d: delay = %v, want %v", i, d, tt.w)
+		}
+	}
+}
+
 func TestCompactAllAndRestore(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
 	s0 := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
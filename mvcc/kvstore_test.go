@@ -465,6 +465,112 @@ func TestRestoreDelete(t *testing.T) {
 	}
 }
 
+// TestStoreRangeMinRevisions ensures ranges with minimum modification and
+// creation revisions return the same keys with and without the revision
+// index, also after compaction and restore, and that the index counts only
+// the keys passing the minimums.
+func TestStoreRangeMinRevisions(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	ib, itmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(itmpPath)
+
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	is := NewStoreWithConfig(zap.NewExample(), ib, &lease.FakeLessor{}, nil, StoreConfig{RevisionIndex: true})
+	defer func() {
+		s.Close()
+		is.Close()
+	}()
+
+	write := func() {
+		for i := 0; i < 50; i++ {
+			k := []byte(fmt.Sprintf("foo-%d", mrand.Intn(20)))
+			if mrand.Intn(4) == 0 {
+				s.DeleteRange(k, nil)
+				is.DeleteRange(k, nil)
+				continue
+			}
+			s.Put(k, []byte("bar"), lease.NoLease)
+			is.Put(k, []byte("bar"), lease.NoLease)
+		}
+		// outside of the ranged prefix
+		s.Put([]byte("zoo"), []byte("bar"), lease.NoLease)
+		is.Put([]byte("zoo"), []byte("bar"), lease.NoLease)
+	}
+	check := func() {
+		rev := s.Rev()
+		for _, ro := range []RangeOptions{
+			{MinModRev: rev - 20},
+			{MinModRev: rev - 20, Limit: 3},
+			{MinModRev: rev - 20, Rev: rev - 10},
+			{MinCreateRev: rev - 40},
+			{MinModRev: rev - 30, MinCreateRev: rev - 60},
+			{MinModRev: 1},
+		} {
+			all, err := s.Range([]byte("foo-"), []byte("foo."), RangeOptions{Rev: ro.Rev})
+			if err != nil {
+				t.Fatal(err)
+			}
+			n := 0
+			for _, kv := range all.KVs {
+				if kv.ModRevision >= ro.MinModRev && kv.CreateRevision >= ro.MinCreateRev {
+					n++
+				}
+			}
+
+			for _, cro := range []RangeOptions{ro, {Rev: ro.Rev, MinModRev: ro.MinModRev, MinCreateRev: ro.MinCreateRev, Count: true}} {
+				for _, filtered := range []bool{false, true} {
+					cro.CountFiltered = filtered
+					r, err := s.Range([]byte("foo-"), []byte("foo."), cro)
+					if err != nil {
+						t.Fatal(err)
+					}
+					ir, err := is.Range([]byte("foo-"), []byte("foo."), cro)
+					if err != nil {
+						t.Fatal(err)
+					}
+					// the count must not depend on the revision index
+					if !reflect.DeepEqual(r, ir) {
+						t.Errorf("range %+v with index = %+v, want %+v", cro, ir, r)
+					}
+					wcount := len(all.KVs)
+					if filtered {
+						wcount = n
+					}
+					if r.Count != wcount {
+						t.Errorf("range %+v count = %d, want %d", cro, r.Count, wcount)
+					}
+					for _, kv := range r.KVs {
+						if kv.ModRevision < ro.MinModRev || kv.CreateRevision < ro.MinCreateRev {
+							t.Errorf("range %+v returned %+v", cro, kv)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	write()
+	check()
+
+	write()
+	rev := s.Rev() - 30
+	_, err := s.Compact(rev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = is.Compact(rev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write()
+	check()
+
+	is.Close()
+	is = NewStoreWithConfig(zap.NewExample(), ib, &lease.FakeLessor{}, nil, StoreConfig{RevisionIndex: true})
+	check()
+}
+
 func TestRestoreContinueUnfinishedCompaction(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
 	s0 := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
@@ -614,6 +720,20 @@ func TestHashKVZeroRevision(t *testing.T) {
 	}
 }
 
+// TestReadConsistentIndexFreshBackend ensures reading the consistent index of
+// a backend no store has used yet returns 0.
+func TestReadConsistentIndexFreshBackend(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer func() {
+		b.Close()
+		os.Remove(tmpPath)
+	}()
+
+	if ci := ReadConsistentIndex(b); ci != 0 {
+		t.Errorf("consistent index = %d, want 0", ci)
+	}
+}
+
 func TestTxnPut(t *testing.T) {
 	// assign arbitrary size
 	bytesN := 30
@@ -635,29 +755,48 @@ func TestTxnPut(t *testing.T) {
 	}
 }
 
-func TestTxnBlockBackendForceCommit(t *testing.T) {
+// TestConcurrentReadNotBlockingCommit ensures a read txn neither blocks the
+// backend from committing nor sees the writes committed after it began.
+func TestConcurrentReadNotBlockingCommit(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
 	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
 	defer os.Remove(tmpPath)
 
-	txn := s.Read()
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	// a long read txn
+	txn1 := s.Read()
 
 	done := make(chan struct{})
 	go func() {
+		s.Put([]byte("foo"), []byte("newbar"), lease.NoLease)
 		s.b.ForceCommit()
-		done <- struct{}{}
+		close(done)
 	}()
 	select {
 	case <-done:
-		t.Fatalf("failed to block ForceCommit")
-	case <-time.After(100 * time.Millisecond):
+	case <-time.After(5 * time.Second): // wait 5 seconds for CI with slow IO
+		testutil.FatalStack(t, "failed to commit during a read txn")
 	}
 
-	txn.End()
-	select {
-	case <-done:
-	case <-time.After(5 * time.Second): // wait 5 seconds for CI with slow IO
-		testutil.FatalStack(t, "failed to execute ForceCommit")
+	ro := RangeOptions{Limit: 1}
+	txn2 := s.Read()
+	r, err := txn2.Range([]byte("foo"), nil, ro)
+	txn2.End()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.KVs) != 1 || string(r.KVs[0].Value) != "newbar" {
+		t.Fatalf("kvs = %+v, want foo=newbar", r.KVs)
+	}
+
+	r, err = txn1.Range([]byte("foo"), nil, ro)
+	txn1.End()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.KVs) != 1 || string(r.KVs[0].Value) != "bar" {
+		t.Fatalf("kvs = %+v, want foo=bar", r.KVs)
 	}
 }
 
@@ -742,12 +881,14 @@ type fakeBackend struct {
 
 func (b *fakeBackend) BatchTx() backend.BatchTx                                    { return b.tx }
 func (b *fakeBackend) ReadTx() backend.ReadTx                                      { return b.tx }
+func (b *fakeBackend) ConcurrentReadTx() backend.ReadTx                            { return b.tx }
 func (b *fakeBackend) Hash(ignores map[backend.IgnoreKey]struct{}) (uint32, error) { return 0, nil }
 func (b *fakeBackend) Size() int64                                                 { return 0 }
 func (b *fakeBackend) SizeInUse() int64                                            { return 0 }
 func (b *fakeBackend) Snapshot() backend.Snapshot                                  { return nil }
 func (b *fakeBackend) ForceCommit()                                                {}
 func (b *fakeBackend) Defrag() error                                               { return nil }
+func (b *fakeBackend) DefragOnline(func(backend.DefragProgress)) error             { return nil }
 func (b *fakeBackend) Close() error                                                { return nil }
 
 type indexGetResp struct {
@@ -779,6 +920,10 @@ func (i *fakeIndex) Revisions(key, end []byte, atRev int64) []revision {
 	return rev
 }
 
+func (i *fakeIndex) CountRevisions(key, end []byte, atRev int64) int {
+	return len(i.Revisions(key, end, atRev))
+}
+
 func (i *fakeIndex) Get(key []byte, atRev int64) (rev, created revision, ver int64, err error) {
 	i.Recorder.Record(testutil.Action{Name: "get", Params: []interface{}{key, atRev}})
 	r := <-i.indexGetRespc
@@ -801,11 +946,11 @@ func (i *fakeIndex) RangeSince(key, end []byte, rev int64) []revision {
 	r := <-i.indexRangeEventsRespc
 	return r.revs
 }
-func (i *fakeIndex) Compact(rev int64) map[revision]struct{} {
+func (i *fakeIndex) Compact(rev int64, r retention) map[revision]struct{} {
 	i.Recorder.Record(testutil.Action{Name: "compact", Params: []interface{}{rev}})
 	return <-i.indexCompactRespc
 }
-func (i *fakeIndex) Keep(rev int64) map[revision]struct{} {
+func (i *fakeIndex) Keep(rev int64, r retention) map[revision]struct{} {
 	i.Recorder.Record(testutil.Action{Name: "keep", Params: []interface{}{rev}})
 	return <-i.indexCompactRespc
 }
@@ -820,6 +965,11 @@ func (i *fakeIndex) KeyIndex(ki *keyIndex) *keyIndex {
 	return nil
 }
 
+func (i *fakeIndex) VisitAt(rev int64, f func(ki *keyIndex) error) error {
+	i.Recorder.Record(testutil.Action{Name: "visitAt", Params: []interface{}{rev}})
+	return nil
+}
+
 func createBytesSlice(bytesN, sliceN int) [][]byte {
 	rs := [][]byte{}
 	for len(rs) != sliceN {
@@ -31,9 +31,12 @@ type storeTxnRead struct {
 
 func (s *store) Read() TxnRead {
 	s.mu.RLock()
-	tx := s.b.ReadTx()
 	s.revMu.RLock()
-	tx.Lock()
+	// the backend only blocks while creating the concurrent read tx, which
+	// then sees every write up to the current revision without blocking
+	// commits, however long the txn reads for
+	tx := s.b.ConcurrentReadTx()
+	tx.Lock() // no-op
 	firstRev, rev := s.compactMainRev, s.currentRev
 	s.revMu.RUnlock()
 	return newMetricsTxnRead(&storeTxnRead{s, tx, firstRev, rev})
@@ -117,16 +120,16 @@ func (tr *storeTxnRead) rangeKeys(key, end []byte, curRev int64, ro RangeOptions
 	if rev <= 0 {
 		rev = curRev
 	}
-	if rev < tr.s.compactMainRev {
+	if rev < tr.s.compactMainRev && rev < tr.s.retention.rangeCompactRev(key, end, tr.s.compactMainRev) {
 		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
 	}
 
-	revpairs := tr.s.kvindex.Revisions(key, end, rev)
-	if len(revpairs) == 0 {
-		return &RangeResult{KVs: nil, Count: 0, Rev: curRev}, nil
+	if ro.Count && !ro.CountFiltered {
+		return &RangeResult{KVs: nil, Count: tr.s.kvindex.CountRevisions(key, end, rev), Rev: curRev}, nil
 	}
-	if ro.Count {
-		return &RangeResult{KVs: nil, Count: len(revpairs), Rev: curRev}, nil
+	revpairs, total := tr.revisions(key, end, rev, ro)
+	if len(revpairs) == 0 || ro.Count {
+		return &RangeResult{KVs: nil, Count: total, Rev: curRev}, nil
 	}
 
 	limit := int(ro.Limit)
@@ -134,9 +137,12 @@ func (tr *storeTxnRead) rangeKeys(key, end []byte, curRev int64, ro RangeOptions
 		limit = len(revpairs)
 	}
 
-	kvs := make([]mvccpb.KeyValue, limit)
+	kvs := make([]mvccpb.KeyValue, 0, limit)
 	revBytes := newRevBytes()
-	for i, revpair := range revpairs[:len(kvs)] {
+	for _, revpair := range revpairs {
+		if len(kvs) == limit {
+			break
+		}
 		revToBytes(revpair, revBytes)
 		_, vs := tr.tx.UnsafeRange(keyBucketName, revBytes, nil, 0)
 		if len(vs) != 1 {
@@ -150,7 +156,9 @@ func (tr *storeTxnRead) rangeKeys(key, end []byte, curRev int64, ro RangeOptions
 				plog.Fatalf("range cannot find rev (%d,%d)", revpair.main, revpair.sub)
 			}
 		}
-		if err := kvs[i].Unmarshal(vs[0]); err != nil {
+		kvs = append(kvs, mvccpb.KeyValue{})
+		kv := &kvs[len(kvs)-1]
+		if err := UnmarshalKeyValue(vs[0], kv); err != nil {
 			if tr.s.lg != nil {
 				tr.s.lg.Fatal(
 					"failed to unmarshal mvccpb.KeyValue",
@@ -160,8 +168,73 @@ func (tr *storeTxnRead) rangeKeys(key, end []byte, curRev int64, ro RangeOptions
 				plog.Fatalf("cannot unmarshal event: %v", err)
 			}
 		}
+		if kv.CreateRevision < ro.MinCreateRev {
+			kvs = kvs[:len(kvs)-1]
+		}
+	}
+	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
+}
+
+// revisions returns the revisions at rev of the keys in the range that were
+// modified since ro.MinModRev, and the number of all keys in the range. If
+// ro.CountFiltered is set, the keys are also created since ro.MinCreateRev,
+// and the number is that of the returned keys instead, which the revision
+// index answers without walking the range.
+func (tr *storeTxnRead) revisions(key, end []byte, rev int64, ro RangeOptions) ([]revision, int) {
+	since := ro.MinModRev
+	if ro.MinCreateRev > since {
+		// a key created since a revision was also modified since then
+		since = ro.MinCreateRev
+	}
+	if since > 0 && end != nil && tr.s.revindex != nil {
+		if keys, ok := tr.s.revindex.Keys(key, end, since, rev); ok {
+			revpairs := make([]revision, 0, len(keys))
+			for _, k := range keys {
+				modified, created, _, err := tr.s.kvindex.Get(k, rev)
+				if err != nil || modified.main < ro.MinModRev || created.main < ro.MinCreateRev {
+					continue
+				}
+				revpairs = append(revpairs, modified)
+			}
+			if ro.CountFiltered {
+				return revpairs, len(revpairs)
+			}
+			return revpairs, tr.s.kvindex.CountRevisions(key, end, rev)
+		}
+	}
+
+	if ro.CountFiltered && ro.MinCreateRev > 0 {
+		keys, revpairs := tr.s.kvindex.Range(key, end, rev)
+		n := 0
+		for i, revpair := range revpairs {
+			if revpair.main < ro.MinModRev {
+				continue
+			}
+			if _, created, _, err := tr.s.kvindex.Get(keys[i], rev); err != nil || created.main < ro.MinCreateRev {
+				continue
+			}
+			revpairs[n] = revpair
+			n++
+		}
+		return revpairs[:n], n
+	}
+
+	revpairs := tr.s.kvindex.Revisions(key, end, rev)
+	total := len(revpairs)
+	if ro.MinModRev > 0 {
+		n := 0
+		for _, revpair := range revpairs {
+			if revpair.main >= ro.MinModRev {
+				revpairs[n] = revpair
+				n++
+			}
+		}
+		revpairs = revpairs[:n]
 	}
-	return &RangeResult{KVs: kvs, Count: len(revpairs), Rev: curRev}, nil
+	if ro.CountFiltered {
+		total = len(revpairs)
+	}
+	return revpairs, total
 }
 
 func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
@@ -191,7 +264,7 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 		Lease:          int64(leaseID),
 	}
 
-	d, err := kv.Marshal()
+	d, err := tw.s.compressor.marshal(kv)
 	if err != nil {
 		if tw.storeTxnRead.s.lg != nil {
 			tw.storeTxnRead.s.lg.Fatal(
@@ -205,6 +278,9 @@ func (tw *storeTxnWrite) put(key, value []byte, leaseID lease.LeaseID) {
 
 	tw.tx.UnsafeSeqPut(keyBucketName, ibytes, d)
 	tw.s.kvindex.Put(key, idxRev)
+	if tw.s.revindex != nil {
+		tw.s.revindex.Put(key, idxRev)
+	}
 	tw.changes = append(tw.changes, kv)
 
 	if oldLease != lease.NoLease {
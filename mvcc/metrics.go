@@ -145,6 +145,22 @@ var (
 			Help:      "Total number of db keys compacted.",
 		})
 
+	dbCompactionRevision = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "db_compaction_revision",
+			Help:      "The revision the running db compaction compacts to, or 0 if none is running.",
+		})
+
+	dbCompactionProgress = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "db_compaction_progress_ratio",
+			Help:      "The ratio of the revisions the running db compaction has visited.",
+		})
+
 	dbTotalSize = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
 		Namespace: "etcd",
 		Subsystem: "mvcc",
@@ -217,6 +233,60 @@ var (
 		// highest bucket start of 0.01 sec * 2^14 == 163.84 sec
 		Buckets: prometheus.ExponentialBuckets(.01, 2, 15),
 	})
+
+	indexRestoreSec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "index_restore_duration_seconds",
+		Help:      "The latency distribution of restoring the key index, by whether it was loaded from its checkpoint or scanned from the backend.",
+
+		// lowest bucket start of upper bound 0.01 sec (10 ms) with factor 2
+		// highest bucket start of 0.01 sec * 2^14 == 163.84 sec
+		Buckets: prometheus.ExponentialBuckets(.01, 2, 15),
+	}, []string{"source"})
+
+	indexCheckpointSec = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "index_checkpoint_duration_seconds",
+		Help:      "The latency distribution of checkpointing the key index.",
+
+		// lowest bucket start of upper bound 0.01 sec (10 ms) with factor 2
+		// highest bucket start of 0.01 sec * 2^14 == 163.84 sec
+		Buckets: prometheus.ExponentialBuckets(.01, 2, 15),
+	})
+
+	putValueBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "put_value_bytes_total",
+		Help:      "Total number of bytes of the values put, before compression.",
+	})
+	putValueStoredBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "put_value_stored_bytes_total",
+		Help:      "Total number of bytes of the values put, as stored after compression.",
+	})
+	valueCompressionRatio = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "value_compression_ratio",
+		Help:      "The ratio of the bytes stored to the bytes of the values put since the member started.",
+	})
+
+	watchCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "watch_cache_hits_total",
+		Help:      "Total number of unsynced watcher batches caught up from the watch event cache.",
+	})
+	watchCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "mvcc",
+		Name:      "watch_cache_misses_total",
+		Help:      "Total number of unsynced watcher batches caught up from the backend since the watch event cache did not hold their events.",
+	})
 )
 
 func init() {
@@ -234,11 +304,20 @@ func init() {
 	prometheus.MustRegister(dbCompactionPauseMs)
 	prometheus.MustRegister(dbCompactionTotalMs)
 	prometheus.MustRegister(dbCompactionKeysCounter)
+	prometheus.MustRegister(dbCompactionRevision)
+	prometheus.MustRegister(dbCompactionProgress)
 	prometheus.MustRegister(dbTotalSize)
 	prometheus.MustRegister(dbTotalSizeDebugging)
 	prometheus.MustRegister(dbTotalSizeInUse)
 	prometheus.MustRegister(hashSec)
 	prometheus.MustRegister(hashRevSec)
+	prometheus.MustRegister(indexRestoreSec)
+	prometheus.MustRegister(indexCheckpointSec)
+	prometheus.MustRegister(putValueBytes)
+	prometheus.MustRegister(putValueStoredBytes)
+	prometheus.MustRegister(valueCompressionRatio)
+	prometheus.MustRegister(watchCacheHits)
+	prometheus.MustRegister(watchCacheMisses)
 }
 
 // ReportEventReceived reports that an event is received.
@@ -0,0 +1,169 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+var errRetentionCorrupt = errors.New("mvcc: corrupted retention")
+
+// PrefixRetention retains the history of the keys under Prefix made after
+// Revision when compacting past it.
+type PrefixRetention struct {
+	Prefix   []byte
+	Revision int64
+}
+
+// retention holds the revisions the keys under each retained prefix are
+// compacted up to, sorted by prefix. The keys under no retained prefix are
+// compacted up to the compaction revision.
+type retention []PrefixRetention
+
+// next returns the retention of a compaction at rev following one at
+// compacted. The history a previous compaction dropped is not brought back,
+// so a prefix is never compacted to an older revision than before, and a
+// prefix that would be compacted up to rev is not retained at all.
+func (r retention) next(rev, compacted int64, retain []PrefixRetention) retention {
+	var n retention
+	for _, p := range retain {
+		at := r.prefixRev(p.Prefix, compacted)
+		if p.Revision > at {
+			at = p.Revision
+		}
+		if at >= rev {
+			continue
+		}
+		i := sort.Search(len(n), func(i int) bool { return bytes.Compare(n[i].Prefix, p.Prefix) >= 0 })
+		if i < len(n) && bytes.Equal(n[i].Prefix, p.Prefix) {
+			// keep the longest history asked of a prefix
+			if at < n[i].Revision {
+				n[i].Revision = at
+			}
+			continue
+		}
+		n = append(n, PrefixRetention{})
+		copy(n[i+1:], n[i:])
+		n[i] = PrefixRetention{Prefix: append([]byte(nil), p.Prefix...), Revision: at}
+	}
+	return n
+}
+
+// prefixRev returns the revision the given prefix was compacted up to, or
+// rev if it was not retained.
+func (r retention) prefixRev(prefix []byte, rev int64) int64 {
+	for _, p := range r {
+		if bytes.Equal(p.Prefix, prefix) {
+			return p.Revision
+		}
+	}
+	return rev
+}
+
+// compactRev returns the revision the given key is compacted up to by a
+// compaction at rev.
+func (r retention) compactRev(key []byte, rev int64) int64 {
+	for _, p := range r {
+		if p.Revision < rev && bytes.HasPrefix(key, p.Prefix) {
+			rev = p.Revision
+		}
+	}
+	return rev
+}
+
+// rangeCompactRev returns the oldest revision a range over [key, end) can
+// be served at after a compaction at rev, which is older than rev only if
+// the range lies within a retained prefix.
+func (r retention) rangeCompactRev(key, end []byte, rev int64) int64 {
+	for _, p := range r {
+		if p.Revision < rev && bytes.HasPrefix(key, p.Prefix) && withinPrefix(p.Prefix, end) {
+			rev = p.Revision
+		}
+	}
+	return rev
+}
+
+// withinPrefix returns whether a range ending at end, starting at a key
+// with the given prefix, only covers keys with the prefix.
+func withinPrefix(prefix, end []byte) bool {
+	pend := prefixEnd(prefix)
+	if pend == nil {
+		return true
+	}
+	switch {
+	case len(end) == 0:
+		// a single key
+		return true
+	case len(end) == 1 && end[0] == 0:
+		// every key from the start on
+		return false
+	}
+	return bytes.Compare(end, pend) <= 0
+}
+
+// prefixEnd returns the end of the range of keys with the given prefix, or
+// nil if the range has no end.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func (r retention) marshal() []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	data := append([]byte(nil), buf[:binary.PutUvarint(buf, uint64(len(r)))]...)
+	for _, p := range r {
+		data = append(data, buf[:binary.PutUvarint(buf, uint64(len(p.Prefix)))]...)
+		data = append(data, p.Prefix...)
+		data = append(data, buf[:binary.PutVarint(buf, p.Revision)]...)
+	}
+	return data
+}
+
+func unmarshalRetention(data []byte) (retention, error) {
+	n, l := binary.Uvarint(data)
+	if l <= 0 {
+		return nil, errRetentionCorrupt
+	}
+	data = data[l:]
+	var r retention
+	for i := uint64(0); i < n; i++ {
+		plen, l := binary.Uvarint(data)
+		if l <= 0 || uint64(len(data)-l) < plen {
+			return nil, errRetentionCorrupt
+		}
+		data = data[l:]
+		prefix := append([]byte(nil), data[:plen]...)
+		data = data[plen:]
+		rev, l := binary.Varint(data)
+		if l <= 0 {
+			return nil, errRetentionCorrupt
+		}
+		data = data[l:]
+		r = append(r, PrefixRetention{Prefix: prefix, Revision: rev})
+	}
+	if len(data) != 0 {
+		return nil, errRetentionCorrupt
+	}
+	return r, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"go.etcd.io/etcd/lease"
+	"go.etcd.io/etcd/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+func TestRetentionNext(t *testing.T) {
+	tests := []struct {
+		r         retention
+		rev       int64
+		compacted int64
+		retain    []PrefixRetention
+
+		w retention
+	}{
+		{nil, 20, 10, nil, nil},
+		{nil, 20, 10, []PrefixRetention{{[]byte("a"), 15}}, retention{{[]byte("a"), 15}}},
+		// the history of the previous compaction is gone
+		{nil, 20, 10, []PrefixRetention{{[]byte("a"), 5}}, retention{{[]byte("a"), 10}}},
+		{retention{{[]byte("a"), 5}}, 20, 10, []PrefixRetention{{[]byte("a"), 3}}, retention{{[]byte("a"), 5}}},
+		// not retained past the compaction
+		{retention{{[]byte("a"), 5}}, 20, 10, []PrefixRetention{{[]byte("a"), 25}}, nil},
+		{retention{{[]byte("a"), 5}}, 20, 10, []PrefixRetention{{[]byte("b"), 12}}, retention{{[]byte("b"), 12}}},
+		{
+			nil, 20, 10,
+			[]PrefixRetention{{[]byte("b"), 12}, {[]byte("a"), 15}, {[]byte("a"), 13}},
+			retention{{[]byte("a"), 13}, {[]byte("b"), 12}},
+		},
+	}
+	for i, tt := range tests {
+		if g := tt.r.next(tt.rev, tt.compacted, tt.retain); !reflect.DeepEqual(g, tt.w) {
+			t.Errorf("#%d: next = %v, want %v", i, g, tt.w)
+		}
+	}
+}
+
+func TestRetentionRangeCompactRev(t *testing.T) {
+	r := retention{{[]byte("a/"), 5}, {[]byte("a/b/"), 3}}
+	tests := []struct {
+		key, end []byte
+
+		w int64
+	}{
+		{[]byte("a/x"), nil, 5},
+		{[]byte("a/"), []byte("a0"), 5},
+		{[]byte("a/b/"), []byte("a/b0"), 3},
+		{[]byte("a/b/"), []byte("a/c"), 5},
+		{[]byte("a/"), []byte("b"), 10},
+		{[]byte("a/"), []byte{0}, 10},
+		{[]byte("b"), nil, 10},
+	}
+	for i, tt := range tests {
+		if g := r.rangeCompactRev(tt.key, tt.end, 10); g != tt.w {
+			t.Errorf("#%d: rangeCompactRev(%q, %q) = %d, want %d", i, tt.key, tt.end, g, tt.w)
+		}
+	}
+	if g := r.compactRev([]byte("a/b/c"), 10); g != 3 {
+		t.Errorf("compactRev = %d, want 3", g)
+	}
+
+	data := r.marshal()
+	g, err := unmarshalRetention(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(g, r) {
+		t.Errorf("unmarshal = %v, want %v", g, r)
+	}
+	if _, err = unmarshalRetention(data[:len(data)-1]); err != errRetentionCorrupt {
+		t.Errorf("err = %v, want %v", err, errRetentionCorrupt)
+	}
+}
+
+// TestStoreCompactRetaining ensures the history of a retained prefix can be
+// ranged over past a compaction, also after restoring the store, while the
+// other keys are compacted.
+func TestStoreCompactRetaining(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer s.Close()
+	putRetentionTestKeys(s)
+
+	done, err := s.CompactRetaining(15, []PrefixRetention{{[]byte("audit/"), 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	s.Commit()
+
+	s2 := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer s2.Close()
+	for _, st := range []*store{s, s2} {
+		r, err := st.Range([]byte("audit/x"), nil, RangeOptions{Rev: 6})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.KVs) != 1 || string(r.KVs[0].Value) != "2" {
+			t.Fatalf("audit/x at 6 = %+v, want value 2", r.KVs)
+		}
+		if _, err = st.Range([]byte("audit/"), []byte("audit0"), RangeOptions{Rev: 5}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = st.Range([]byte("audit/"), []byte("audit0"), RangeOptions{Rev: 4}); err != ErrCompacted {
+			t.Fatalf("err = %v, want %v", err, ErrCompacted)
+		}
+		if _, err = st.Range([]byte("foo"), nil, RangeOptions{Rev: 6}); err != ErrCompacted {
+			t.Fatalf("err = %v, want %v", err, ErrCompacted)
+		}
+		if _, err = st.Range([]byte("a"), []byte("z"), RangeOptions{Rev: 6}); err != ErrCompacted {
+			t.Fatalf("err = %v, want %v", err, ErrCompacted)
+		}
+	}
+	testStoresEqual(t, s, s2)
+	if !reflect.DeepEqual(s2.retention, s.retention) {
+		t.Errorf("restored retention = %v, want %v", s2.retention, s.retention)
+	}
+}
+
+// TestHashKVRetaining ensures the hash covers the retained history and does
+// not change while the backend is being compacted.
+func TestHashKVRetaining(t *testing.T) {
+	retain := []PrefixRetention{{[]byte("audit/"), 5}}
+	newStore := func() *store {
+		b, tmpPath := backend.NewDefaultTmpBackend()
+		defer os.Remove(tmpPath)
+		s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+		putRetentionTestKeys(s)
+		return s
+	}
+
+	s := newStore()
+	defer s.Close()
+	done, err := s.CompactRetaining(15, retain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	hash, _, _, err := s.HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// hold the backend compaction back
+	s2 := newStore()
+	defer s2.Close()
+	blockc := make(chan struct{})
+	s2.fifoSched.Schedule(func(context.Context) { <-blockc })
+	done, err = s2.CompactRetaining(15, retain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, _, _, err := s2.HashByRev(0); err != nil || h != hash {
+		t.Fatalf("hash while compacting = (%d, %v), want %d", h, err, hash)
+	}
+	close(blockc)
+	<-done
+	if h, _, _, err := s2.HashByRev(0); err != nil || h != hash {
+		t.Fatalf("hash after compacting = (%d, %v), want %d", h, err, hash)
+	}
+
+	s3 := newStore()
+	defer s3.Close()
+	if done, err = s3.Compact(15); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if h, _, _, _ := s3.HashByRev(0); h == hash {
+		t.Fatal("hash without retained history matches hash with it")
+	}
+}
+
+// putRetentionTestKeys puts alternately to audit/x and foo, from revision 2
+// to 21, with values counting from 0 for each key.
+func putRetentionTestKeys(s *store) {
+	for i := 0; i < 10; i++ {
+		s.Put([]byte("audit/x"), []byte(fmt.Sprint(i)), lease.NoLease)
+		s.Put([]byte("foo"), []byte(fmt.Sprint(i)), lease.NoLease)
+	}
+}
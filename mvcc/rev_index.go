@@ -0,0 +1,103 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/google/btree"
+)
+
+// revIndex orders the puts of keys by their revisions. It answers which keys
+// were modified since a revision by visiting only the puts made since then,
+// instead of every key in the range.
+type revIndex struct {
+	mu   sync.RWMutex
+	tree *btree.BTree
+	// floor is the revision since which all puts are indexed. Puts before
+	// it are dropped by compaction.
+	floor int64
+}
+
+type revIndexItem struct {
+	rev revision
+	key []byte
+}
+
+func (a *revIndexItem) Less(b btree.Item) bool {
+	return b.(*revIndexItem).rev.GreaterThan(a.rev)
+}
+
+func newRevIndex(floor int64) *revIndex {
+	return &revIndex{tree: btree.New(32), floor: floor}
+}
+
+// Put indexes the put of key at rev, unless rev is before the floor.
+func (ri *revIndex) Put(key []byte, rev revision) {
+	ri.mu.Lock()
+	if rev.main >= ri.floor {
+		ri.tree.ReplaceOrInsert(&revIndexItem{rev: rev, key: key})
+	}
+	ri.mu.Unlock()
+}
+
+// Compact drops the puts before the given revision.
+func (ri *revIndex) Compact(rev int64) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+	if rev <= ri.floor {
+		return
+	}
+	ri.floor = rev
+	var stale []btree.Item
+	ri.tree.AscendLessThan(&revIndexItem{rev: revision{main: rev}}, func(item btree.Item) bool {
+		stale = append(stale, item)
+		return true
+	})
+	for _, item := range stale {
+		ri.tree.Delete(item)
+	}
+}
+
+// Keys returns the distinct keys in the range [key, end) put at revisions in
+// [since, atRev], sorted. An empty end means the range is unbounded. It
+// returns false, if puts since the given revision have been compacted away.
+func (ri *revIndex) Keys(key, end []byte, since, atRev int64) ([][]byte, bool) {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+	if since < ri.floor {
+		return nil, false
+	}
+
+	var keys [][]byte
+	seen := make(map[string]struct{})
+	from := &revIndexItem{rev: revision{main: since}}
+	to := &revIndexItem{rev: revision{main: atRev + 1}}
+	ri.tree.AscendRange(from, to, func(item btree.Item) bool {
+		k := item.(*revIndexItem).key
+		if bytes.Compare(k, key) < 0 || (len(end) > 0 && bytes.Compare(k, end) >= 0) {
+			return true
+		}
+		if _, ok := seen[string(k)]; !ok {
+			seen[string(k)] = struct{}{}
+			keys = append(keys, k)
+		}
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return keys, true
+}
@@ -0,0 +1,185 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"sync/atomic"
+
+	"go.etcd.io/etcd/mvcc/mvccpb"
+)
+
+const (
+	// ValueCompressionNone stores values verbatim.
+	ValueCompressionNone = ""
+	// ValueCompressionFlate compresses values with DEFLATE.
+	ValueCompressionFlate = "flate"
+)
+
+// A key-value pair is stored in the key bucket as its marshaled KeyValue.
+// A pair with a compressed value is stored as compressedKVMark, the
+// compression of its value, then its marshaled KeyValue holding the
+// compressed value. A marshaled KeyValue never starts with a zero byte, so
+// both kinds of pairs can be stored in the same backend.
+const (
+	compressedKVMark byte = 0
+
+	compressionFlate byte = 1
+)
+
+// minCompressValueBytes is the size of the smallest value compressed;
+// compressing smaller values rarely saves space.
+const minCompressValueBytes = 128
+
+// valueCompressor compresses the values of the pairs a store writes, and
+// counts the bytes it saves.
+type valueCompressor struct {
+	compression byte
+	writers     sync.Pool
+
+	// rawBytes and storedBytes count the bytes of the values put, before
+	// and after compression. Accessed through atomics.
+	rawBytes    int64
+	storedBytes int64
+}
+
+// newValueCompressor returns a compressor for the named compression, or
+// nil to store values verbatim.
+func newValueCompressor(name string) (*valueCompressor, error) {
+	switch name {
+	case ValueCompressionNone:
+		return nil, nil
+	case ValueCompressionFlate:
+		return &valueCompressor{compression: compressionFlate}, nil
+	default:
+		return nil, fmt.Errorf("mvcc: unknown value compression %q", name)
+	}
+}
+
+// marshal marshals the pair to store it, with its value compressed if that
+// saves space.
+func (c *valueCompressor) marshal(kv mvccpb.KeyValue) ([]byte, error) {
+	if c == nil {
+		return kv.Marshal()
+	}
+	raw := len(kv.Value)
+	if raw >= minCompressValueBytes {
+		if v := c.compress(kv.Value); len(v) < raw {
+			kv.Value = v
+			d := make([]byte, 2+kv.Size())
+			d[0], d[1] = compressedKVMark, c.compression
+			if _, err := kv.MarshalTo(d[2:]); err != nil {
+				return nil, err
+			}
+			c.record(raw, len(v))
+			return d, nil
+		}
+	}
+	c.record(raw, raw)
+	return kv.Marshal()
+}
+
+func (c *valueCompressor) record(raw, stored int) {
+	putValueBytes.Add(float64(raw))
+	putValueStoredBytes.Add(float64(stored))
+	r := atomic.AddInt64(&c.rawBytes, int64(raw))
+	st := atomic.AddInt64(&c.storedBytes, int64(stored))
+	if r > 0 {
+		valueCompressionRatio.Set(float64(st) / float64(r))
+	}
+}
+
+func (c *valueCompressor) compress(v []byte) []byte {
+	var buf bytes.Buffer
+	w, _ := c.writers.Get().(*flate.Writer)
+	if w == nil {
+		// BestSpeed still shrinks JSON and text several times over
+		w, _ = flate.NewWriter(&buf, flate.BestSpeed)
+	} else {
+		w.Reset(&buf)
+	}
+	w.Write(v)
+	w.Close()
+	c.writers.Put(w)
+	return buf.Bytes()
+}
+
+// ratio returns the bytes stored per byte of value put so far, or 1 if no
+// value was put.
+func (c *valueCompressor) ratio() float64 {
+	if c == nil {
+		return 1
+	}
+	raw := atomic.LoadInt64(&c.rawBytes)
+	if raw == 0 {
+		return 1
+	}
+	return float64(atomic.LoadInt64(&c.storedBytes)) / float64(raw)
+}
+
+var flateReaders sync.Pool
+
+// unmarshalKeyValue unmarshals a stored pair, leaving its value compressed.
+// It returns the compression of the value, or 0 if it is not compressed.
+func unmarshalKeyValue(data []byte, kv *mvccpb.KeyValue) (byte, error) {
+	if len(data) == 0 || data[0] != compressedKVMark {
+		return 0, kv.Unmarshal(data)
+	}
+	if len(data) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return data[1], kv.Unmarshal(data[2:])
+}
+
+// decompressValue decompresses the value of a pair unmarshaled with the
+// given compression.
+func decompressValue(kv *mvccpb.KeyValue, compression byte) error {
+	switch compression {
+	case 0:
+		return nil
+	case compressionFlate:
+		src := bytes.NewReader(kv.Value)
+		r, _ := flateReaders.Get().(io.ReadCloser)
+		if r == nil {
+			r = flate.NewReader(src)
+		} else if err := r.(flate.Resetter).Reset(src, nil); err != nil {
+			return err
+		}
+		v, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		flateReaders.Put(r)
+		kv.Value = v
+		return nil
+	default:
+		return fmt.Errorf("mvcc: unknown value compression %d", compression)
+	}
+}
+
+// UnmarshalKeyValue unmarshals a key-value pair as stored in the key bucket
+// of the backend, decompressing its value.
+func UnmarshalKeyValue(data []byte, kv *mvccpb.KeyValue) error {
+	c, err := unmarshalKeyValue(data, kv)
+	if err != nil {
+		return err
+	}
+	return decompressValue(kv, c)
+}
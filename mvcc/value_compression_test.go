@@ -0,0 +1,93 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/lease"
+	"go.etcd.io/etcd/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+// TestValueCompression ensures compressed values are served as put, also by
+// a store not compressing values, and hash like uncompressed ones.
+func TestValueCompression(t *testing.T) {
+	large := bytes.Repeat([]byte(`{"name": "bar", "labels": {}}`), 100)
+	put := func(s KV) {
+		s.Put([]byte("small"), []byte("bar"), lease.NoLease)
+		s.Put([]byte("large"), large, lease.NoLease)
+		s.Put([]byte("large"), append(large, '!'), lease.NoLease)
+	}
+
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath)
+	s := newWatchableStoreWithConfig(zap.NewExample(), b, &lease.FakeLessor{}, nil, StoreConfig{ValueCompression: ValueCompressionFlate})
+	defer s.Close()
+	w := s.NewWatchStream()
+	defer w.Close()
+	// an unsynced watcher reads the events back from the backend
+	w.Watch(0, []byte("large"), nil, 1)
+	put(s)
+
+	if r := s.ValueCompressionRatio(); r >= 0.1 {
+		t.Errorf("compression ratio = %v, want < 0.1", r)
+	}
+	tx := b.BatchTx()
+	tx.Lock()
+	_, vs := tx.UnsafeRange(keyBucketName, newRevBytes(), []byte{0xff}, 0)
+	tx.Unlock()
+	if len(vs) != 3 || vs[0][0] == compressedKVMark || vs[1][0] != compressedKVMark {
+		t.Fatalf("small value compressed or large value not")
+	}
+	s.Commit()
+
+	b2, tmpPath2 := backend.NewDefaultTmpBackend()
+	defer os.Remove(tmpPath2)
+	s2 := NewStore(zap.NewExample(), b2, &lease.FakeLessor{}, nil)
+	defer s2.Close()
+	put(s2)
+
+	s3 := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer s3.Close()
+	testStoresEqual(t, s2, s3)
+	testStoresEqual(t, s2, s.store)
+	h, _, _, err := s.HashByRev(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h2, _, _, _ := s2.HashByRev(0); h != h2 {
+		t.Errorf("hash = %d, want %d as uncompressed", h, h2)
+	}
+
+	var n int
+	for n < 2 {
+		select {
+		case resp := <-w.Chan():
+			for _, ev := range resp.Events {
+				if !bytes.Equal(ev.Kv.Value, large) && !bytes.Equal(ev.Kv.Value, append(large, '!')) {
+					t.Fatalf("watched value = %q, want as put", ev.Kv.Value)
+				}
+				n++
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("failed to watch the large values")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"sort"
+
+	"go.etcd.io/etcd/mvcc/mvccpb"
+)
+
+// watchCache holds the events of the most recent revisions in memory, so
+// that unsynced watchers catch up from it instead of reading the backend.
+// It is protected by the watchable store mutex.
+type watchCache struct {
+	// revs is the number of revisions whose events are held.
+	revs int64
+
+	// first and last are the oldest and latest revisions whose events are
+	// held, or 0 if none are. The events of every revision in between are
+	// held.
+	first, last int64
+	// evs holds the events ordered by revision.
+	evs []mvccpb.Event
+}
+
+// newWatchCache returns a cache for the events of the given number of
+// revisions, or nil to read them from the backend.
+func newWatchCache(revs int64) *watchCache {
+	if revs <= 0 {
+		return nil
+	}
+	return &watchCache{revs: revs}
+}
+
+// add adds the events of the given revision, evicting the events of the
+// revisions out of the window.
+func (c *watchCache) add(rev int64, evs []mvccpb.Event) {
+	if c == nil {
+		return
+	}
+	if c.last != 0 && rev != c.last+1 {
+		// the revisions in between are missing
+		c.reset()
+	}
+	if c.first == 0 {
+		c.first = rev
+	}
+	c.last = rev
+	c.evs = append(c.evs, evs...)
+
+	if first := rev - c.revs + 1; first > c.first {
+		c.first = first
+		i := c.search(first)
+		// release the evicted pairs before the slice is reallocated
+		for j := range c.evs[:i] {
+			c.evs[j] = mvccpb.Event{}
+		}
+		c.evs = c.evs[i:]
+	}
+}
+
+// events returns the events from minRev up to curRev, and whether the cache
+// holds all of them. The events must not be modified.
+func (c *watchCache) events(minRev, curRev int64) ([]mvccpb.Event, bool) {
+	if c == nil || c.first == 0 || minRev < c.first || c.last != curRev {
+		return nil, false
+	}
+	return c.evs[c.search(minRev):], true
+}
+
+// search returns the index of the first event at or after rev.
+func (c *watchCache) search(rev int64) int {
+	return sort.Search(len(c.evs), func(i int) bool { return c.evs[i].Kv.ModRevision >= rev })
+}
+
+func (c *watchCache) reset() {
+	if c == nil {
+		return
+	}
+	c.first, c.last, c.evs = 0, 0, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/lease"
+	"go.etcd.io/etcd/mvcc/backend"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/zap"
+)
+
+func TestWatchCache(t *testing.T) {
+	c := newWatchCache(3)
+	ev := func(rev int64) mvccpb.Event {
+		return mvccpb.Event{Kv: &mvccpb.KeyValue{Key: []byte("foo"), ModRevision: rev}}
+	}
+	for rev := int64(2); rev <= 5; rev++ {
+		c.add(rev, []mvccpb.Event{ev(rev), ev(rev)})
+	}
+	tests := []struct {
+		minRev, curRev int64
+
+		wn  int
+		wok bool
+	}{
+		{3, 5, 6, true},
+		{5, 5, 2, true},
+		{6, 5, 0, true},
+		// evicted
+		{2, 5, 0, false},
+		// the cache is behind the store
+		{3, 6, 0, false},
+	}
+	for i, tt := range tests {
+		evs, ok := c.events(tt.minRev, tt.curRev)
+		if len(evs) != tt.wn || ok != tt.wok {
+			t.Errorf("#%d: events(%d, %d) = %d events, %v, want %d, %v", i, tt.minRev, tt.curRev, len(evs), ok, tt.wn, tt.wok)
+		}
+		if len(evs) != 0 && evs[0].Kv.ModRevision != tt.minRev {
+			t.Errorf("#%d: first event at %d, want %d", i, evs[0].Kv.ModRevision, tt.minRev)
+		}
+	}
+
+	// a gap in the revisions drops the older ones
+	c.add(7, []mvccpb.Event{ev(7)})
+	if _, ok := c.events(5, 7); ok {
+		t.Errorf("events across the gap served")
+	}
+	if evs, ok := c.events(7, 7); !ok || len(evs) != 1 {
+		t.Errorf("events(7, 7) = %d events, %v, want 1, true", len(evs), ok)
+	}
+}
+
+// TestWatchCacheSync ensures unsynced watchers catch up from the cache when
+// it holds their events, and from the backend otherwise.
+func TestWatchCacheSync(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := newWatchableStoreWithConfig(zap.NewExample(), b, &lease.FakeLessor{}, nil, StoreConfig{WatchCacheRevisions: 5})
+	defer func() {
+		s.store.Close()
+		os.Remove(tmpPath)
+	}()
+	// stop syncing in the background so the test syncs by itself
+	close(s.stopc)
+	s.wg.Wait()
+
+	for i := 0; i < 10; i++ {
+		s.Put([]byte("foo"), []byte(fmt.Sprint(i)), lease.NoLease)
+	}
+	w := s.NewWatchStream()
+	defer w.Close()
+
+	tests := []struct {
+		startRev int64
+
+		whits, wmisses int
+	}{
+		{8, 1, 0},
+		{3, 0, 1},
+	}
+	for i, tt := range tests {
+		hits, misses := readCounterInt(watchCacheHits), readCounterInt(watchCacheMisses)
+		w.Watch(0, []byte("foo"), nil, tt.startRev)
+		s.syncWatchers()
+		select {
+		case resp := <-w.Chan():
+			if n := int64(len(resp.Events)); n != 12-tt.startRev || resp.Events[0].Kv.ModRevision != tt.startRev {
+				t.Errorf("#%d: got %d events from %d, want %d from %d", i, n, resp.Events[0].Kv.ModRevision, 12-tt.startRev, tt.startRev)
+			}
+			for _, ev := range resp.Events {
+				if v := fmt.Sprint(ev.Kv.ModRevision - 2); string(ev.Kv.Value) != v {
+					t.Errorf("#%d: value at %d = %q, want %q", i, ev.Kv.ModRevision, ev.Kv.Value, v)
+				}
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("#%d: failed to receive the events", i)
+		}
+		if g := readCounterInt(watchCacheHits) - hits; g != tt.whits {
+			t.Errorf("#%d: hits = %d, want %d", i, g, tt.whits)
+		}
+		if g := readCounterInt(watchCacheMisses) - misses; g != tt.wmisses {
+			t.Errorf("#%d: misses = %d, want %d", i, g, tt.wmisses)
+		}
+	}
+}
+
+func readCounterInt(c prometheus.Counter) int {
+	ch := make(chan prometheus.Metric, 1)
+	c.Collect(ch)
+	m := <-ch
+	mm := &dto.Metric{}
+	m.Write(mm)
+	return int(mm.GetCounter().GetValue())
+}
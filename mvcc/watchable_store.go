@@ -36,8 +36,13 @@ var (
 	maxWatchersPerSync = 512
 )
 
+// initialStatePageSize is the number of key-values of an initial state to
+// send in a single watch response.
+var initialStatePageSize = 1000
+
 type watchable interface {
-	watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, fcs ...FilterFunc) (*watcher, cancelFunc)
+	watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, bl WatchBufferLimiter, fcs ...FilterFunc) (*watcher, cancelFunc)
+	watchInitialState(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, bl WatchBufferLimiter, fcs ...FilterFunc) (*watcher, int64, cancelFunc, error)
 	progress(w *watcher)
 	rev() int64
 }
@@ -60,6 +65,12 @@ type watchableStore struct {
 	// The key of the map is the key that the watcher watches on.
 	synced watcherGroup
 
+	// contains all watchers that are sending the initial state of their range.
+	initial map[*watcher]struct{}
+
+	// cache holds the events of the most recent revisions, if enabled.
+	cache *watchCache
+
 	stopc chan struct{}
 	wg    sync.WaitGroup
 }
@@ -72,12 +83,23 @@ func New(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentIndexG
 	return newWatchableStore(lg, b, le, ig)
 }
 
+// NewWithConfig is like New but enables the optional features in cfg.
+func NewWithConfig(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) ConsistentWatchableKV {
+	return newWatchableStoreWithConfig(lg, b, le, ig, cfg)
+}
+
 func newWatchableStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter) *watchableStore {
+	return newWatchableStoreWithConfig(lg, b, le, ig, StoreConfig{})
+}
+
+func newWatchableStoreWithConfig(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentIndexGetter, cfg StoreConfig) *watchableStore {
 	s := &watchableStore{
-		store:    NewStore(lg, b, le, ig),
+		store:    NewStoreWithConfig(lg, b, le, ig, cfg),
 		victimc:  make(chan struct{}, 1),
 		unsynced: newWatcherGroup(),
 		synced:   newWatcherGroup(),
+		initial:  make(map[*watcher]struct{}),
+		cache:    newWatchCache(cfg.WatchCacheRevisions),
 		stopc:    make(chan struct{}),
 	}
 	s.store.ReadView = &readView{s}
@@ -99,22 +121,28 @@ func (s *watchableStore) Close() error {
 }
 
 func (s *watchableStore) NewWatchStream() WatchStream {
+	return s.NewLimitedWatchStream(nil)
+}
+
+func (s *watchableStore) NewLimitedWatchStream(bl WatchBufferLimiter) WatchStream {
 	watchStreamGauge.Inc()
 	return &watchStream{
 		watchable: s,
 		ch:        make(chan WatchResponse, chanBufLen),
+		bl:        bl,
 		cancels:   make(map[WatchID]cancelFunc),
 		watchers:  make(map[WatchID]*watcher),
 	}
 }
 
-func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, fcs ...FilterFunc) (*watcher, cancelFunc) {
+func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, bl WatchBufferLimiter, fcs ...FilterFunc) (*watcher, cancelFunc) {
 	wa := &watcher{
 		key:    key,
 		end:    end,
 		minRev: startRev,
 		id:     id,
 		ch:     ch,
+		bl:     bl,
 		fcs:    fcs,
 	}
 
@@ -141,6 +169,53 @@ func (s *watchableStore) watch(key, end []byte, startRev int64, id WatchID, ch c
 	return wa, func() { s.cancelWatcher(wa) }
 }
 
+// watchInitialState creates a watcher that sends the key-values in its range
+// at the revision before startRev, or at the current revision, before the
+// events after that revision.
+func (s *watchableStore) watchInitialState(key, end []byte, startRev int64, id WatchID, ch chan<- WatchResponse, bl WatchBufferLimiter, fcs ...FilterFunc) (*watcher, int64, cancelFunc, error) {
+	s.mu.Lock()
+	s.revMu.RLock()
+	rev := s.store.currentRev
+	if startRev > 0 {
+		rev = startRev - 1
+	}
+	var err error
+	switch {
+	case rev > s.store.currentRev:
+		err = ErrFutureRev
+	case rev < s.store.compactMainRev:
+		err = ErrCompacted
+	}
+	s.revMu.RUnlock()
+	if err != nil || rev == 0 {
+		s.mu.Unlock()
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		// the store is empty before its first revision
+		wa, c := s.watch(key, end, 1, id, ch, bl, fcs...)
+		return wa, 0, c, nil
+	}
+
+	wa := &watcher{
+		key:        key,
+		end:        end,
+		minRev:     rev + 1,
+		id:         id,
+		ch:         ch,
+		bl:         bl,
+		fcs:        fcs,
+		initialRev: rev,
+		initialKey: key,
+	}
+	s.initial[wa] = struct{}{}
+	s.mu.Unlock()
+
+	watcherGauge.Inc()
+
+	return wa, rev, func() { s.cancelWatcher(wa) }, nil
+}
+
 // cancelWatcher removes references of the watcher from the watchableStore
 func (s *watchableStore) cancelWatcher(wa *watcher) {
 	for {
@@ -150,6 +225,9 @@ func (s *watchableStore) cancelWatcher(wa *watcher) {
 			break
 		} else if s.synced.delete(wa) {
 			break
+		} else if _, ok := s.initial[wa]; ok {
+			delete(s.initial, wa)
+			break
 		} else if wa.compacted {
 			break
 		} else if wa.ch == nil {
@@ -179,6 +257,11 @@ func (s *watchableStore) cancelWatcher(wa *watcher) {
 		time.Sleep(time.Millisecond)
 	}
 
+	if wa.held != 0 {
+		// drop the response held back for the watcher
+		wa.bl.Release(wa.held)
+		wa.held = 0
+	}
 	watcherGauge.Dec()
 	wa.ch = nil
 	s.mu.Unlock()
@@ -191,6 +274,7 @@ func (s *watchableStore) Restore(b backend.Backend) error {
 	if err != nil {
 		return err
 	}
+	s.cache.reset()
 
 	for wa := range s.synced.watchers {
 		wa.restore = true
@@ -208,8 +292,13 @@ func (s *watchableStore) syncWatchersLoop() {
 		s.mu.RLock()
 		st := time.Now()
 		lastUnsyncedWatchers := s.unsynced.size()
+		initialWatchers := len(s.initial)
 		s.mu.RUnlock()
 
+		initialPages := 0
+		if initialWatchers > 0 {
+			initialPages = s.syncInitialState()
+		}
 		unsyncedWatchers := 0
 		if lastUnsyncedWatchers > 0 {
 			unsyncedWatchers = s.syncWatchers()
@@ -218,7 +307,7 @@ func (s *watchableStore) syncWatchersLoop() {
 
 		waitDuration := 100 * time.Millisecond
 		// more work pending?
-		if unsyncedWatchers != 0 && lastUnsyncedWatchers > unsyncedWatchers {
+		if initialPages != 0 || (unsyncedWatchers != 0 && lastUnsyncedWatchers > unsyncedWatchers) {
 			// be fair to other store operations by yielding time taken
 			waitDuration = syncDuration
 		}
@@ -339,23 +428,36 @@ func (s *watchableStore) syncWatchers() int {
 	compactionRev := s.store.compactMainRev
 
 	wg, minRev := s.unsynced.choose(maxWatchersPerSync, curRev, compactionRev)
-	minBytes, maxBytes := newRevBytes(), newRevBytes()
-	revToBytes(revision{main: minRev}, minBytes)
-	revToBytes(revision{main: curRev + 1}, maxBytes)
-
-	// UnsafeRange returns keys and values. And in boltdb, keys are revisions.
-	// values are actual key-value pairs in backend.
-	tx := s.store.b.ReadTx()
-	tx.Lock()
-	revs, vs := tx.UnsafeRange(keyBucketName, minBytes, maxBytes, 0)
+
 	var evs []mvccpb.Event
-	if s.store != nil && s.store.lg != nil {
-		evs = kvsToEvents(s.store.lg, wg, revs, vs)
+	if cevs, ok := s.cache.events(minRev, curRev); ok {
+		watchCacheHits.Inc()
+		for _, ev := range cevs {
+			if wg.contains(string(ev.Kv.Key)) {
+				evs = append(evs, ev)
+			}
+		}
 	} else {
-		// TODO: remove this in v3.5
-		evs = kvsToEvents(nil, wg, revs, vs)
+		if s.cache != nil {
+			watchCacheMisses.Inc()
+		}
+		minBytes, maxBytes := newRevBytes(), newRevBytes()
+		revToBytes(revision{main: minRev}, minBytes)
+		revToBytes(revision{main: curRev + 1}, maxBytes)
+
+		// UnsafeRange returns keys and values. And in boltdb, keys are revisions.
+		// values are actual key-value pairs in backend.
+		tx := s.store.b.ReadTx()
+		tx.Lock()
+		revs, vs := tx.UnsafeRange(keyBucketName, minBytes, maxBytes, 0)
+		if s.store != nil && s.store.lg != nil {
+			evs = kvsToEvents(s.store.lg, wg, revs, vs)
+		} else {
+			// TODO: remove this in v3.5
+			evs = kvsToEvents(nil, wg, revs, vs)
+		}
+		tx.Unlock()
 	}
-	tx.Unlock()
 
 	var victims watcherBatch
 	wb := newWatcherBatch(wg, evs)
@@ -405,11 +507,94 @@ func (s *watchableStore) syncWatchers() int {
 	return s.unsynced.size()
 }
 
+// syncInitialState sends the next page of the initial state of each watcher
+// that is sending one, and returns the number of pages sent. Watchers that
+// sent their whole initial state move on to the events after it.
+func (s *watchableStore) syncInitialState() int {
+	s.mu.RLock()
+	ws := make([]*watcher, 0, len(s.initial))
+	for w := range s.initial {
+		ws = append(ws, w)
+	}
+	s.mu.RUnlock()
+
+	pages := 0
+	for _, w := range ws {
+		// read outside of mu, which must not be locked before store.mu;
+		// only this loop updates the initial state of the watchers
+		txn := s.store.Read()
+		r, err := txn.Range(w.initialKey, w.end, RangeOptions{Rev: w.initialRev, Limit: int64(initialStatePageSize) + 1})
+		txn.End()
+
+		s.mu.Lock()
+		if _, ok := s.initial[w]; !ok {
+			// canceled while reading
+			s.mu.Unlock()
+			continue
+		}
+		if err == ErrCompacted {
+			s.store.revMu.RLock()
+			compactRev := s.store.compactMainRev
+			s.store.revMu.RUnlock()
+			select {
+			case w.ch <- WatchResponse{WatchID: w.id, CompactRevision: compactRev}:
+				w.compacted = true
+				delete(s.initial, w)
+			default:
+				// retry next time
+			}
+			s.mu.Unlock()
+			continue
+		}
+		if err != nil {
+			if s.store.lg != nil {
+				s.store.lg.Panic("failed to read initial state", zap.Int64("revision", w.initialRev), zap.Error(err))
+			} else {
+				plog.Panicf("cannot read initial state at %d: %v", w.initialRev, err)
+			}
+		}
+
+		kvs, more := r.KVs, len(r.KVs) > initialStatePageSize
+		if more {
+			kvs = kvs[:initialStatePageSize]
+		}
+		evs := make([]mvccpb.Event, len(kvs))
+		for i := range kvs {
+			evs[i] = mvccpb.Event{Type: mvccpb.PUT, Kv: &kvs[i]}
+		}
+		if len(evs) != 0 && !w.send(WatchResponse{WatchID: w.id, Events: evs, Revision: w.initialRev}) {
+			// channel full; retry the page next time
+			s.mu.Unlock()
+			continue
+		}
+		pendingEventsGauge.Add(float64(len(evs)))
+		pages++
+
+		if more {
+			w.initialKey = append(append([]byte{}, kvs[len(kvs)-1].Key...), 0)
+		} else {
+			delete(s.initial, w)
+			w.initialRev, w.initialKey = 0, nil
+			s.store.revMu.RLock()
+			if w.minRev > s.store.currentRev {
+				s.synced.add(w)
+			} else {
+				slowWatcherGauge.Inc()
+				s.unsynced.add(w)
+			}
+			s.store.revMu.RUnlock()
+		}
+		s.mu.Unlock()
+	}
+	return pages
+}
+
 // kvsToEvents gets all events for the watchers from all key-value pairs
 func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []mvccpb.Event) {
 	for i, v := range vals {
 		var kv mvccpb.KeyValue
-		if err := kv.Unmarshal(v); err != nil {
+		c, err := unmarshalKeyValue(v, &kv)
+		if err != nil {
 			if lg != nil {
 				lg.Panic("failed to unmarshal mvccpb.KeyValue", zap.Error(err))
 			} else {
@@ -420,6 +605,13 @@ func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []m
 		if !wg.contains(string(kv.Key)) {
 			continue
 		}
+		if err = decompressValue(&kv, c); err != nil {
+			if lg != nil {
+				lg.Panic("failed to decompress value", zap.Error(err))
+			} else {
+				plog.Panicf("cannot decompress value: %v", err)
+			}
+		}
 
 		ty := mvccpb.PUT
 		if isTombstone(revs[i]) {
@@ -435,6 +627,7 @@ func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []m
 // notify notifies the fact that given event at the given rev just happened to
 // watchers that watch on the key of the event.
 func (s *watchableStore) notify(rev int64, evs []mvccpb.Event) {
+	s.cache.add(rev, evs)
 	var victim watcherBatch
 	for w, eb := range newWatcherBatch(&s.synced, evs) {
 		if eb.revs != 1 {
@@ -509,6 +702,12 @@ type watcher struct {
 	// except when the watcher were to be moved from "synced" watcher group
 	restore bool
 
+	// initialRev is the revision of the initial state the watcher is
+	// sending, or 0 if it is not sending one. initialKey is the key to send
+	// the next page of the initial state from.
+	initialRev int64
+	initialKey []byte
+
 	// minRev is the minimum revision update the watcher will accept
 	minRev int64
 	id     WatchID
@@ -517,6 +716,12 @@ type watcher struct {
 	// a chan to send out the watch response.
 	// The chan might be shared with other watchers.
 	ch chan<- WatchResponse
+
+	// bl is the limiter of the stream of the watcher, if any. held is the
+	// number of bytes taken from it for a response that was produced but
+	// could not be sent on ch yet, such as the events of a victim.
+	bl   WatchBufferLimiter
+	held int64
 }
 
 func (w *watcher) send(wr WatchResponse) bool {
@@ -543,8 +748,24 @@ func (w *watcher) send(wr WatchResponse) bool {
 	if !progressEvent && len(wr.Events) == 0 {
 		return true
 	}
+	if w.bl != nil && !progressEvent {
+		// a response held back keeps its bytes until it is sent
+		if w.held == 0 {
+			n := int64(0)
+			for i := range wr.Events {
+				n += int64(wr.Events[i].Size())
+			}
+			if !w.bl.Acquire(n) {
+				return false
+			}
+			w.held = n
+		}
+		wr.Bytes = w.held
+	}
 	select {
 	case w.ch <- wr:
+		// the receiver releases the bytes
+		w.held = 0
 		return true
 	default:
 		return false
@@ -429,6 +429,82 @@ func TestWatchBatchUnsynced(t *testing.T) {
 	}
 }
 
+// TestWatchInitialState ensures a watcher with the initial state receives
+// the key-values in its range in pages, then the events after them.
+func TestWatchInitialState(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := newWatchableStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+
+	oldPageSize := initialStatePageSize
+	defer func() {
+		initialStatePageSize = oldPageSize
+		s.store.Close()
+		os.Remove(tmpPath)
+	}()
+	initialStatePageSize = 2
+
+	for _, k := range []string{"a", "b", "c", "d", "e", "f"} {
+		s.Put([]byte(k), []byte(k), lease.NoLease)
+	}
+	s.Put([]byte("b"), []byte("b2"), lease.NoLease)
+	s.DeleteRange([]byte("d"), nil)
+
+	w := s.NewWatchStream()
+	defer w.Close()
+	if _, _, err := w.WatchWithInitialState(0, []byte("a"), []byte("f"), 11); err != ErrFutureRev {
+		t.Fatalf("err = %v, want %v", err, ErrFutureRev)
+	}
+	_, rev, err := w.WatchWithInitialState(0, []byte("a"), []byte("f"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rev != 9 {
+		t.Fatalf("initial state revision = %d, want 9", rev)
+	}
+	s.Put([]byte("c"), []byte("c2"), lease.NoLease)
+
+	wpages := [][]string{{"a", "b2"}, {"c", "e"}, {"c2"}}
+	for i, wvals := range wpages {
+		select {
+		case resp := <-w.Chan():
+			var vals []string
+			for _, ev := range resp.Events {
+				if ev.Type != mvccpb.PUT {
+					t.Errorf("#%d: event type = %v, want %v", i, ev.Type, mvccpb.PUT)
+				}
+				vals = append(vals, string(ev.Kv.Value))
+			}
+			if !reflect.DeepEqual(vals, wvals) {
+				t.Errorf("#%d: values = %v, want %v", i, vals, wvals)
+			}
+			if wrev := int64(9); i < 2 && resp.Revision != wrev {
+				t.Errorf("#%d: revision = %d, want %d", i, resp.Revision, wrev)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("#%d: failed to receive the response", i)
+		}
+	}
+
+	// the store is empty before the first revision
+	_, rev, err = w.WatchWithInitialState(0, []byte("b"), nil, 1)
+	if err != nil || rev != 0 {
+		t.Fatalf("rev, err = %d, %v, want 0, <nil>", rev, err)
+	}
+	select {
+	case resp := <-w.Chan():
+		if len(resp.Events) != 2 || resp.Events[0].Kv.ModRevision != 3 {
+			t.Errorf("expected the events of b from 1, got %+v", resp.Events)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("failed to receive the events")
+	}
+
+	s.Compact(9)
+	if _, _, err = w.WatchWithInitialState(0, []byte("a"), nil, 5); err != ErrCompacted {
+		t.Errorf("err = %v, want %v", err, ErrCompacted)
+	}
+}
+
 func TestNewMapwatcherToEventMap(t *testing.T) {
 	k0, k1, k2 := []byte("foo0"), []byte("foo1"), []byte("foo2")
 	v0, v1, v2 := []byte("bar0"), []byte("bar1"), []byte("bar2")
@@ -606,6 +682,112 @@ func TestWatchVictims(t *testing.T) {
 	}
 }
 
+// testBufferLimiter holds up to max bytes.
+type testBufferLimiter struct {
+	mu        sync.Mutex
+	max, held int64
+}
+
+func (l *testBufferLimiter) Acquire(n int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.held+n > l.max {
+		return false
+	}
+	l.held += n
+	return true
+}
+
+func (l *testBufferLimiter) Release(n int64) {
+	l.mu.Lock()
+	l.held -= n
+	l.mu.Unlock()
+}
+
+func (l *testBufferLimiter) get() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.held
+}
+
+// TestWatchBufferLimiter ensures the responses of a limited stream hold their
+// bytes from when they are produced, including as victims, and are held back
+// while they do not fit.
+func TestWatchBufferLimiter(t *testing.T) {
+	oldChanBufLen := chanBufLen
+
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := newWatchableStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+
+	defer func() {
+		s.store.Close()
+		os.Remove(tmpPath)
+		chanBufLen = oldChanBufLen
+	}()
+
+	chanBufLen = 1
+	testKey, testValue := []byte("foo"), []byte("bar")
+
+	bl := &testBufferLimiter{max: 1 << 20}
+	w := s.NewLimitedWatchStream(bl)
+	defer w.Close()
+	id, _ := w.Watch(0, testKey, nil, 0)
+
+	s.Put(testKey, testValue, lease.NoLease)
+	// the channel is full, so the watcher becomes a victim
+	s.Put(testKey, testValue, lease.NoLease)
+
+	wr := <-w.Chan()
+	n := wr.Bytes
+	if want := int64(wr.Events[0].Size()); n != want {
+		t.Fatalf("bytes = %d, want %d", n, want)
+	}
+	if held := bl.get(); held != 2*n {
+		t.Fatalf("held = %d, want %d", held, 2*n)
+	}
+	bl.Release(n)
+
+	// canceling the victim drops its response
+	if err := w.Cancel(id); err != nil {
+		t.Fatal(err)
+	}
+	if held := bl.get(); held != 0 {
+		t.Fatalf("held = %d, want 0", held)
+	}
+	select {
+	case <-w.Chan():
+	default:
+	}
+
+	// a response over the limit is held back until it fits
+	bl.mu.Lock()
+	bl.max = n
+	bl.mu.Unlock()
+	w.Watch(0, testKey, nil, 0)
+	s.Put(testKey, testValue, lease.NoLease)
+	s.Put(testKey, testValue, lease.NoLease)
+
+	wr = <-w.Chan()
+	if held := bl.get(); held != n {
+		t.Fatalf("held = %d, want %d", held, n)
+	}
+	select {
+	case wr = <-w.Chan():
+		t.Fatalf("unexpected response %+v over the limit", wr)
+	case <-time.After(100 * time.Millisecond):
+	}
+	bl.Release(wr.Bytes)
+
+	select {
+	case wr = <-w.Chan():
+		if wr.Bytes != n {
+			t.Fatalf("bytes = %d, want %d", wr.Bytes, n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the held back response")
+	}
+}
+
 // TestStressWatchCancelClose tests closing a watch stream while
 // canceling its watches.
 func TestStressWatchCancelClose(t *testing.T) {
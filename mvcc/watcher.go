@@ -50,6 +50,14 @@ type WatchStream interface {
 	// an auto-generated watch ID is returned.
 	Watch(id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, error)
 
+	// WatchWithInitialState creates a watcher like Watch that first receives
+	// the key-values in the range at the revision before startRev, or at the
+	// current revision if "startRev" <=0, as PUT events. The key-values are
+	// sent in pages ordered by key, then the events after the revision follow.
+	//
+	// The returned revision is the revision of the initial state.
+	WatchWithInitialState(id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, int64, error)
+
 	// Chan returns a chan. All watch response will be sent to the returned chan.
 	Chan() <-chan WatchResponse
 
@@ -72,6 +80,17 @@ type WatchStream interface {
 	Rev() int64
 }
 
+// WatchBufferLimiter bounds the bytes of the responses a watch stream holds
+// before they are sent.
+type WatchBufferLimiter interface {
+	// Acquire takes n bytes for a response produced for the stream, and
+	// reports whether they fit. A response that does not fit is held back
+	// like one blocked on a full stream channel.
+	Acquire(n int64) bool
+	// Release returns n bytes taken by Acquire.
+	Release(n int64)
+}
+
 type WatchResponse struct {
 	// WatchID is the WatchID of the watcher this response sent to.
 	WatchID WatchID
@@ -88,6 +107,11 @@ type WatchResponse struct {
 
 	// CompactRevision is set when the watcher is cancelled due to compaction.
 	CompactRevision int64
+
+	// Bytes is the size of the events, held in the limiter of the stream
+	// until the receiver releases it after sending the response. It is 0
+	// if the stream has no limiter.
+	Bytes int64
 }
 
 // watchStream contains a collection of watchers that share
@@ -95,6 +119,8 @@ type WatchResponse struct {
 type watchStream struct {
 	watchable watchable
 	ch        chan WatchResponse
+	// bl holds the bytes of the responses of the stream, if set
+	bl WatchBufferLimiter
 
 	mu sync.Mutex // guards fields below it
 	// nextID is the ID pre-allocated for next new watcher in this stream
@@ -106,16 +132,28 @@ type watchStream struct {
 
 // Watch creates a new watcher in the stream and returns its WatchID.
 func (ws *watchStream) Watch(id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, error) {
+	id, _, err := ws.watch(id, key, end, startRev, false, fcs...)
+	return id, err
+}
+
+// WatchWithInitialState creates a new watcher in the stream that receives
+// the initial state of its range first, and returns its WatchID and the
+// revision of the initial state.
+func (ws *watchStream) WatchWithInitialState(id WatchID, key, end []byte, startRev int64, fcs ...FilterFunc) (WatchID, int64, error) {
+	return ws.watch(id, key, end, startRev, true, fcs...)
+}
+
+func (ws *watchStream) watch(id WatchID, key, end []byte, startRev int64, initial bool, fcs ...FilterFunc) (WatchID, int64, error) {
 	// prevent wrong range where key >= end lexicographically
 	// watch request with 'WithFromKey' has empty-byte range end
 	if len(end) != 0 && bytes.Compare(key, end) != -1 {
-		return -1, ErrEmptyWatcherRange
+		return -1, 0, ErrEmptyWatcherRange
 	}
 
 	ws.mu.Lock()
 	defer ws.mu.Unlock()
 	if ws.closed {
-		return -1, ErrEmptyWatcherRange
+		return -1, 0, ErrEmptyWatcherRange
 	}
 
 	if id == AutoWatchID {
@@ -125,14 +163,26 @@ func (ws *watchStream) Watch(id WatchID, key, end []byte, startRev int64, fcs ..
 		id = ws.nextID
 		ws.nextID++
 	} else if _, ok := ws.watchers[id]; ok {
-		return -1, ErrWatcherDuplicateID
+		return -1, 0, ErrWatcherDuplicateID
 	}
 
-	w, c := ws.watchable.watch(key, end, startRev, id, ws.ch, fcs...)
+	var (
+		w   *watcher
+		c   cancelFunc
+		rev int64
+	)
+	if initial {
+		var err error
+		if w, rev, c, err = ws.watchable.watchInitialState(key, end, startRev, id, ws.ch, ws.bl, fcs...); err != nil {
+			return -1, 0, err
+		}
+	} else {
+		w, c = ws.watchable.watch(key, end, startRev, id, ws.ch, ws.bl, fcs...)
+	}
 
 	ws.cancels[id] = c
 	ws.watchers[id] = w
-	return id, nil
+	return id, rev, nil
 }
 
 func (ws *watchStream) Chan() <-chan WatchResponse {
@@ -86,3 +86,38 @@ func (s *ss2scServerStream) Recv() (*pb.SnapshotRequest, error) {
 	}
 	return v.(*pb.SnapshotRequest), nil
 }
+
+func (s *mts2mtc) DefragmentOnline(ctx context.Context, in *pb.DefragmentRequest, opts ...grpc.CallOption) (pb.Maintenance_DefragmentOnlineClient, error) {
+	cs := newPipeStream(ctx, func(ss chanServerStream) error {
+		return s.mts.DefragmentOnline(in, &ds2dcServerStream{ss})
+	})
+	return &ds2dcClientStream{cs}, nil
+}
+
+// ds2dcClientStream implements Maintenance_DefragmentOnlineClient
+type ds2dcClientStream struct{ chanClientStream }
+
+// ds2dcServerStream implements Maintenance_DefragmentOnlineServer
+type ds2dcServerStream struct{ chanServerStream }
+
+func (s *ds2dcClientStream) Send(rr *pb.DefragmentRequest) error {
+	return s.SendMsg(rr)
+}
+func (s *ds2dcClientStream) Recv() (*pb.DefragmentProgressResponse, error) {
+	var v interface{}
+	if err := s.RecvMsg(&v); err != nil {
+		return nil, err
+	}
+	return v.(*pb.DefragmentProgressResponse), nil
+}
+
+func (s *ds2dcServerStream) Send(rr *pb.DefragmentProgressResponse) error {
+	return s.SendMsg(rr)
+}
+func (s *ds2dcServerStream) Recv() (*pb.DefragmentRequest, error) {
+	var v interface{}
+	if err := s.RecvMsg(&v); err != nil {
+		return nil, err
+	}
+	return v.(*pb.DefragmentRequest), nil
+}
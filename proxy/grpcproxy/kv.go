@@ -179,12 +179,21 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.CountOnly {
 		opts = append(opts, clientv3.WithCountOnly())
 	}
+	if r.CountFiltered {
+		opts = append(opts, clientv3.WithCountFiltered())
+	}
 	if r.KeysOnly {
 		opts = append(opts, clientv3.WithKeysOnly())
 	}
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
+	if r.MaxStaleness != 0 {
+		opts = append(opts, clientv3.WithMaxStaleness(r.MaxStaleness))
+	}
+	if len(r.PageToken) != 0 {
+		opts = append(opts, clientv3.WithPageToken(r.PageToken))
+	}
 
 	return clientv3.OpGet(string(r.Key), opts...)
 }
@@ -37,6 +37,33 @@ func (mp *maintenanceProxy) Defragment(ctx context.Context, dr *pb.DefragmentReq
 	return pb.NewMaintenanceClient(conn).Defragment(ctx, dr)
 }
 
+func (mp *maintenanceProxy) DefragmentOnline(dr *pb.DefragmentRequest, stream pb.Maintenance_DefragmentOnlineServer) error {
+	conn := mp.client.ActiveConnection()
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	ctx = withClientAuthToken(ctx, stream.Context())
+
+	dc, err := pb.NewMaintenanceClient(conn).DefragmentOnline(ctx, dr)
+	if err != nil {
+		return err
+	}
+
+	for {
+		rr, err := dc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		err = stream.Send(rr)
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (mp *maintenanceProxy) Snapshot(sr *pb.SnapshotRequest, stream pb.Maintenance_SnapshotServer) error {
 	conn := mp.client.ActiveConnection()
 	ctx, cancel := context.WithCancel(stream.Context())
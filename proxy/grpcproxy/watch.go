@@ -240,6 +240,31 @@ func (wps *watchProxyStream) recvLoop() error {
 				continue
 			}
 
+			// the proxy coalesces watchers onto shared server watchers, which
+			// cannot replay the initial state or resume from a token.
+			if cr.InitialState || len(cr.ResumeToken) != 0 {
+				wps.watchCh <- &pb.WatchResponse{
+					Header:       &pb.ResponseHeader{},
+					WatchId:      -1,
+					Created:      true,
+					Canceled:     true,
+					CancelReason: rpctypes.ErrorDesc(rpctypes.ErrGRPCWatchNotSupported),
+				}
+				continue
+			}
+
+			filters, err := v3rpc.FiltersFromRequest(cr)
+			if err != nil {
+				wps.watchCh <- &pb.WatchResponse{
+					Header:       &pb.ResponseHeader{},
+					WatchId:      -1,
+					Created:      true,
+					Canceled:     true,
+					CancelReason: rpctypes.ErrorDesc(err),
+				}
+				continue
+			}
+
 			w := &watcher{
 				wr:  watchRange{string(cr.Key), string(cr.RangeEnd)},
 				id:  wps.nextWatcherID,
@@ -248,7 +273,7 @@ func (wps *watchProxyStream) recvLoop() error {
 				nextrev:  cr.StartRevision,
 				progress: cr.ProgressNotify,
 				prevKV:   cr.PrevKv,
-				filters:  v3rpc.FiltersFromRequest(cr),
+				filters:  filters,
 			}
 			if !w.wr.valid() {
 				w.post(&pb.WatchResponse{WatchId: -1, Created: true, Canceled: true})
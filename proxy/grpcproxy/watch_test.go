@@ -0,0 +1,131 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "go.etcd.io/etcd/etcdserver/etcdserverpb"
+	"go.etcd.io/etcd/integration"
+	"go.etcd.io/etcd/pkg/testutil"
+
+	"google.golang.org/grpc"
+)
+
+// TestWatchProxyUnsupportedOptions ensures the proxy cancels watches asking
+// for the initial state or a resume token instead of silently ignoring them.
+func TestWatchProxyUnsupportedOptions(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	wpts := newWatchProxyServer([]string{clus.Members[0].GRPCAddr()}, t)
+	defer wpts.close()
+
+	cfg := clientv3.Config{
+		Endpoints:   []string{wpts.l.Addr().String()},
+		DialTimeout: 5 * time.Second,
+	}
+	client, err := clientv3.New(cfg)
+	if err != nil {
+		t.Fatalf("err = %v, want nil", err)
+	}
+	defer client.Close()
+
+	tests := [][]clientv3.OpOption{
+		{clientv3.WithInitialState()},
+		{clientv3.WithResumeToken([]byte("token"))},
+	}
+	for i, opts := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		wresp, ok := <-client.Watch(ctx, "foo", opts...)
+		cancel()
+		if !ok {
+			t.Fatalf("#%d: watch channel closed without a response", i)
+		}
+		if !wresp.Canceled {
+			t.Errorf("#%d: canceled = false, want true", i)
+		}
+		if err := wresp.Err(); err != rpctypes.ErrWatchNotSupported {
+			t.Errorf("#%d: err = %v, want %v", i, err, rpctypes.ErrWatchNotSupported)
+		}
+	}
+
+	// plain watches still go through the proxy
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wch := client.Watch(ctx, "foo")
+	if _, err := clus.Client(0).Put(context.Background(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wresp := <-wch:
+		if err := wresp.Err(); err != nil || len(wresp.Events) != 1 {
+			t.Fatalf("unexpected watch response %+v (err %v)", wresp, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the watch event")
+	}
+}
+
+type watchproxyTestServer struct {
+	wp     pb.WatchServer
+	c      *clientv3.Client
+	server *grpc.Server
+	l      net.Listener
+}
+
+func (wpts *watchproxyTestServer) close() {
+	wpts.server.Stop()
+	wpts.l.Close()
+	wpts.c.Close()
+}
+
+func newWatchProxyServer(endpoints []string, t *testing.T) *watchproxyTestServer {
+	cfg := clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: 5 * time.Second,
+	}
+	client, err := clientv3.New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wp, _ := NewWatchProxy(client)
+
+	wpts := &watchproxyTestServer{
+		wp: wp,
+		c:  client,
+	}
+
+	var opts []grpc.ServerOption
+	wpts.server = grpc.NewServer(opts...)
+	pb.RegisterWatchServer(wpts.server, wpts.wp)
+
+	wpts.l, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go wpts.server.Serve(wpts.l)
+
+	return wpts
+}
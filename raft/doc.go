@@ -144,6 +144,19 @@ The total state machine handling loop will look something like this:
     }
   }
 
+Usage with Asynchronous Storage Writes
+
+Setting Config.AsyncStorageWrites lets the application write to its raft log
+and apply committed entries in the background, pipelined with the handling of
+later Ready structs. Instead of acting on the HardState, Entries, Snapshot and
+CommittedEntries fields and calling Advance, the application hands the
+MsgStorageAppend and MsgStorageApply messages found in Ready.Messages to an
+append and an apply thread, which process them in order. Once the work for a
+message is done (and, for MsgStorageAppend, durable), the thread delivers the
+message's Responses: those addressed to the local node go to Node.Step, the
+others to the respective peers. The remaining messages in Ready.Messages can be
+sent right away.
+
 To propose changes to the state machine from your node take your application
 data, serialize it into a byte slice and call:
 
@@ -164,6 +177,29 @@ raftpb.EntryConfChange will be returned. You must apply it to node through:
 	cc.Unmarshal(data)
 	n.ApplyConfChange(cc)
 
+Several voters and learners can be added, removed, promoted or demoted
+atomically by proposing a ConfChangeV2 instead. Its entries have type
+raftpb.EntryConfChangeV2 and are applied in the same way:
+
+	var cc raftpb.ConfChangeV2
+	cc.Unmarshal(data)
+	n.ApplyConfChange(cc)
+
+A ConfChangeV2 that makes more than one change (or explicitly asks for it
+through its Transition field) moves the cluster into a joint configuration,
+in which elections and commitment require a majority of both the old and
+the new voters. With ConfChangeTransitionAuto and
+ConfChangeTransitionJointImplicit the leader leaves the joint configuration
+on its own once it has been applied, by proposing an empty ConfChangeV2.
+With ConfChangeTransitionJointExplicit the application proposes that empty
+ConfChangeV2 itself (optionally with a Context) when it sees fit.
+
+A node added with ConfChangeAddWitnessNode is a witness: a voter that takes
+part in elections and in acknowledging log entries, but that is never asked
+to take over leadership and hands it off to a regular voter should it get
+elected. This lets the application run it without a state machine. A
+witness cannot be turned into a regular voter or a learner.
+
 Note: An ID represents a unique node in a cluster for all time. A
 given ID MUST be used only once even if the old node has been removed.
 This means that for example IP addresses make poor node IDs since they
@@ -174,12 +210,12 @@ Implementation notes
 This implementation is up to date with the final Raft thesis
 (https://ramcloud.stanford.edu/~ongaro/thesis.pdf), although our
 implementation of the membership change protocol differs somewhat from
-that described in chapter 4. The key invariant that membership changes
-happen one node at a time is preserved, but in our implementation the
-membership change takes effect when its entry is applied, not when it
-is added to the log (so the entry is committed under the old
-membership instead of the new). This is equivalent in terms of safety,
-since the old and new configurations are guaranteed to overlap.
+that described in chapter 4. Simple changes happen one node at a time, and
+larger changes go through a joint configuration as described in section 4.3,
+but in our implementation the membership change takes effect when its entry
+is applied, not when it is added to the log (so the entry is committed under
+the old membership instead of the new). This is equivalent in terms of
+safety, since the old and new configurations are guaranteed to overlap.
 
 To ensure that we do not attempt to commit two membership changes at
 once by matching log positions (which would be unsafe since they
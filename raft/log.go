@@ -32,10 +32,22 @@ type raftLog struct {
 	// committed is the highest log position that is known to be in
 	// stable storage on a quorum of nodes.
 	committed uint64
+	// applying is the highest log position that the application has
+	// been instructed to apply to its state machine. Some of these
+	// entries may be in the process of applying and have not yet
+	// reached applied.
+	// Invariant: applied <= applying && applying <= committed
+	applying uint64
 	// applied is the highest log position that the application has
-	// been instructed to apply to its state machine.
-	// Invariant: applied <= committed
+	// successfully applied to its state machine.
+	// Invariant: applied <= applying
 	applied uint64
+	// applyStableOnly restricts the entries handed out for applying to
+	// those already written to storage. It is set when storage writes are
+	// asynchronous (see Config.AsyncStorageWrites), in which case the
+	// application may apply entries before the corresponding append has
+	// completed.
+	applyStableOnly bool
 
 	logger Logger
 
@@ -71,16 +83,19 @@ func newLogWithSize(storage Storage, logger Logger, maxNextEntsSize uint64) *raf
 		panic(err) // TODO(bdarnell)
 	}
 	log.unstable.offset = lastIndex + 1
+	log.unstable.offsetInProgress = lastIndex + 1
 	log.unstable.logger = logger
 	// Initialize our committed and applied pointers to the time of the last compaction.
 	log.committed = firstIndex - 1
+	log.applying = firstIndex - 1
 	log.applied = firstIndex - 1
 
 	return log
 }
 
 func (l *raftLog) String() string {
-	return fmt.Sprintf("committed=%d, applied=%d, unstable.offset=%d, len(unstable.Entries)=%d", l.committed, l.applied, l.unstable.offset, len(l.unstable.entries))
+	return fmt.Sprintf("committed=%d, applied=%d, applying=%d, unstable.offset=%d, unstable.offsetInProgress=%d, len(unstable.Entries)=%d",
+		l.committed, l.applied, l.applying, l.unstable.offset, l.unstable.offsetInProgress, len(l.unstable.entries))
 }
 
 // maybeAppend returns (0, false) if the entries cannot be appended. Otherwise,
@@ -138,20 +153,27 @@ func (l *raftLog) findConflict(ents []pb.Entry) uint64 {
 	return 0
 }
 
+// unstableEntries returns the unstable entries that are not already in the
+// process of being written to storage.
 func (l *raftLog) unstableEntries() []pb.Entry {
-	if len(l.unstable.entries) == 0 {
-		return nil
-	}
-	return l.unstable.entries
+	return l.unstable.nextEntries()
+}
+
+// unstableSnapshot returns the unstable snapshot, if there is one that is not
+// already in the process of being written to storage.
+func (l *raftLog) unstableSnapshot() *pb.Snapshot {
+	return l.unstable.nextSnapshot()
 }
 
 // nextEnts returns all the available entries for execution.
 // If applied is smaller than the index of snapshot, it returns all committed
-// entries after the index of snapshot.
+// entries after the index of snapshot. Entries that are already being applied
+// are not returned again.
 func (l *raftLog) nextEnts() (ents []pb.Entry) {
-	off := max(l.applied+1, l.firstIndex())
-	if l.committed+1 > off {
-		ents, err := l.slice(off, l.committed+1, l.maxNextEntsSize)
+	off := max(l.applying+1, l.firstIndex())
+	hi := l.maxAppliableIndex()
+	if hi+1 > off {
+		ents, err := l.slice(off, hi+1, l.maxNextEntsSize)
 		if err != nil {
 			l.logger.Panicf("unexpected error when getting unapplied entries (%v)", err)
 		}
@@ -163,8 +185,20 @@ func (l *raftLog) nextEnts() (ents []pb.Entry) {
 // hasNextEnts returns if there is any available entries for execution. This
 // is a fast check without heavy raftLog.slice() in raftLog.nextEnts().
 func (l *raftLog) hasNextEnts() bool {
-	off := max(l.applied+1, l.firstIndex())
-	return l.committed+1 > off
+	off := max(l.applying+1, l.firstIndex())
+	return l.maxAppliableIndex()+1 > off
+}
+
+// maxAppliableIndex returns the highest index that may be handed to the
+// application for applying.
+func (l *raftLog) maxAppliableIndex() uint64 {
+	hi := l.committed
+	if l.applyStableOnly {
+		// Entries at or above the unstable offset (and those after an
+		// unstable snapshot) have not been written to storage yet.
+		hi = min(hi, l.unstable.offset-1)
+	}
+	return hi
 }
 
 func (l *raftLog) snapshot() (pb.Snapshot, error) {
@@ -214,8 +248,24 @@ func (l *raftLog) appliedTo(i uint64) {
 		l.logger.Panicf("applied(%d) is out of range [prevApplied(%d), committed(%d)]", i, l.applied, l.committed)
 	}
 	l.applied = i
+	l.applying = max(l.applying, i)
 }
 
+// acceptApplying records that the entries up to and including i have been
+// handed to the application for applying, so that they are not returned from
+// nextEnts again.
+func (l *raftLog) acceptApplying(i uint64) {
+	if l.committed < i {
+		l.logger.Panicf("applying(%d) is out of range [prevApplying(%d), committed(%d)]", i, l.applying, l.committed)
+	}
+	l.applying = max(l.applying, i)
+}
+
+// acceptUnstable marks the unstable entries and snapshot as being written to
+// storage, so that they are not returned from unstableEntries and
+// unstableSnapshot again.
+func (l *raftLog) acceptUnstable() { l.unstable.acceptInProgress() }
+
 func (l *raftLog) stableTo(i, t uint64) { l.unstable.stableTo(i, t) }
 
 func (l *raftLog) stableSnapTo(i uint64) { l.unstable.stableSnapTo(i) }
@@ -357,3 +357,81 @@ func TestUnstableTruncateAndAppend(t *testing.T) {
 		}
 	}
 }
+
+func TestUnstableAcceptInProgress(t *testing.T) {
+	snap := &pb.Snapshot{Metadata: pb.SnapshotMetadata{Index: 4, Term: 1}}
+	tests := []struct {
+		entries []pb.Entry
+		snap    *pb.Snapshot
+		// appended after acceptInProgress
+		appends []pb.Entry
+
+		wentries []pb.Entry
+		wsnap    *pb.Snapshot
+	}{
+		// nothing to accept
+		{nil, nil, nil, nil, nil},
+		// entries and snapshot are in progress
+		{[]pb.Entry{{Index: 5, Term: 1}}, snap, nil, nil, nil},
+		// new entries are returned
+		{
+			[]pb.Entry{{Index: 5, Term: 1}}, nil,
+			[]pb.Entry{{Index: 6, Term: 1}},
+			[]pb.Entry{{Index: 6, Term: 1}}, nil,
+		},
+		// truncation resets the in-progress entries after the conflict
+		{
+			[]pb.Entry{{Index: 5, Term: 1}, {Index: 6, Term: 1}}, nil,
+			[]pb.Entry{{Index: 6, Term: 2}},
+			[]pb.Entry{{Index: 6, Term: 2}}, nil,
+		},
+		// replacing all entries resets everything
+		{
+			[]pb.Entry{{Index: 5, Term: 1}, {Index: 6, Term: 1}}, nil,
+			[]pb.Entry{{Index: 5, Term: 2}},
+			[]pb.Entry{{Index: 5, Term: 2}}, nil,
+		},
+	}
+	for i, tt := range tests {
+		u := unstable{
+			entries:          tt.entries,
+			offset:           5,
+			offsetInProgress: 5,
+			snapshot:         tt.snap,
+			logger:           raftLogger,
+		}
+		u.acceptInProgress()
+		if len(tt.appends) > 0 {
+			u.truncateAndAppend(tt.appends)
+		}
+		if g := u.nextEntries(); !reflect.DeepEqual(g, tt.wentries) {
+			t.Errorf("#%d: nextEntries = %v, want %v", i, g, tt.wentries)
+		}
+		if g := u.nextSnapshot(); !reflect.DeepEqual(g, tt.wsnap) {
+			t.Errorf("#%d: nextSnapshot = %v, want %v", i, g, tt.wsnap)
+		}
+	}
+}
+
+func TestUnstableStableToInProgress(t *testing.T) {
+	u := unstable{
+		entries:          []pb.Entry{{Index: 5, Term: 1}, {Index: 6, Term: 1}},
+		offset:           5,
+		offsetInProgress: 5,
+		logger:           raftLogger,
+	}
+	u.acceptInProgress()
+	u.truncateAndAppend([]pb.Entry{{Index: 7, Term: 1}})
+	u.stableTo(5, 1)
+	if u.offset != 6 || u.offsetInProgress != 7 {
+		t.Errorf("offset, offsetInProgress = %d, %d, want 6, 7", u.offset, u.offsetInProgress)
+	}
+	wents := []pb.Entry{{Index: 7, Term: 1}}
+	if g := u.nextEntries(); !reflect.DeepEqual(g, wents) {
+		t.Errorf("nextEntries = %v, want %v", g, wents)
+	}
+	u.stableTo(7, 1)
+	if u.offset != 8 || u.offsetInProgress != 8 || u.nextEntries() != nil {
+		t.Errorf("offset, offsetInProgress = %d, %d, want 8, 8", u.offset, u.offsetInProgress)
+	}
+}
@@ -58,6 +58,9 @@ type Ready struct {
 	// The current state of a Node to be saved to stable storage BEFORE
 	// Messages are sent.
 	// HardState will be equal to empty state if there is no update.
+	//
+	// If async storage writes are enabled, this field does not need to be
+	// acted upon; it is carried by a MsgStorageAppend message instead.
 	pb.HardState
 
 	// ReadStates can be used for node to serve linearizable read requests locally
@@ -68,20 +71,34 @@ type Ready struct {
 
 	// Entries specifies entries to be saved to stable storage BEFORE
 	// Messages are sent.
+	//
+	// If async storage writes are enabled, this field does not need to be
+	// acted upon; it is carried by a MsgStorageAppend message instead.
 	Entries []pb.Entry
 
 	// Snapshot specifies the snapshot to be saved to stable storage.
+	//
+	// If async storage writes are enabled, this field does not need to be
+	// acted upon; it is carried by a MsgStorageAppend message instead.
 	Snapshot pb.Snapshot
 
 	// CommittedEntries specifies entries to be committed to a
 	// store/state-machine. These have previously been committed to stable
 	// store.
+	//
+	// If async storage writes are enabled, this field does not need to be
+	// acted upon; it is carried by a MsgStorageApply message instead.
 	CommittedEntries []pb.Entry
 
 	// Messages specifies outbound messages to be sent AFTER Entries are
 	// committed to stable storage.
 	// If it contains a MsgSnap message, the application MUST report back to raft
 	// when the snapshot has been received or has failed by calling ReportSnapshot.
+	//
+	// If async storage writes are enabled, the messages may be sent right
+	// away, and the MsgStorageAppend and MsgStorageApply messages addressed to
+	// LocalAppendThread and LocalApplyThread must be handed to the
+	// application's storage instead. See Config.AsyncStorageWrites.
 	Messages []pb.Message
 
 	// MustSync indicates whether the HardState and Entries must be synchronously
@@ -132,10 +149,22 @@ type Node interface {
 	// Propose proposes that data be appended to the log. Note that proposals can be lost without
 	// notice, therefore it is user's job to ensure proposal retries.
 	Propose(ctx context.Context, data []byte) error
-	// ProposeConfChange proposes config change.
-	// At most one ConfChange can be in the process of going through consensus.
-	// Application needs to call ApplyConfChange when applying EntryConfChange type entry.
-	ProposeConfChange(ctx context.Context, cc pb.ConfChange) error
+	// ProposeConfChange proposes a configuration change. Like any proposal, the
+	// configuration change may be dropped with or without an error being
+	// returned. In particular, configuration changes are dropped unless the
+	// leader has certainty that there is no prior unapplied configuration
+	// change in its log.
+	//
+	// The method accepts either a pb.ConfChange (deprecated) or pb.ConfChangeV2
+	// message. The latter allows arbitrary configuration changes via joint
+	// consensus, notably including replacing a voter. Passing a ConfChangeV2
+	// message is only allowed if all Nodes participating in the cluster run a
+	// version of this library aware of the V2 API. See pb.ConfChangeV2 for
+	// usage details and semantics.
+	//
+	// Application needs to call ApplyConfChange when applying EntryConfChange
+	// and EntryConfChangeV2 type entries.
+	ProposeConfChange(ctx context.Context, cc pb.ConfChangeI) error
 	// Step advances the state machine using the given message. ctx.Err() will be returned, if any.
 	Step(ctx context.Context, msg pb.Message) error
 
@@ -155,12 +184,17 @@ type Node interface {
 	// commands. For example. when the last Ready contains a snapshot, the application might take
 	// a long time to apply the snapshot data. To continue receiving Ready without blocking raft
 	// progress, it can call Advance before finishing applying the last ready.
+	//
+	// Advance must not be called when async storage writes are enabled; the
+	// responses of the local storage messages take its place.
 	Advance()
-	// ApplyConfChange applies config change to the local node.
-	// Returns an opaque ConfState protobuf which must be recorded
-	// in snapshots. Will never return nil; it returns a pointer only
-	// to match MemoryStorage.Compact.
-	ApplyConfChange(cc pb.ConfChange) *pb.ConfState
+	// ApplyConfChange applies a config change (previously passed to
+	// ProposeConfChange) to the node. This must be called whenever a config
+	// change is observed in Ready.CommittedEntries.
+	//
+	// Returns an opaque non-nil ConfState protobuf which must be recorded in
+	// snapshots.
+	ApplyConfChange(cc pb.ConfChangeI) *pb.ConfState
 
 	// TransferLeadership attempts to transfer leadership to the given transferee.
 	TransferLeadership(ctx context.Context, lead, transferee uint64)
@@ -256,7 +290,7 @@ type msgWithResult struct {
 type node struct {
 	propc      chan msgWithResult
 	recvc      chan pb.Message
-	confc      chan pb.ConfChange
+	confc      chan pb.ConfChangeV2
 	confstatec chan pb.ConfState
 	readyc     chan Ready
 	advancec   chan struct{}
@@ -272,7 +306,7 @@ func newNode() node {
 	return node{
 		propc:      make(chan msgWithResult),
 		recvc:      make(chan pb.Message),
-		confc:      make(chan pb.ConfChange),
+		confc:      make(chan pb.ConfChangeV2),
 		confstatec: make(chan pb.ConfState),
 		readyc:     make(chan Ready),
 		advancec:   make(chan struct{}),
@@ -357,35 +391,17 @@ func (n *node) run(r *raft) {
 				r.Step(m)
 			}
 		case cc := <-n.confc:
-			if cc.NodeID == None {
-				select {
-				case n.confstatec <- pb.ConfState{
-					Nodes:    r.nodes(),
-					Learners: r.learnerNodes()}:
-				case <-n.done:
-				}
-				break
-			}
-			switch cc.Type {
-			case pb.ConfChangeAddNode:
-				r.addNode(cc.NodeID)
-			case pb.ConfChangeAddLearnerNode:
-				r.addLearner(cc.NodeID)
-			case pb.ConfChangeRemoveNode:
-				// block incoming proposal when local node is
-				// removed
-				if cc.NodeID == r.id {
-					propc = nil
-				}
-				r.removeNode(cc.NodeID)
-			case pb.ConfChangeUpdateNode:
-			default:
-				panic("unexpected conf type")
+			okBefore := r.getProgress(r.id) != nil
+			cs := r.applyConfChange(cc)
+			// block incoming proposal when local node is removed. Note that
+			// this is only done if the node was part of the configuration
+			// before; a node may be catching up on the log without knowing
+			// that it is a member yet.
+			if okBefore && r.getProgress(r.id) == nil {
+				propc = nil
 			}
 			select {
-			case n.confstatec <- pb.ConfState{
-				Nodes:    r.nodes(),
-				Learners: r.learnerNodes()}:
+			case n.confstatec <- cs:
 			case <-n.done:
 			}
 		case <-n.tickc:
@@ -394,28 +410,34 @@ func (n *node) run(r *raft) {
 			if rd.SoftState != nil {
 				prevSoftSt = rd.SoftState
 			}
+			if !IsEmptyHardState(rd.HardState) {
+				prevHardSt = rd.HardState
+			}
+			r.msgs = nil
+			r.readStates = nil
+			r.reduceUncommittedSize(rd.CommittedEntries)
+			if r.asyncStorageWrites {
+				// The local storage messages in rd take the place of
+				// Advance; the next Ready may be handed out right away.
+				r.acceptReady(rd)
+				break
+			}
+
 			if len(rd.Entries) > 0 {
 				prevLastUnstablei = rd.Entries[len(rd.Entries)-1].Index
 				prevLastUnstablet = rd.Entries[len(rd.Entries)-1].Term
 				havePrevLastUnstablei = true
 			}
-			if !IsEmptyHardState(rd.HardState) {
-				prevHardSt = rd.HardState
-			}
 			if !IsEmptySnap(rd.Snapshot) {
 				prevSnapi = rd.Snapshot.Metadata.Index
 			}
 			if index := rd.appliedCursor(); index != 0 {
 				applyingToI = index
 			}
-
-			r.msgs = nil
-			r.readStates = nil
-			r.reduceUncommittedSize(rd.CommittedEntries)
 			advancec = n.advancec
 		case <-advancec:
 			if applyingToI != 0 {
-				r.raftLog.appliedTo(applyingToI)
+				r.appliedTo(applyingToI)
 				applyingToI = 0
 			}
 			if havePrevLastUnstablei {
@@ -452,19 +474,27 @@ func (n *node) Propose(ctx context.Context, data []byte) error {
 
 func (n *node) Step(ctx context.Context, m pb.Message) error {
 	// ignore unexpected local messages receiving over network
-	if IsLocalMsg(m.Type) {
+	if IsLocalMsg(m.Type) && !IsLocalMsgTarget(m.From) {
 		// TODO: return an error?
 		return nil
 	}
 	return n.step(ctx, m)
 }
 
-func (n *node) ProposeConfChange(ctx context.Context, cc pb.ConfChange) error {
-	data, err := cc.Marshal()
+func confChangeToMsg(c pb.ConfChangeI) (pb.Message, error) {
+	typ, data, err := pb.MarshalConfChange(c)
+	if err != nil {
+		return pb.Message{}, err
+	}
+	return pb.Message{Type: pb.MsgProp, Entries: []pb.Entry{{Type: typ, Data: data}}}, nil
+}
+
+func (n *node) ProposeConfChange(ctx context.Context, cc pb.ConfChangeI) error {
+	msg, err := confChangeToMsg(cc)
 	if err != nil {
 		return err
 	}
-	return n.Step(ctx, pb.Message{Type: pb.MsgProp, Entries: []pb.Entry{{Type: pb.EntryConfChange, Data: data}}})
+	return n.Step(ctx, msg)
 }
 
 func (n *node) step(ctx context.Context, m pb.Message) error {
@@ -525,10 +555,10 @@ func (n *node) Advance() {
 	}
 }
 
-func (n *node) ApplyConfChange(cc pb.ConfChange) *pb.ConfState {
+func (n *node) ApplyConfChange(cc pb.ConfChangeI) *pb.ConfState {
 	var cs pb.ConfState
 	select {
-	case n.confc <- cc:
+	case n.confc <- cc.AsV2():
 	case <-n.done:
 	}
 	select {
@@ -589,16 +619,96 @@ func newReady(r *raft, prevSoftSt *SoftState, prevHardSt pb.HardState) Ready {
 	if hardSt := r.hardState(); !isHardStateEqual(hardSt, prevHardSt) {
 		rd.HardState = hardSt
 	}
-	if r.raftLog.unstable.snapshot != nil {
-		rd.Snapshot = *r.raftLog.unstable.snapshot
+	if snap := r.raftLog.unstableSnapshot(); snap != nil {
+		rd.Snapshot = *snap
 	}
 	if len(r.readStates) != 0 {
 		rd.ReadStates = r.readStates
 	}
 	rd.MustSync = MustSync(r.hardState(), prevHardSt, len(rd.Entries))
+
+	if r.asyncStorageWrites {
+		// Hand the writes to the application's storage as local messages.
+		// Don't append to r.msgs in place: the Ready may be discarded and
+		// recomputed before it is accepted.
+		rd.Messages = rd.Messages[:len(rd.Messages):len(rd.Messages)]
+		if needStorageAppendMsg(r, rd) {
+			rd.Messages = append(rd.Messages, newStorageAppendMsg(r, rd))
+		}
+		if len(rd.CommittedEntries) > 0 {
+			rd.Messages = append(rd.Messages, newStorageApplyMsg(r, rd.CommittedEntries))
+		}
+	}
 	return rd
 }
 
+// needStorageAppendMsg reports whether rd contains state that must be written
+// to storage, or whether messages are waiting for such a write to complete.
+func needStorageAppendMsg(r *raft, rd Ready) bool {
+	return !IsEmptyHardState(rd.HardState) || len(rd.Entries) > 0 ||
+		!IsEmptySnap(rd.Snapshot) || len(r.msgsAfterAppend) > 0
+}
+
+// newStorageAppendMsg creates the MsgStorageAppend message that instructs
+// the LocalAppendThread to write the unstable state in rd to storage.
+func newStorageAppendMsg(r *raft, rd Ready) pb.Message {
+	m := pb.Message{
+		Type:     pb.MsgStorageAppend,
+		To:       LocalAppendThread,
+		From:     r.id,
+		Entries:  rd.Entries,
+		Snapshot: rd.Snapshot,
+	}
+	if !IsEmptyHardState(rd.HardState) {
+		m.Term = rd.Term
+		m.Vote = rd.Vote
+		m.Commit = rd.Commit
+	}
+	// Once the write is durable, deliver the messages that were waiting for
+	// it, followed by the acknowledgement of the write itself.
+	m.Responses = append(r.msgsAfterAppend[:len(r.msgsAfterAppend):len(r.msgsAfterAppend)], newStorageAppendRespMsg(r, rd))
+	return m
+}
+
+// newStorageAppendRespMsg creates the MsgStorageAppendResp message that tells
+// raft that the entries and snapshot in rd have been written to storage.
+func newStorageAppendRespMsg(r *raft, rd Ready) pb.Message {
+	m := pb.Message{
+		Type: pb.MsgStorageAppendResp,
+		To:   r.id,
+		From: LocalAppendThread,
+		// The response is ignored (except for the snapshot) if the term has
+		// changed by the time it is stepped, since the entries may have been
+		// overwritten in the meantime.
+		Term: r.Term,
+	}
+	if n := len(rd.Entries); n > 0 {
+		m.Index = rd.Entries[n-1].Index
+		m.LogTerm = rd.Entries[n-1].Term
+	}
+	if !IsEmptySnap(rd.Snapshot) {
+		m.Snapshot = pb.Snapshot{Metadata: rd.Snapshot.Metadata}
+	}
+	return m
+}
+
+// newStorageApplyMsg creates the MsgStorageApply message that instructs the
+// LocalApplyThread to apply the given committed entries.
+func newStorageApplyMsg(r *raft, ents []pb.Entry) pb.Message {
+	return pb.Message{
+		Type:    pb.MsgStorageApply,
+		To:      LocalApplyThread,
+		From:    r.id,
+		Entries: ents,
+		Responses: []pb.Message{{
+			Type:    pb.MsgStorageApplyResp,
+			To:      r.id,
+			From:    LocalApplyThread,
+			Entries: ents,
+		}},
+	}
+}
+
 // MustSync returns true if the hard state and count of Raft entries indicate
 // that a synchronous write to persistent storage is required.
 func MustSync(st, prevst pb.HardState, entsnum int) bool {
@@ -1051,3 +1051,47 @@ func TestNodeBoundedLogGrowthWithPartition(t *testing.T) {
 	n.Advance()
 	checkUncommitted(0)
 }
+
+// TestNodeAsyncStorageWrites ensures that a Node with async storage writes
+// hands out Ready without Advance and commits and applies proposals once the
+// local storage messages have been processed.
+func TestNodeAsyncStorageWrites(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := NewMemoryStorage()
+	cfg := newTestConfig(1, []uint64{1}, 10, 1, s)
+	cfg.AsyncStorageWrites = true
+	n := newNode()
+	r := newRaft(cfg)
+	go n.run(r)
+	defer n.Stop()
+	a := &asyncStorage{id: 1, step: func(m raftpb.Message) error { return n.Step(ctx, m) }, s: s}
+
+	n.Campaign(ctx)
+	data := []byte("somedata")
+	proposed := false
+	for {
+		select {
+		case rd := <-n.Ready():
+			if msgs := a.queue(rd); len(msgs) != 0 {
+				t.Fatalf("unexpected messages: %v", msgs)
+			}
+			a.process(t)
+			if rd.SoftState != nil && rd.SoftState.Lead == r.id && !proposed {
+				if err := n.Propose(ctx, data); err != nil {
+					t.Fatal(err)
+				}
+				proposed = true
+			}
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the proposal to be applied")
+		}
+		if len(a.applied) > 0 {
+			break
+		}
+	}
+	if !bytes.Equal(a.applied[0].Data, data) {
+		t.Fatalf("applied = %+v, want entry with %q", a.applied, data)
+	}
+}
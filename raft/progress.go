@@ -14,7 +14,11 @@
 
 package raft
 
-import "fmt"
+import (
+	"fmt"
+
+	"go.etcd.io/etcd/raft/tracker"
+)
 
 const (
 	ProgressStateProbe ProgressStateType = iota
@@ -80,6 +84,11 @@ type Progress struct {
 
 	// IsLearner is true if this progress is tracked for a learner.
 	IsLearner bool
+
+	// heartbeatAck is the leader tick carried by the most recent heartbeat
+	// the follower has acknowledged, or zero if it has not acknowledged any
+	// in the current term.
+	heartbeatAck uint64
 }
 
 func (pr *Progress) resetState(state ProgressStateType) {
@@ -282,3 +291,41 @@ func (in *inflights) reset() {
 	in.count = 0
 	in.start = 0
 }
+
+// matchAckIndexer is an implementation of tracker.AckedIndexer that reports
+// the Match index of each Progress.
+type matchAckIndexer map[uint64]*Progress
+
+var _ tracker.AckedIndexer = matchAckIndexer(nil)
+
+// AckedIndex implements tracker.AckedIndexer.
+func (l matchAckIndexer) AckedIndex(id uint64) (uint64, bool) {
+	pr, ok := l[id]
+	if !ok {
+		return 0, false
+	}
+	return pr.Match, true
+}
+
+// heartbeatAckIndexer is an implementation of tracker.AckedIndexer that
+// reports the leader tick of the latest heartbeat acknowledged by each
+// Progress. The leader itself is reported at the current tick.
+type heartbeatAckIndexer struct {
+	prs  map[uint64]*Progress
+	id   uint64
+	tick uint64
+}
+
+var _ tracker.AckedIndexer = heartbeatAckIndexer{}
+
+// AckedIndex implements tracker.AckedIndexer.
+func (l heartbeatAckIndexer) AckedIndex(id uint64) (uint64, bool) {
+	if id == l.id {
+		return l.tick, true
+	}
+	pr, ok := l.prs[id]
+	if !ok {
+		return 0, false
+	}
+	return pr.heartbeatAck, true
+}
@@ -26,12 +26,23 @@ import (
 	"time"
 
 	pb "go.etcd.io/etcd/raft/raftpb"
+	"go.etcd.io/etcd/raft/tracker"
 )
 
 // None is a placeholder node ID used when there is no leader.
 const None uint64 = 0
 const noLimit = math.MaxUint64
 
+// LocalAppendThread and LocalApplyThread are the targets of the local storage
+// messages emitted when Config.AsyncStorageWrites is set. Responses from those
+// threads carry the same IDs in their From field.
+const (
+	// LocalAppendThread is the target of MsgStorageAppend messages.
+	LocalAppendThread uint64 = math.MaxUint64
+	// LocalApplyThread is the target of MsgStorageApply messages.
+	LocalApplyThread uint64 = math.MaxUint64 - 1
+)
+
 // Possible values for StateType.
 const (
 	StateFollower StateType = iota
@@ -189,8 +200,20 @@ type Config struct {
 	// should (clock can move backward/pause without any bound). ReadIndex is not safe
 	// in that case.
 	// CheckQuorum MUST be enabled if ReadOnlyOption is ReadOnlyLeaseBased.
+	//
+	// The lease starts when the leader sends a heartbeat which is then
+	// acknowledged by a quorum, and lasts for ElectionTick ticks minus one tick
+	// of rounding and minus LeaseClockDriftTicks. While the lease is held, read
+	// only requests are answered without any communication. Otherwise they fall
+	// back to ReadOnlySafe.
 	ReadOnlyOption ReadOnlyOption
 
+	// LeaseClockDriftTicks is the number of ticks by which the lease of a
+	// leader using ReadOnlyLeaseBased is shortened to account for clocks (or
+	// tick intervals) of the other members running faster than the leader's.
+	// It must leave the lease at least one tick long.
+	LeaseClockDriftTicks int
+
 	// Logger is the logger used for raft log. For multinode which can host
 	// multiple raft group, each raft group can have its own logger
 	Logger Logger
@@ -204,6 +227,35 @@ type Config struct {
 	// logical clock from assigning the timestamp and then forwarding the data
 	// to the leader.
 	DisableProposalForwarding bool
+
+	// AsyncStorageWrites configures the raft node to hand the writes to its
+	// local storage (raft log and state machine) to the application as
+	// messages instead of through the Ready/Advance interface. This allows the
+	// application to pipeline appending to the log (and its fsync) with
+	// applying committed entries and with the next Ready, which reduces
+	// commit latency when the log is slow to sync.
+	//
+	// When set, Ready.Messages includes a MsgStorageAppend message addressed
+	// to LocalAppendThread and a MsgStorageApply message addressed to
+	// LocalApplyThread whenever there is work for them; Advance must not be
+	// called. The messages take over the role of the Entries, HardState,
+	// Snapshot and CommittedEntries fields of Ready, which remain populated
+	// for informational purposes only.
+	//
+	// MsgStorageAppend carries entries to append (Entries), a HardState to
+	// persist (Term, Vote and Commit, when any of them is non-zero) and a
+	// snapshot to persist and apply (Snapshot, when not empty). All writes
+	// must be durable before its responses are delivered.
+	//
+	// MsgStorageApply carries committed entries to apply to the state machine.
+	// The application must skip entries at or below the index of a snapshot
+	// it has already applied.
+	//
+	// Each local message carries the messages (Responses) to deliver once the
+	// work has been done: those addressed to this node are passed to Step,
+	// the others are sent to their peers. Messages to the same thread must be
+	// processed in order and must not be dropped.
+	AsyncStorageWrites bool
 }
 
 func (c *Config) validate() error {
@@ -245,6 +297,14 @@ func (c *Config) validate() error {
 		return errors.New("CheckQuorum must be enabled when ReadOnlyOption is ReadOnlyLeaseBased")
 	}
 
+	if c.LeaseClockDriftTicks < 0 {
+		return errors.New("lease clock drift ticks must not be negative")
+	}
+
+	if c.ReadOnlyOption == ReadOnlyLeaseBased && c.ElectionTick-1-c.LeaseClockDriftTicks < 1 {
+		return errors.New("lease clock drift ticks must be less than election tick minus one")
+	}
+
 	return nil
 }
 
@@ -264,16 +324,41 @@ type raft struct {
 	maxInflight        int
 	prs                map[uint64]*Progress
 	learnerPrs         map[uint64]*Progress
-	matchBuf           uint64Slice
+
+	// voters holds the IDs of the voters in the incoming (voters[0]) and the
+	// outgoing (voters[1]) configuration. The outgoing configuration is empty
+	// unless a joint configuration is in effect; prs tracks the voters of
+	// both.
+	voters tracker.JointConfig
+	// learnersNext holds the voters of the outgoing configuration that will
+	// become learners once the joint configuration is left.
+	learnersNext map[uint64]struct{}
+	// autoLeave is true if the joint configuration should be left
+	// automatically once the configuration change that entered it has been
+	// applied on the leader.
+	autoLeave bool
 
 	state StateType
 
 	// isLearner is true if the local raft node is a learner.
 	isLearner bool
+	// witnesses holds the voters that are witnesses. A witness votes and
+	// acknowledges entries like any other voter but never becomes the target
+	// of a leadership transfer. It only campaigns after a longer timeout, to
+	// make progress if it holds the only up-to-date log besides a failed
+	// leader, and then hands leadership to a regular voter right away.
+	witnesses map[uint64]struct{}
+	// isWitness is true if the local raft node is a witness.
+	isWitness bool
 
 	votes map[uint64]bool
 
 	msgs []pb.Message
+	// msgsAfterAppend contains the messages that must only be delivered after
+	// the unstable state handed out so far (entries, term, vote and snapshot)
+	// has been written to storage. It is only used with asyncStorageWrites;
+	// the messages are attached to the next MsgStorageAppend.
+	msgsAfterAppend []pb.Message
 
 	// the leader id
 	lead uint64
@@ -304,6 +389,18 @@ type raft struct {
 	// only leader keeps heartbeatElapsed.
 	heartbeatElapsed int
 
+	// number of ticks since it became leader, starting at one. With
+	// ReadOnlyLeaseBased, heartbeats carry it in their Index and followers echo
+	// it back, which tells the
+	// leader when a quorum last acknowledged it. Only leader keeps leaderTicks.
+	leaderTicks uint64
+	// leaseTicks is the length of the lease used for ReadOnlyLeaseBased reads.
+	leaseTicks uint64
+	// leaseRevoked is set once the leader has sent MsgTimeoutNow in its term.
+	// The recipient campaigns regardless of the followers' leases, so the
+	// leader can no longer rely on its own.
+	leaseRevoked bool
+
 	checkQuorum bool
 	preVote     bool
 
@@ -314,6 +411,8 @@ type raft struct {
 	// when raft changes its state to follower or candidate.
 	randomizedElectionTimeout int
 	disableProposalForwarding bool
+	// asyncStorageWrites is set from Config.AsyncStorageWrites.
+	asyncStorageWrites bool
 
 	tick func()
 	step stepFunc
@@ -332,7 +431,7 @@ func newRaft(c *Config) *raft {
 	}
 	peers := c.peers
 	learners := c.learners
-	if len(cs.Nodes) > 0 || len(cs.Learners) > 0 {
+	if len(cs.Nodes) > 0 || len(cs.Learners) > 0 || len(cs.VotersOutgoing) > 0 {
 		if len(peers) > 0 || len(learners) > 0 {
 			// TODO(bdarnell): the peers argument is always nil except in
 			// tests; the argument should be removed and these tests should be
@@ -352,6 +451,10 @@ func newRaft(c *Config) *raft {
 		maxUncommittedSize:        c.MaxUncommittedEntriesSize,
 		prs:                       make(map[uint64]*Progress),
 		learnerPrs:                make(map[uint64]*Progress),
+		voters:                    tracker.JointConfig{tracker.MajorityConfig{}, tracker.MajorityConfig{}},
+		learnersNext:              make(map[uint64]struct{}),
+		witnesses:                 make(map[uint64]struct{}),
+		autoLeave:                 cs.AutoLeave,
 		electionTimeout:           c.ElectionTick,
 		heartbeatTimeout:          c.HeartbeatTick,
 		logger:                    c.Logger,
@@ -359,10 +462,30 @@ func newRaft(c *Config) *raft {
 		preVote:                   c.PreVote,
 		readOnly:                  newReadOnly(c.ReadOnlyOption),
 		disableProposalForwarding: c.DisableProposalForwarding,
+		asyncStorageWrites:        c.AsyncStorageWrites,
+		leaseTicks:                uint64(c.ElectionTick - 1 - c.LeaseClockDriftTicks),
 	}
+	raftlog.applyStableOnly = c.AsyncStorageWrites
 	for _, p := range peers {
+		r.voters[0][p] = struct{}{}
 		r.prs[p] = &Progress{Next: 1, ins: newInflights(r.maxInflight)}
 	}
+	for _, p := range cs.VotersOutgoing {
+		r.voters[1][p] = struct{}{}
+		if _, ok := r.prs[p]; !ok {
+			r.prs[p] = &Progress{Next: 1, ins: newInflights(r.maxInflight)}
+		}
+	}
+	for _, p := range cs.LearnersNext {
+		r.learnersNext[p] = struct{}{}
+	}
+	for _, p := range cs.Witnesses {
+		if _, ok := r.prs[p]; !ok {
+			panic(fmt.Sprintf("witness %x is not a voter", p))
+		}
+		r.witnesses[p] = struct{}{}
+	}
+	_, r.isWitness = r.witnesses[r.id]
 	for _, p := range learners {
 		if _, ok := r.prs[p]; ok {
 			panic(fmt.Sprintf("node %x is in both learner and peer list", p))
@@ -403,15 +526,9 @@ func (r *raft) hardState() pb.HardState {
 	}
 }
 
-func (r *raft) quorum() int { return len(r.prs)/2 + 1 }
-
+// nodes returns the voters of the incoming configuration.
 func (r *raft) nodes() []uint64 {
-	nodes := make([]uint64, 0, len(r.prs))
-	for id := range r.prs {
-		nodes = append(nodes, id)
-	}
-	sort.Sort(uint64Slice(nodes))
-	return nodes
+	return r.voters[0].Slice()
 }
 
 func (r *raft) learnerNodes() []uint64 {
@@ -454,6 +571,14 @@ func (r *raft) send(m pb.Message) {
 			m.Term = r.Term
 		}
 	}
+	if r.asyncStorageWrites && (m.To == r.id || m.Type == pb.MsgAppResp || m.Type == pb.MsgVoteResp || m.Type == pb.MsgPreVoteResp) {
+		// These messages acknowledge log entries or a vote that may not have
+		// been written to storage yet, so they must wait for the local append.
+		// Self-addressed messages are used to count the leader's own log and
+		// a candidate's own vote once they are durable.
+		r.msgsAfterAppend = append(r.msgsAfterAppend, m)
+		return
+	}
 	r.msgs = append(r.msgs, m)
 }
 
@@ -553,6 +678,9 @@ func (r *raft) sendHeartbeat(to uint64, ctx []byte) {
 		Commit:  commit,
 		Context: ctx,
 	}
+	if r.readOnly.option == ReadOnlyLeaseBased {
+		m.Index = r.leaderTicks
+	}
 
 	r.send(m)
 }
@@ -602,19 +730,7 @@ func (r *raft) bcastHeartbeatWithCtx(ctx []byte) {
 // the commit index changed (in which case the caller should call
 // r.bcastAppend).
 func (r *raft) maybeCommit() bool {
-	// Preserving matchBuf across calls is an optimization
-	// used to avoid allocating a new slice on each call.
-	if cap(r.matchBuf) < len(r.prs) {
-		r.matchBuf = make(uint64Slice, len(r.prs))
-	}
-	mis := r.matchBuf[:len(r.prs)]
-	idx := 0
-	for _, p := range r.prs {
-		mis[idx] = p.Match
-		idx++
-	}
-	sort.Sort(mis)
-	mci := mis[len(mis)-r.quorum()]
+	mci := r.voters.CommittedIndex(matchAckIndexer(r.prs))
 	return r.raftLog.maybeCommit(mci, r.Term)
 }
 
@@ -627,6 +743,8 @@ func (r *raft) reset(term uint64) {
 
 	r.electionElapsed = 0
 	r.heartbeatElapsed = 0
+	r.leaderTicks = 0
+	r.leaseRevoked = false
 	r.resetRandomizedElectionTimeout()
 
 	r.abortLeaderTransfer()
@@ -661,12 +779,62 @@ func (r *raft) appendEntry(es ...pb.Entry) (accepted bool) {
 	}
 	// use latest "last" index after truncate/append
 	li = r.raftLog.append(es...)
-	r.getProgress(r.id).maybeUpdate(li)
+	if r.asyncStorageWrites {
+		// The leader counts towards the quorum only once the entries have
+		// been written to its storage, which it learns about through this
+		// self-addressed response.
+		r.send(pb.Message{To: r.id, Type: pb.MsgAppResp, Index: li})
+	} else {
+		r.getProgress(r.id).maybeUpdate(li)
+	}
 	// Regardless of maybeCommit's return, our caller will call bcastAppend.
 	r.maybeCommit()
 	return true
 }
 
+// appliedTo advances the applied index of the log. On the leader, it also
+// proposes to leave the joint configuration once the configuration change that
+// entered it has been applied, if the transition is to happen automatically.
+func (r *raft) appliedTo(index uint64) {
+	r.raftLog.appliedTo(index)
+
+	if r.state == StateLeader && r.voters.IsJoint() && r.autoLeave && index >= r.pendingConfIndex {
+		ccdata, err := (&pb.ConfChangeV2{}).Marshal()
+		if err != nil {
+			panic(err)
+		}
+		m := pb.Message{
+			From:    r.id,
+			Type:    pb.MsgProp,
+			Entries: []pb.Entry{{Type: pb.EntryConfChangeV2, Data: ccdata}},
+		}
+		if err := r.Step(m); err != nil {
+			r.logger.Debugf("%x not initiating automatic transition out of joint configuration: %v", r.id, err)
+		} else {
+			r.logger.Infof("%x initiating automatic transition out of joint configuration", r.id)
+		}
+	}
+}
+
+// appliedSnap records that the snapshot at the given index has been written to
+// storage and applied to the state machine.
+func (r *raft) appliedSnap(index uint64) {
+	r.raftLog.stableSnapTo(index)
+	r.appliedTo(index)
+}
+
+// acceptReady is called when the application has received rd with async
+// storage writes enabled. The local storage messages in rd now carry the
+// unstable state, the committed entries and the messages waiting for the
+// append, so none of these must be handed out again.
+func (r *raft) acceptReady(rd Ready) {
+	r.raftLog.acceptUnstable()
+	if n := len(rd.CommittedEntries); n > 0 {
+		r.raftLog.acceptApplying(rd.CommittedEntries[n-1].Index)
+	}
+	r.msgsAfterAppend = nil
+}
+
 // tickElection is run by followers and candidates after r.electionTimeout.
 func (r *raft) tickElection() {
 	r.electionElapsed++
@@ -681,6 +849,7 @@ func (r *raft) tickElection() {
 func (r *raft) tickHeartbeat() {
 	r.heartbeatElapsed++
 	r.electionElapsed++
+	r.leaderTicks++
 
 	if r.electionElapsed >= r.electionTimeout {
 		r.electionElapsed = 0
@@ -697,6 +866,10 @@ func (r *raft) tickHeartbeat() {
 		return
 	}
 
+	if r.isWitness && r.leadTransferee == None {
+		r.handOffLeadership()
+	}
+
 	if r.heartbeatElapsed >= r.heartbeatTimeout {
 		r.heartbeatElapsed = 0
 		r.Step(pb.Message{From: r.id, Type: pb.MsgBeat})
@@ -750,6 +923,7 @@ func (r *raft) becomeLeader() {
 	r.tick = r.tickHeartbeat
 	r.lead = r.id
 	r.state = StateLeader
+	r.leaderTicks = 1
 	// Followers enter replicate mode when they've been successfully probed
 	// (perhaps after having received a snapshot as a result). The leader is
 	// trivially in this state. Note that r.reset() has initialized this
@@ -789,7 +963,11 @@ func (r *raft) campaign(t CampaignType) {
 		voteMsg = pb.MsgVote
 		term = r.Term
 	}
-	if r.quorum() == r.poll(r.id, voteRespMsgType(voteMsg), true) {
+	if r.asyncStorageWrites {
+		// Our own vote counts only once it has been written to storage, at
+		// which point the response below is stepped back into this node.
+		r.send(pb.Message{To: r.id, Term: term, Type: voteRespMsgType(voteMsg)})
+	} else if _, _, res := r.poll(r.id, voteRespMsgType(voteMsg), true); res == tracker.VoteWon {
 		// We won the election after voting for ourselves (which must mean that
 		// this is a single-node cluster). Advance to the next state.
 		if t == campaignPreElection {
@@ -814,7 +992,10 @@ func (r *raft) campaign(t CampaignType) {
 	}
 }
 
-func (r *raft) poll(id uint64, t pb.MessageType, v bool) (granted int) {
+// poll records the vote of the given ID and returns the number of granted
+// and rejected votes received so far, along with the outcome of the election
+// under the current (possibly joint) configuration.
+func (r *raft) poll(id uint64, t pb.MessageType, v bool) (granted int, rejected int, result tracker.VoteResult) {
 	if v {
 		r.logger.Infof("%x received %s from %x at term %d", r.id, t, id, r.Term)
 	} else {
@@ -826,9 +1007,11 @@ func (r *raft) poll(id uint64, t pb.MessageType, v bool) (granted int) {
 	for _, vv := range r.votes {
 		if vv {
 			granted++
+		} else {
+			rejected++
 		}
 	}
-	return granted
+	return granted, rejected, r.voters.VoteResult(r.votes)
 }
 
 func (r *raft) Step(m pb.Message) error {
@@ -898,6 +1081,14 @@ func (r *raft) Step(m pb.Message) error {
 			r.logger.Infof("%x [logterm: %d, index: %d, vote: %x] rejected %s from %x [logterm: %d, index: %d] at term %d",
 				r.id, r.raftLog.lastTerm(), r.raftLog.lastIndex(), r.Vote, m.Type, m.From, m.LogTerm, m.Index, r.Term)
 			r.send(pb.Message{To: m.From, Term: r.Term, Type: pb.MsgPreVoteResp, Reject: true})
+		} else if m.Type == pb.MsgStorageAppendResp {
+			// The appended entries may have been overwritten in the unstable
+			// log during the later term, so they can't be marked as stable
+			// here. A snapshot carries committed state, so its application is
+			// still valid.
+			if !IsEmptySnap(m.Snapshot) {
+				r.appliedSnap(m.Snapshot.Metadata.Index)
+			}
 		} else {
 			// ignore other cases
 			r.logger.Infof("%x [term: %d] ignored a %s message with lower term from %x [term: %d]",
@@ -907,6 +1098,21 @@ func (r *raft) Step(m pb.Message) error {
 	}
 
 	switch m.Type {
+	case pb.MsgStorageAppendResp:
+		if m.Index != 0 {
+			r.raftLog.stableTo(m.Index, m.LogTerm)
+		}
+		if !IsEmptySnap(m.Snapshot) {
+			r.appliedSnap(m.Snapshot.Metadata.Index)
+		}
+
+	case pb.MsgStorageApplyResp:
+		// A snapshot applied by the append thread may have overtaken entries
+		// that were being applied at the same time; they are superseded by it.
+		if n := len(m.Entries); n > 0 && m.Entries[n-1].Index > r.raftLog.applied {
+			r.appliedTo(m.Entries[n-1].Index)
+		}
+
 	case pb.MsgHup:
 		if r.state != StateLeader {
 			ents, err := r.raftLog.slice(r.raftLog.applied+1, r.raftLog.committed+1, noLimit)
@@ -1005,14 +1211,43 @@ func stepLeader(r *raft, m pb.Message) error {
 		}
 
 		for i, e := range m.Entries {
-			if e.Type == pb.EntryConfChange {
-				if r.pendingConfIndex > r.raftLog.applied {
-					r.logger.Infof("propose conf %s ignored since pending unapplied configuration [index %d, applied %d]",
-						e.String(), r.pendingConfIndex, r.raftLog.applied)
-					m.Entries[i] = pb.Entry{Type: pb.EntryNormal}
-				} else {
-					r.pendingConfIndex = r.raftLog.lastIndex() + uint64(i) + 1
+			var cc pb.ConfChangeI
+			switch e.Type {
+			case pb.EntryConfChange:
+				var ccc pb.ConfChange
+				if err := ccc.Unmarshal(e.Data); err != nil {
+					panic(err)
+				}
+				cc = ccc
+			case pb.EntryConfChangeV2:
+				var ccc pb.ConfChangeV2
+				if err := ccc.Unmarshal(e.Data); err != nil {
+					panic(err)
 				}
+				cc = ccc
+			}
+			if cc == nil {
+				continue
+			}
+
+			alreadyJoint := r.voters.IsJoint()
+			wantsLeaveJoint := len(cc.AsV2().Changes) == 0
+
+			var refused string
+			switch {
+			case r.pendingConfIndex > r.raftLog.applied:
+				refused = fmt.Sprintf("pending unapplied configuration [index %d, applied %d]", r.pendingConfIndex, r.raftLog.applied)
+			case alreadyJoint && !wantsLeaveJoint:
+				refused = "must transition out of joint configuration first"
+			case !alreadyJoint && wantsLeaveJoint:
+				refused = "not in joint configuration; refusing empty conf change"
+			}
+
+			if refused != "" {
+				r.logger.Infof("propose conf %s ignored since %s", e.String(), refused)
+				m.Entries[i] = pb.Entry{Type: pb.EntryNormal}
+			} else {
+				r.pendingConfIndex = r.raftLog.lastIndex() + uint64(i) + 1
 			}
 		}
 
@@ -1022,7 +1257,7 @@ func stepLeader(r *raft, m pb.Message) error {
 		r.bcastAppend()
 		return nil
 	case pb.MsgReadIndex:
-		if r.quorum() > 1 {
+		if !r.isSingleton() {
 			if r.raftLog.zeroTermOnErrCompacted(r.raftLog.term(r.raftLog.committed)) != r.Term {
 				// Reject read only request when this leader has not committed any log entry at its term.
 				return nil
@@ -1034,11 +1269,21 @@ func stepLeader(r *raft, m pb.Message) error {
 			switch r.readOnly.option {
 			case ReadOnlySafe:
 				r.readOnly.addRequest(r.raftLog.committed, m)
+				// The local node automatically acks the request.
+				r.readOnly.recvAck(r.id, m.Entries[0].Data)
 				r.bcastHeartbeatWithCtx(m.Entries[0].Data)
 			case ReadOnlyLeaseBased:
+				if !r.inLeaderLease() {
+					// The lease has expired (or was never established), so
+					// confirm the leadership with a round of heartbeats.
+					r.readOnly.addRequest(r.raftLog.committed, m)
+					r.readOnly.recvAck(r.id, m.Entries[0].Data)
+					r.bcastHeartbeatWithCtx(m.Entries[0].Data)
+					return nil
+				}
 				ri := r.raftLog.committed
 				if m.From == None || m.From == r.id { // from local member
-					r.readStates = append(r.readStates, ReadState{Index: r.raftLog.committed, RequestCtx: m.Entries[0].Data})
+					r.readStates = append(r.readStates, ReadState{Index: r.raftLog.committed, RequestCtx: m.Entries[0].Data, LeaseBased: true})
 				} else {
 					r.send(pb.Message{To: m.From, Type: pb.MsgReadIndexResp, Index: ri, Entries: m.Entries})
 				}
@@ -1089,6 +1334,15 @@ func stepLeader(r *raft, m pb.Message) error {
 					pr.ins.freeTo(m.Index)
 				}
 
+				if m.From == r.id {
+					// The leader's own entries have been written to storage
+					// (see Config.AsyncStorageWrites). They may now be
+					// committed, but there is nothing to send to ourselves.
+					if r.maybeCommit() {
+						r.bcastAppend()
+					}
+					return nil
+				}
 				if r.maybeCommit() {
 					r.bcastAppend()
 				} else if oldPaused {
@@ -1114,6 +1368,9 @@ func stepLeader(r *raft, m pb.Message) error {
 	case pb.MsgHeartbeatResp:
 		pr.RecentActive = true
 		pr.resume()
+		if m.Index > pr.heartbeatAck {
+			pr.heartbeatAck = m.Index
+		}
 
 		// free one slot for the full inflights window to allow progress.
 		if pr.State == ProgressStateReplicate && pr.ins.full() {
@@ -1123,12 +1380,11 @@ func stepLeader(r *raft, m pb.Message) error {
 			r.sendAppend(m.From)
 		}
 
-		if r.readOnly.option != ReadOnlySafe || len(m.Context) == 0 {
+		if len(m.Context) == 0 {
 			return nil
 		}
 
-		ackCount := r.readOnly.recvAck(m)
-		if ackCount < r.quorum() {
+		if r.voters.VoteResult(r.readOnly.recvAck(m.From, m.Context)) != tracker.VoteWon {
 			return nil
 		}
 
@@ -1169,6 +1425,10 @@ func stepLeader(r *raft, m pb.Message) error {
 			r.logger.Debugf("%x is learner. Ignored transferring leadership", r.id)
 			return nil
 		}
+		if _, ok := r.witnesses[m.From]; ok {
+			r.logger.Infof("%x [term %d] ignored transferring leadership to witness %x", r.id, r.Term, m.From)
+			return nil
+		}
 		leadTransferee := m.From
 		lastLeadTransferee := r.leadTransferee
 		if lastLeadTransferee != None {
@@ -1225,17 +1485,17 @@ func stepCandidate(r *raft, m pb.Message) error {
 		r.becomeFollower(m.Term, m.From) // always m.Term == r.Term
 		r.handleSnapshot(m)
 	case myVoteRespType:
-		gr := r.poll(m.From, m.Type, !m.Reject)
-		r.logger.Infof("%x [quorum:%d] has received %d %s votes and %d vote rejections", r.id, r.quorum(), gr, m.Type, len(r.votes)-gr)
-		switch r.quorum() {
-		case gr:
+		gr, rj, res := r.poll(m.From, m.Type, !m.Reject)
+		r.logger.Infof("%x has received %d %s votes and %d vote rejections", r.id, gr, m.Type, rj)
+		switch res {
+		case tracker.VoteWon:
 			if r.state == StatePreCandidate {
 				r.campaign(campaignElection)
 			} else {
 				r.becomeLeader()
 				r.bcastAppend()
 			}
-		case len(r.votes) - gr:
+		case tracker.VoteLost:
 			// pb.MsgPreVoteResp contains future term of pre-candidate
 			// m.Term > r.Term; reuse r.Term
 			r.becomeFollower(r.Term, None)
@@ -1278,7 +1538,9 @@ func stepFollower(r *raft, m pb.Message) error {
 		m.To = r.lead
 		r.send(m)
 	case pb.MsgTimeoutNow:
-		if r.promotable() {
+		if r.isWitness {
+			r.logger.Infof("%x received MsgTimeoutNow from %x but is a witness", r.id, m.From)
+		} else if r.promotable() {
 			r.logger.Infof("%x [term %d] received MsgTimeoutNow from %x and starts an election to get leadership.", r.id, r.Term, m.From)
 			// Leadership transfers never use pre-vote even if r.preVote is true; we
 			// know we are not recovering from a partition so there is no need for the
@@ -1321,7 +1583,7 @@ func (r *raft) handleAppendEntries(m pb.Message) {
 
 func (r *raft) handleHeartbeat(m pb.Message) {
 	r.raftLog.commitTo(m.Commit)
-	r.send(pb.Message{To: m.From, Type: pb.MsgHeartbeatResp, Context: m.Context})
+	r.send(pb.Message{To: m.From, Type: pb.MsgHeartbeatResp, Index: m.Index, Context: m.Context})
 }
 
 func (r *raft) handleSnapshot(m pb.Message) {
@@ -1364,11 +1626,35 @@ func (r *raft) restore(s pb.Snapshot) bool {
 		r.id, r.raftLog.committed, r.raftLog.lastIndex(), r.raftLog.lastTerm(), s.Metadata.Index, s.Metadata.Term)
 
 	r.raftLog.restore(s)
+	r.restoreConfState(s.Metadata.ConfState)
+	return true
+}
+
+// restoreConfState replaces the voters, learners and Progress tracked by r
+// with the (possibly joint) configuration described by cs.
+func (r *raft) restoreConfState(cs pb.ConfState) {
 	r.prs = make(map[uint64]*Progress)
 	r.learnerPrs = make(map[uint64]*Progress)
-	r.restoreNode(s.Metadata.ConfState.Nodes, false)
-	r.restoreNode(s.Metadata.ConfState.Learners, true)
-	return true
+	r.voters = tracker.JointConfig{tracker.MajorityConfig{}, tracker.MajorityConfig{}}
+	r.learnersNext = make(map[uint64]struct{})
+	r.witnesses = make(map[uint64]struct{})
+	r.autoLeave = cs.AutoLeave
+	r.isLearner = false
+	for _, id := range cs.Nodes {
+		r.voters[0][id] = struct{}{}
+	}
+	for _, id := range cs.VotersOutgoing {
+		r.voters[1][id] = struct{}{}
+	}
+	for _, id := range cs.LearnersNext {
+		r.learnersNext[id] = struct{}{}
+	}
+	r.restoreNode(tracker.MajorityConfig(r.voters.IDs()).Slice(), false)
+	r.restoreNode(cs.Learners, true)
+	for _, id := range cs.Witnesses {
+		r.witnesses[id] = struct{}{}
+	}
+	_, r.isWitness = r.witnesses[r.id]
 }
 
 func (r *raft) restoreNode(nodes []uint64, isLearner bool) {
@@ -1383,6 +1669,31 @@ func (r *raft) restoreNode(nodes []uint64, isLearner bool) {
 	}
 }
 
+// confState returns the ConfState describing the current configuration.
+func (r *raft) confState() pb.ConfState {
+	cs := pb.ConfState{
+		Nodes:     r.nodes(),
+		Learners:  r.learnerNodes(),
+		AutoLeave: r.autoLeave,
+	}
+	if r.voters.IsJoint() {
+		cs.VotersOutgoing = r.voters[1].Slice()
+	}
+	if len(r.learnersNext) > 0 {
+		cs.LearnersNext = tracker.MajorityConfig(r.learnersNext).Slice()
+	}
+	if len(r.witnesses) > 0 {
+		cs.Witnesses = tracker.MajorityConfig(r.witnesses).Slice()
+	}
+	return cs
+}
+
+// isSingleton reports whether the configuration consists of a single voter,
+// which can then make decisions without consulting any peer.
+func (r *raft) isSingleton() bool {
+	return !r.voters.IsJoint() && len(r.voters[0]) == 1
+}
+
 // promotable indicates whether state machine can be promoted to leader,
 // which is true when its own id is in progress list.
 func (r *raft) promotable() bool {
@@ -1391,66 +1702,243 @@ func (r *raft) promotable() bool {
 }
 
 func (r *raft) addNode(id uint64) {
-	r.addNodeOrLearnerNode(id, false)
+	r.applyConfChange(pb.ConfChange{Type: pb.ConfChangeAddNode, NodeID: id}.AsV2())
 }
 
 func (r *raft) addLearner(id uint64) {
-	r.addNodeOrLearnerNode(id, true)
+	r.applyConfChange(pb.ConfChange{Type: pb.ConfChangeAddLearnerNode, NodeID: id}.AsV2())
 }
 
-func (r *raft) addNodeOrLearnerNode(id uint64, isLearner bool) {
-	pr := r.getProgress(id)
-	if pr == nil {
-		r.setProgress(id, 0, r.raftLog.lastIndex()+1, isLearner)
+func (r *raft) addWitness(id uint64) {
+	r.applyConfChange(pb.ConfChange{Type: pb.ConfChangeAddWitnessNode, NodeID: id}.AsV2())
+}
+
+func (r *raft) removeNode(id uint64) {
+	r.applyConfChange(pb.ConfChange{Type: pb.ConfChangeRemoveNode, NodeID: id}.AsV2())
+}
+
+// applyConfChange applies the given configuration change and returns the
+// resulting ConfState. A change with more than one voter modification, or one
+// that explicitly asks for it, enters a joint configuration in which every
+// decision requires a majority of both the old and the new voters; an empty
+// change leaves it again. The application is expected to only apply changes
+// that raft accepted for proposal, so an invalid change causes a panic.
+func (r *raft) applyConfChange(cc pb.ConfChangeV2) pb.ConfState {
+	if cc.LeaveJoint() {
+		r.leaveJoint()
+	} else if autoLeave, ok := cc.EnterJoint(); ok {
+		r.enterJoint(autoLeave, cc.Changes)
 	} else {
-		if isLearner && !pr.IsLearner {
-			// can only change Learner to Voter
-			r.logger.Infof("%x ignored addLearner: do not support changing %x from raft peer to learner.", r.id, id)
-			return
+		r.applySimple(cc.Changes)
+	}
+
+	_, r.isLearner = r.learnerPrs[r.id]
+	_, r.isWitness = r.witnesses[r.id]
+
+	// do not try to commit or abort transferring if there are no voters in
+	// the cluster, or if this node is not the leader.
+	if r.state != StateLeader || len(r.prs) == 0 {
+		return r.confState()
+	}
+
+	// The quorum may have changed, so see if any pending entries can be
+	// committed.
+	if r.maybeCommit() {
+		r.bcastAppend()
+	}
+	// If the leadTransferee is no longer a voter, abort the leadership
+	// transfer.
+	if r.leadTransferee != None {
+		if _, ok := r.prs[r.leadTransferee]; !ok {
+			r.abortLeaderTransfer()
+		}
+	}
+	return r.confState()
+}
+
+// enterJoint makes the current voters the outgoing configuration and applies
+// the changes to the incoming one.
+func (r *raft) enterJoint(autoLeave bool, ccs []pb.ConfChangeSingle) {
+	if r.voters.IsJoint() {
+		r.logger.Panicf("%x cannot enter joint configuration: configuration is already joint", r.id)
+	}
+	if len(r.voters[0]) == 0 {
+		// Adding nodes to an empty configuration is allowed for convenience
+		// (bootstrapping and testing), but entering a joint state is not.
+		r.logger.Panicf("%x cannot enter joint configuration: no voters", r.id)
+	}
+	for id := range r.voters[0] {
+		r.voters[1][id] = struct{}{}
+	}
+	r.applyChanges(ccs)
+	r.autoLeave = autoLeave
+	r.logger.Infof("%x entered joint configuration [incoming: %v, outgoing: %v, autoleave: %t]",
+		r.id, r.voters[0].Slice(), r.voters[1].Slice(), autoLeave)
+}
+
+// leaveJoint drops the outgoing configuration, demoting the voters in
+// learnersNext to learners and forgetting the voters that are not part of
+// the incoming configuration.
+func (r *raft) leaveJoint() {
+	if !r.voters.IsJoint() {
+		r.logger.Panicf("%x cannot leave joint configuration: configuration is not joint", r.id)
+	}
+	for id := range r.learnersNext {
+		pr := r.prs[id]
+		delete(r.prs, id)
+		pr.IsLearner = true
+		r.learnerPrs[id] = pr
+	}
+	r.learnersNext = make(map[uint64]struct{})
+	for id := range r.voters[1] {
+		_, isVoter := r.voters[0][id]
+		_, isLearner := r.learnerPrs[id]
+		if !isVoter && !isLearner {
+			delete(r.prs, id)
+			delete(r.witnesses, id)
 		}
+	}
+	r.voters[1] = tracker.MajorityConfig{}
+	r.autoLeave = false
+	r.logger.Infof("%x left joint configuration [voters: %v]", r.id, r.voters[0].Slice())
+}
 
-		if isLearner == pr.IsLearner {
-			// Ignore any redundant addNode calls (which can happen because the
-			// initial bootstrapping entries are applied twice).
-			return
+// applySimple applies the changes to the incoming configuration. At most one
+// voter may be added or removed this way; larger changes must go through a
+// joint configuration.
+func (r *raft) applySimple(ccs []pb.ConfChangeSingle) {
+	if r.voters.IsJoint() {
+		r.logger.Panicf("%x cannot apply simple configuration change in joint configuration", r.id)
+	}
+	prev := make(tracker.MajorityConfig, len(r.voters[0]))
+	for id := range r.voters[0] {
+		prev[id] = struct{}{}
+	}
+	r.applyChanges(ccs)
+	n := 0
+	for id := range prev {
+		if _, ok := r.voters[0][id]; !ok {
+			n++
+		}
+	}
+	for id := range r.voters[0] {
+		if _, ok := prev[id]; !ok {
+			n++
+		}
+	}
+	if n > 1 {
+		r.logger.Panicf("%x cannot change more than one voter without entering a joint configuration", r.id)
+	}
+}
+
+func (r *raft) applyChanges(ccs []pb.ConfChangeSingle) {
+	for _, cc := range ccs {
+		if cc.NodeID == None {
+			// etcdserver replaces the NodeID with None if it decides not to
+			// apply a change, so ignore these.
+			continue
+		}
+		switch cc.Type {
+		case pb.ConfChangeAddNode:
+			r.makeVoter(cc.NodeID)
+		case pb.ConfChangeAddLearnerNode:
+			r.makeLearner(cc.NodeID)
+		case pb.ConfChangeAddWitnessNode:
+			r.makeWitness(cc.NodeID)
+		case pb.ConfChangeRemoveNode:
+			r.remove(cc.NodeID)
+		case pb.ConfChangeUpdateNode:
+		default:
+			r.logger.Panicf("%x unexpected conf type %d", r.id, cc.Type)
 		}
+	}
+}
 
+// makeVoter adds the given ID to the voters of the incoming configuration,
+// promoting it if it is a learner.
+func (r *raft) makeVoter(id uint64) {
+	if _, ok := r.witnesses[id]; ok {
+		r.logger.Panicf("%x cannot turn witness %x into a regular voter", r.id, id)
+	}
+	pr := r.getProgress(id)
+	if pr == nil {
+		r.initProgress(id, false)
+	} else if pr.IsLearner {
 		// change Learner to Voter, use origin Learner progress
 		delete(r.learnerPrs, id)
 		pr.IsLearner = false
+		pr.RecentActive = true
 		r.prs[id] = pr
 	}
+	// Ignore any redundant additions (which can happen because the initial
+	// bootstrapping entries are applied twice).
+	r.voters[0][id] = struct{}{}
+	delete(r.learnersNext, id)
+}
 
-	if r.id == id {
-		r.isLearner = isLearner
+// makeLearner makes the given ID a learner of the incoming configuration.
+// A voter of the outgoing configuration cannot be demoted right away and is
+// added to learnersNext instead; it becomes a learner once the joint
+// configuration is left.
+func (r *raft) makeLearner(id uint64) {
+	if _, ok := r.witnesses[id]; ok {
+		r.logger.Panicf("%x cannot turn witness %x into a learner", r.id, id)
 	}
-
-	// When a node is first added, we should mark it as recently active.
-	// Otherwise, CheckQuorum may cause us to step down if it is invoked
-	// before the added node has a chance to communicate with us.
-	pr = r.getProgress(id)
-	pr.RecentActive = true
+	pr := r.getProgress(id)
+	if pr == nil {
+		r.initProgress(id, true)
+		return
+	}
+	if pr.IsLearner {
+		return
+	}
+	delete(r.voters[0], id)
+	if _, ok := r.voters[1][id]; ok {
+		r.learnersNext[id] = struct{}{}
+		return
+	}
+	delete(r.prs, id)
+	pr.IsLearner = true
+	r.learnerPrs[id] = pr
 }
 
-func (r *raft) removeNode(id uint64) {
-	r.delProgress(id)
-
-	// do not try to commit or abort transferring if there is no nodes in the cluster.
-	if len(r.prs) == 0 && len(r.learnerPrs) == 0 {
+// makeWitness adds the given ID to the voters of the incoming configuration
+// as a witness. Only nodes new to the configuration can become witnesses.
+func (r *raft) makeWitness(id uint64) {
+	if _, ok := r.witnesses[id]; ok {
+		// redundant addition, see makeVoter
+		r.voters[0][id] = struct{}{}
 		return
 	}
-
-	// The quorum size is now smaller, so see if any pending entries can
-	// be committed.
-	if r.maybeCommit() {
-		r.bcastAppend()
+	if r.getProgress(id) != nil {
+		r.logger.Panicf("%x cannot turn member %x into a witness", r.id, id)
 	}
-	// If the removed node is the leadTransferee, then abort the leadership transferring.
-	if r.state == StateLeader && r.leadTransferee == id {
-		r.abortLeaderTransfer()
+	r.initProgress(id, false)
+	r.voters[0][id] = struct{}{}
+	r.witnesses[id] = struct{}{}
+}
+
+// remove removes the given ID from the incoming configuration. Its Progress
+// is kept for as long as it is a voter of the outgoing configuration.
+func (r *raft) remove(id uint64) {
+	delete(r.voters[0], id)
+	delete(r.learnersNext, id)
+	delete(r.learnerPrs, id)
+	if _, ok := r.voters[1][id]; !ok {
+		delete(r.prs, id)
+		delete(r.witnesses, id)
 	}
 }
 
+// initProgress starts tracking a node that is new to the configuration.
+func (r *raft) initProgress(id uint64, isLearner bool) {
+	r.setProgress(id, 0, r.raftLog.lastIndex()+1, isLearner)
+	// When a node is first added, we should mark it as recently active.
+	// Otherwise, CheckQuorum may cause us to step down if it is invoked
+	// before the added node has a chance to communicate with us.
+	r.getProgress(id).RecentActive = true
+}
+
 func (r *raft) setProgress(id, match, next uint64, isLearner bool) {
 	if !isLearner {
 		delete(r.learnerPrs, id)
@@ -1464,11 +1952,6 @@ func (r *raft) setProgress(id, match, next uint64, isLearner bool) {
 	r.learnerPrs[id] = &Progress{Next: next, Match: match, ins: newInflights(r.maxInflight), IsLearner: true}
 }
 
-func (r *raft) delProgress(id uint64) {
-	delete(r.prs, id)
-	delete(r.learnerPrs, id)
-}
-
 func (r *raft) loadState(state pb.HardState) {
 	if state.Commit < r.raftLog.committed || state.Commit > r.raftLog.lastIndex() {
 		r.logger.Panicf("%x state.commit %d is out of range [%d, %d]", r.id, state.Commit, r.raftLog.committed, r.raftLog.lastIndex())
@@ -1487,6 +1970,10 @@ func (r *raft) pastElectionTimeout() bool {
 
 func (r *raft) resetRandomizedElectionTimeout() {
 	r.randomizedElectionTimeout = r.electionTimeout + globalRand.Intn(r.electionTimeout)
+	if r.isWitness {
+		// give the regular voters a head start
+		r.randomizedElectionTimeout += r.electionTimeout
+	}
 }
 
 // checkQuorumActive returns true if the quorum is active from
@@ -1494,28 +1981,62 @@ func (r *raft) resetRandomizedElectionTimeout() {
 // false.
 // checkQuorumActive also resets all RecentActive to false.
 func (r *raft) checkQuorumActive() bool {
-	var act int
+	act := make(map[uint64]bool, len(r.prs))
 
 	r.forEachProgress(func(id uint64, pr *Progress) {
 		if id == r.id { // self is always active
-			act++
+			act[id] = true
 			return
 		}
 
-		if pr.RecentActive && !pr.IsLearner {
-			act++
+		if !pr.IsLearner {
+			act[id] = pr.RecentActive
 		}
 
 		pr.RecentActive = false
 	})
 
-	return act >= r.quorum()
+	return r.voters.VoteResult(act) == tracker.VoteWon
 }
 
 func (r *raft) sendTimeoutNow(to uint64) {
+	r.leaseRevoked = true
 	r.send(pb.Message{To: to, Type: pb.MsgTimeoutNow})
 }
 
+// inLeaderLease returns true if the leader may serve read only requests
+// without confirming its leadership first. This is the case if a quorum has
+// acknowledged a heartbeat sent less than leaseTicks ticks ago: having done
+// so, each of its members refuses to vote for another candidate for at least
+// an election timeout (see the inLease check in Step), so no other leader
+// can have been elected since.
+func (r *raft) inLeaderLease() bool {
+	if r.state != StateLeader || !r.checkQuorum || r.leaseRevoked {
+		return false
+	}
+	acked := r.voters.CommittedIndex(heartbeatAckIndexer{prs: r.prs, id: r.id, tick: r.leaderTicks})
+	return acked > 0 && r.leaderTicks-acked < r.leaseTicks
+}
+
+// handOffLeadership starts transferring the leadership of a witness to the
+// regular voter with the most entries, which is caught up first if needed.
+func (r *raft) handOffLeadership() {
+	var to, match uint64
+	for _, id := range tracker.MajorityConfig(r.voters.IDs()).Slice() {
+		if _, ok := r.witnesses[id]; ok {
+			continue
+		}
+		if pr := r.prs[id]; to == None || pr.Match > match {
+			to, match = id, pr.Match
+		}
+	}
+	if to == None {
+		return
+	}
+	r.logger.Infof("%x is a witness and hands leadership over to %x", r.id, to)
+	r.Step(pb.Message{From: to, To: r.id, Type: pb.MsgTransferLeader})
+}
+
 func (r *raft) abortLeaderTransfer() {
 	r.leadTransferee = None
 }
@@ -1566,7 +2087,7 @@ func (r *raft) reduceUncommittedSize(ents []pb.Entry) {
 func numOfPendingConf(ents []pb.Entry) int {
 	n := 0
 	for i := range ents {
-		if ents[i].Type == pb.EntryConfChange {
+		if ents[i].Type == pb.EntryConfChange || ents[i].Type == pb.EntryConfChangeV2 {
 			n++
 		}
 	}
@@ -231,6 +231,49 @@ func TestLeaderElectionInOneRoundRPC(t *testing.T) {
 	}
 }
 
+// TestLeaderElectionInJointConfig tests that while a joint configuration is in
+// effect, a candidate needs the votes of a majority of both the incoming and
+// the outgoing configuration to win, and loses as soon as either of them
+// denies it a majority.
+// Reference: section 6 (Cluster membership changes)
+func TestLeaderElectionInJointConfig(t *testing.T) {
+	tests := []struct {
+		votes map[uint64]bool
+		state StateType
+	}{
+		// win the election when receiving votes from a majority of both
+		{map[uint64]bool{2: true, 4: true}, StateLeader},
+		{map[uint64]bool{2: true, 3: true, 4: true}, StateLeader},
+		{map[uint64]bool{2: true, 3: false, 4: true, 5: false}, StateLeader},
+
+		// return to follower state if either configuration denies the vote
+		{map[uint64]bool{2: false, 3: false}, StateFollower},
+		{map[uint64]bool{4: false, 5: false}, StateFollower},
+		{map[uint64]bool{2: true, 3: true, 4: false, 5: false}, StateFollower},
+
+		// stay in candidate if a majority is only obtained in one of them
+		{map[uint64]bool{}, StateCandidate},
+		{map[uint64]bool{2: true, 3: true}, StateCandidate},
+		{map[uint64]bool{4: true, 5: true}, StateCandidate},
+		{map[uint64]bool{2: true, 3: true, 4: false}, StateCandidate},
+	}
+	for i, tt := range tests {
+		r := newTestJointRaft(1, []uint64{1, 2, 3}, []uint64{1, 4, 5}, NewMemoryStorage())
+
+		r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+		for id, vote := range tt.votes {
+			r.Step(pb.Message{From: id, To: 1, Term: r.Term, Type: pb.MsgVoteResp, Reject: !vote})
+		}
+
+		if r.state != tt.state {
+			t.Errorf("#%d: state = %s, want %s", i, r.state, tt.state)
+		}
+		if g := r.Term; g != 1 {
+			t.Errorf("#%d: term = %d, want %d", i, g, 1)
+		}
+	}
+}
+
 // TestFollowerVote tests that each follower will vote for at most one
 // candidate in a given term, on a first-come-first-served basis.
 // Reference: section 5.2
@@ -508,6 +551,45 @@ func TestLeaderAcknowledgeCommit(t *testing.T) {
 	}
 }
 
+// TestLeaderAcknowledgeCommitInJointConfig tests that while a joint
+// configuration is in effect, a log entry is committed once the leader has
+// replicated it on a majority of both the incoming and the outgoing
+// configuration.
+// Reference: section 6 (Cluster membership changes)
+func TestLeaderAcknowledgeCommitInJointConfig(t *testing.T) {
+	tests := []struct {
+		acceptors map[uint64]bool
+		wack      bool
+	}{
+		{nil, false},
+		{map[uint64]bool{2: true}, false},
+		{map[uint64]bool{2: true, 3: true}, false},
+		{map[uint64]bool{4: true, 5: true}, false},
+		{map[uint64]bool{2: true, 4: true}, true},
+		{map[uint64]bool{3: true, 5: true}, true},
+		{map[uint64]bool{2: true, 3: true, 4: true, 5: true}, true},
+	}
+	for i, tt := range tests {
+		s := NewMemoryStorage()
+		r := newTestJointRaft(1, []uint64{1, 2, 3}, []uint64{1, 4, 5}, s)
+		r.becomeCandidate()
+		r.becomeLeader()
+		commitNoopEntry(r, s)
+		li := r.raftLog.lastIndex()
+		r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Data: []byte("some data")}}})
+
+		for _, m := range r.readMessages() {
+			if tt.acceptors[m.To] {
+				r.Step(acceptAndReply(m))
+			}
+		}
+
+		if g := r.raftLog.committed > li; g != tt.wack {
+			t.Errorf("#%d: ack commit = %v, want %v", i, g, tt.wack)
+		}
+	}
+}
+
 // TestLeaderCommitPrecedingEntries tests that when leader commits a log entry,
 // it also commits all preceding entries in the leader’s log, including
 // entries created by previous leaders.
@@ -922,6 +1004,13 @@ func commitNoopEntry(r *raft, s *MemoryStorage) {
 	r.raftLog.stableTo(r.raftLog.lastIndex(), r.raftLog.lastTerm())
 }
 
+// newTestJointRaft returns a raft whose storage holds a joint configuration
+// made up of the given incoming and outgoing voters.
+func newTestJointRaft(id uint64, incoming, outgoing []uint64, s *MemoryStorage) *raft {
+	s.snapshot.Metadata.ConfState = pb.ConfState{Nodes: incoming, VotersOutgoing: outgoing}
+	return newTestRaft(id, nil, 10, 1, s)
+}
+
 func acceptAndReply(m pb.Message) pb.Message {
 	if m.Type != pb.MsgApp {
 		panic("type should be MsgApp")
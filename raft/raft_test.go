@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	pb "go.etcd.io/etcd/raft/raftpb"
+	"go.etcd.io/etcd/raft/tracker"
 )
 
 // nextEnts returns the appliable entries and updates the applied index
@@ -427,6 +428,9 @@ func TestUncommittedEntryLimit(t *testing.T) {
 	if err := r.Step(propMsg); err != ErrProposalDropped {
 		t.Fatalf("proposal not dropped: %v", err)
 	}
+	if s := getStatus(r).UncommittedSize; s != uint64(maxEntrySize) {
+		t.Fatalf("status reports %d uncommitted bytes, want %d", s, maxEntrySize)
+	}
 
 	// Read messages and reduce the uncommitted size as if we had committed
 	// these entries.
@@ -438,6 +442,9 @@ func TestUncommittedEntryLimit(t *testing.T) {
 	if r.uncommittedSize != 0 {
 		t.Fatalf("committed everything, but still tracking %d", r.uncommittedSize)
 	}
+	if s := getStatus(r).UncommittedSize; s != 0 {
+		t.Fatalf("status reports %d uncommitted bytes, want 0", s)
+	}
 
 	// Send a single large proposal to r1. Should be accepted even though it
 	// pushes us above the limit because we were beneath it before the proposal.
@@ -1352,7 +1359,12 @@ func TestCommit(t *testing.T) {
 
 		sm := newTestRaft(1, []uint64{1}, 10, 2, storage)
 		for j := 0; j < len(tt.matches); j++ {
-			sm.setProgress(uint64(j)+1, tt.matches[j], tt.matches[j]+1, false)
+			id := uint64(j) + 1
+			if id > 1 {
+				sm.addNode(id)
+			}
+			pr := sm.prs[id]
+			pr.Match, pr.Next = tt.matches[j], tt.matches[j]+1
 		}
 		sm.maybeCommit()
 		if g := sm.raftLog.committed; g != tt.w {
@@ -2137,7 +2149,7 @@ func TestNonPromotableVoterWithCheckQuorum(t *testing.T) {
 	nt := newNetwork(a, b)
 	setRandomizedElectionTimeout(b, b.electionTimeout+1)
 	// Need to remove 2 again to make it a non-promotable node since newNetwork overwritten some internal states
-	b.delProgress(2)
+	b.removeNode(2)
 
 	if b.promotable() {
 		t.Fatalf("promotable = %v, want false", b.promotable())
@@ -2473,6 +2485,95 @@ func TestReadOnlyOptionLease(t *testing.T) {
 	}
 }
 
+// TestReadOnlyOptionLeaseExpiry ensures that a leader using ReadOnlyLeaseBased
+// serves read only requests locally only while a quorum has recently
+// acknowledged its heartbeats, and confirms its leadership with a round of
+// heartbeats otherwise.
+func TestReadOnlyOptionLeaseExpiry(t *testing.T) {
+	newLeaseRaft := func(id uint64) *raft {
+		cfg := newTestConfig(id, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+		cfg.CheckQuorum = true
+		cfg.ReadOnlyOption = ReadOnlyLeaseBased
+		cfg.LeaseClockDriftTicks = 2
+		return newRaft(cfg)
+	}
+	a, b, c := newLeaseRaft(1), newLeaseRaft(2), newLeaseRaft(3)
+	nt := newNetwork(a, b, c)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+	if a.state != StateLeader {
+		t.Fatalf("state = %s, want %s", a.state, StateLeader)
+	}
+	if a.leaseTicks != 7 {
+		t.Fatalf("leaseTicks = %d, want 7", a.leaseTicks)
+	}
+
+	// readIndex issues a read on the leader and reports whether it was served
+	// from the lease, or else whether heartbeats were sent to confirm it.
+	readIndex := func(ctx string) (lease bool, heartbeats bool) {
+		a.readStates = nil
+		a.Step(pb.Message{From: 1, To: 1, Type: pb.MsgReadIndex, Entries: []pb.Entry{{Data: []byte(ctx)}}})
+		for _, rs := range a.readStates {
+			if string(rs.RequestCtx) == ctx && rs.LeaseBased {
+				lease = true
+			}
+		}
+		for _, m := range a.readMessages() {
+			if m.Type == pb.MsgHeartbeat && string(m.Context) == ctx {
+				heartbeats = true
+			}
+		}
+		return lease, heartbeats
+	}
+
+	// No heartbeat has been acknowledged yet.
+	if lease, hb := readIndex("ctx1"); lease || !hb {
+		t.Fatalf("lease = %t, heartbeats = %t, want false, true", lease, hb)
+	}
+
+	a.tick()
+	nt.send(a.readMessages()...)
+	if lease, hb := readIndex("ctx2"); !lease || hb {
+		t.Fatalf("lease = %t, heartbeats = %t, want true, false", lease, hb)
+	}
+
+	// Without acknowledgements the lease lasts for seven ticks.
+	nt.isolate(1)
+	for i := uint64(0); i < a.leaseTicks-1; i++ {
+		a.tick()
+		nt.send(a.readMessages()...)
+	}
+	if lease, hb := readIndex("ctx3"); !lease || hb {
+		t.Fatalf("lease = %t, heartbeats = %t, want true, false", lease, hb)
+	}
+	a.tick()
+	nt.send(a.readMessages()...)
+	if lease, hb := readIndex("ctx4"); lease || !hb {
+		t.Fatalf("lease = %t, heartbeats = %t, want false, true", lease, hb)
+	}
+
+	// Once acknowledged again, the lease is back.
+	nt.recover()
+	a.tick()
+	nt.send(a.readMessages()...)
+	if lease, hb := readIndex("ctx5"); !lease || hb {
+		t.Fatalf("lease = %t, heartbeats = %t, want true, false", lease, hb)
+	}
+
+	// A leader that has sent MsgTimeoutNow no longer trusts its lease, even
+	// if the transfer does not go through.
+	a.Step(pb.Message{From: 2, To: 1, Type: pb.MsgTransferLeader})
+	if !a.leaseRevoked {
+		t.Fatalf("leaseRevoked = false after MsgTimeoutNow to an up-to-date transferee")
+	}
+	a.readMessages()
+	a.abortLeaderTransfer()
+	a.tick()
+	nt.send(a.readMessages()...)
+	if lease, hb := readIndex("ctx6"); lease || !hb {
+		t.Fatalf("lease = %t, heartbeats = %t, want false, true", lease, hb)
+	}
+}
+
 // TestReadOnlyForNewLeader ensures that a leader only accepts MsgReadIndex message
 // when it commits at least one log entry at it term.
 func TestReadOnlyForNewLeader(t *testing.T) {
@@ -3330,6 +3431,187 @@ func TestRemoveLearner(t *testing.T) {
 		t.Errorf("nodes = %v, want %v", g, w)
 	}
 }
+
+// TestApplyConfChangeJoint tests that a configuration change with several
+// changes enters a joint configuration, in which a demoted voter remains a
+// voter of the outgoing configuration, and that leaving the joint
+// configuration makes the demotion and removal take effect.
+func TestApplyConfChangeJoint(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	cc := pb.ConfChangeV2{Changes: []pb.ConfChangeSingle{
+		{Type: pb.ConfChangeAddNode, NodeID: 4},
+		{Type: pb.ConfChangeAddLearnerNode, NodeID: 3},
+		{Type: pb.ConfChangeRemoveNode, NodeID: 2},
+	}}
+	cs := r.applyConfChange(cc)
+	wcs := pb.ConfState{
+		Nodes:          []uint64{1, 4},
+		Learners:       []uint64{},
+		VotersOutgoing: []uint64{1, 2, 3},
+		LearnersNext:   []uint64{3},
+		AutoLeave:      true,
+	}
+	if !reflect.DeepEqual(cs, wcs) {
+		t.Fatalf("conf state = %+v, want %+v", cs, wcs)
+	}
+	for _, id := range []uint64{1, 2, 3, 4} {
+		if r.prs[id] == nil {
+			t.Errorf("progress of %x is missing in joint configuration", id)
+		}
+	}
+
+	cs = r.applyConfChange(pb.ConfChangeV2{})
+	wcs = pb.ConfState{Nodes: []uint64{1, 4}, Learners: []uint64{3}}
+	if !reflect.DeepEqual(cs, wcs) {
+		t.Fatalf("conf state = %+v, want %+v", cs, wcs)
+	}
+	if r.getProgress(2) != nil {
+		t.Errorf("progress of removed node 2 = %v, want nil", r.getProgress(2))
+	}
+	if pr := r.learnerPrs[3]; pr == nil || !pr.IsLearner {
+		t.Errorf("progress of demoted node 3 = %v, want learner", pr)
+	}
+}
+
+// TestJointConfChangeLeave tests that the leader proposes to leave a joint
+// configuration once it has been applied if and only if the transition is
+// automatic.
+func TestJointConfChangeLeave(t *testing.T) {
+	tests := []struct {
+		transition pb.ConfChangeTransition
+		wleave     bool
+	}{
+		{pb.ConfChangeTransitionAuto, true},
+		{pb.ConfChangeTransitionJointImplicit, true},
+		{pb.ConfChangeTransitionJointExplicit, false},
+	}
+	for i, tt := range tests {
+		s := NewMemoryStorage()
+		r := newTestRaft(1, []uint64{1}, 10, 1, s)
+		r.becomeCandidate()
+		r.becomeLeader()
+		nextEnts(r, s)
+
+		cc := pb.ConfChangeV2{
+			Transition: tt.transition,
+			Changes: []pb.ConfChangeSingle{
+				{Type: pb.ConfChangeAddNode, NodeID: 2},
+				{Type: pb.ConfChangeAddNode, NodeID: 3},
+			},
+		}
+		ccdata, err := cc.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Type: pb.EntryConfChangeV2, Data: ccdata}}})
+
+		// the single voter commits the change on its own; apply it
+		ents := nextEnts(r, s)
+		if len(ents) != 1 || ents[0].Type != pb.EntryConfChangeV2 {
+			t.Fatalf("#%d: committed entries = %+v, want the conf change", i, ents)
+		}
+		r.applyConfChange(cc)
+		r.appliedTo(ents[0].Index)
+
+		last := r.raftLog.lastIndex()
+		leave := last > ents[0].Index
+		if leave != tt.wleave {
+			t.Fatalf("#%d: proposed leave = %v, want %v", i, leave, tt.wleave)
+		}
+		if !leave {
+			continue
+		}
+		le, err := r.raftLog.entries(last, noLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var lcc pb.ConfChangeV2
+		if err := lcc.Unmarshal(le[0].Data); err != nil {
+			t.Fatal(err)
+		}
+		if le[0].Type != pb.EntryConfChangeV2 || !lcc.LeaveJoint() {
+			t.Errorf("#%d: last entry = %+v, want an empty EntryConfChangeV2", i, le[0])
+		}
+		// the leave entry needs a majority of the incoming configuration
+		if r.raftLog.committed >= last {
+			t.Errorf("#%d: committed = %d, want < %d", i, r.raftLog.committed, last)
+		}
+	}
+}
+
+// TestProposeConfChangeJoint tests that the leader only accepts a non-empty
+// configuration change outside of a joint configuration, and only an empty one
+// inside of it.
+func TestProposeConfChangeJoint(t *testing.T) {
+	empty, err := (&pb.ConfChangeV2{}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	add, err := (&pb.ConfChangeV2{Changes: []pb.ConfChangeSingle{{Type: pb.ConfChangeAddNode, NodeID: 4}}}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		outgoing []uint64
+		data     []byte
+		wtype    pb.EntryType
+	}{
+		{nil, empty, pb.EntryNormal},
+		{nil, add, pb.EntryConfChangeV2},
+		{[]uint64{1, 2}, empty, pb.EntryConfChangeV2},
+		{[]uint64{1, 2}, add, pb.EntryNormal},
+	}
+	for i, tt := range tests {
+		s := NewMemoryStorage()
+		r := newTestJointRaft(1, []uint64{1}, tt.outgoing, s)
+		r.becomeCandidate()
+		r.becomeLeader()
+		// there is no pending configuration change in the log
+		r.pendingConfIndex = 0
+
+		r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgProp, Entries: []pb.Entry{{Type: pb.EntryConfChangeV2, Data: tt.data}}})
+		ents, err := r.raftLog.entries(r.raftLog.lastIndex(), noLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g := ents[0].Type; g != tt.wtype {
+			t.Errorf("#%d: type = %v, want %v", i, g, tt.wtype)
+		}
+	}
+}
+
+// TestRestoreJointConfState tests that restoring from a snapshot taken in a
+// joint configuration restores the joint configuration.
+func TestRestoreJointConfState(t *testing.T) {
+	cs := pb.ConfState{
+		Nodes:          []uint64{1, 2, 4},
+		Learners:       []uint64{5},
+		VotersOutgoing: []uint64{1, 2, 3},
+		LearnersNext:   []uint64{3},
+		AutoLeave:      true,
+	}
+	s := pb.Snapshot{
+		Metadata: pb.SnapshotMetadata{
+			Index:     11, // magic number
+			Term:      11, // magic number
+			ConfState: cs,
+		},
+	}
+
+	sm := newTestRaft(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	if ok := sm.restore(s); !ok {
+		t.Fatal("restore fail, want succeed")
+	}
+	if g := sm.confState(); !reflect.DeepEqual(g, cs) {
+		t.Errorf("conf state = %+v, want %+v", g, cs)
+	}
+	for _, id := range []uint64{1, 2, 3, 4} {
+		if sm.prs[id] == nil {
+			t.Errorf("progress of voter %x is missing", id)
+		}
+	}
+}
+
 func TestPromotable(t *testing.T) {
 	id := uint64(1)
 	tests := []struct {
@@ -3782,6 +4064,59 @@ func TestLeaderTransferSecondTransferToSameNode(t *testing.T) {
 	checkLeaderTransferState(t, lead, StateLeader, 1)
 }
 
+// TestLeaderTransferToWitness verifies that a leader never transfers its
+// leadership to a witness.
+func TestLeaderTransferToWitness(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	setWitness(nt, 3)
+	nt.send(pb.Message{From: 1, To: 1, Type: pb.MsgHup})
+
+	lead := nt.peers[1].(*raft)
+	nt.send(pb.Message{From: 3, To: 1, Type: pb.MsgTransferLeader})
+	checkLeaderTransferState(t, lead, StateLeader, 1)
+
+	// A MsgTimeoutNow reaching the witness regardless is ignored.
+	nt.send(pb.Message{From: 1, To: 3, Type: pb.MsgTimeoutNow})
+	if w := nt.peers[3].(*raft); w.state != StateFollower {
+		t.Fatalf("witness state = %v, want %v", w.state, StateFollower)
+	}
+	checkLeaderTransferState(t, lead, StateLeader, 1)
+}
+
+// TestWitnessHandsOffLeadership verifies that a witness that has been
+// elected leader transfers its leadership to a regular voter.
+func TestWitnessHandsOffLeadership(t *testing.T) {
+	nt := newNetwork(nil, nil, nil)
+	setWitness(nt, 3)
+	nt.send(pb.Message{From: 3, To: 3, Type: pb.MsgHup})
+
+	w := nt.peers[3].(*raft)
+	if w.state != StateLeader {
+		t.Fatalf("witness state = %v, want %v", w.state, StateLeader)
+	}
+	w.tick()
+	nt.send(w.readMessages()...)
+
+	checkLeaderTransferState(t, w, StateFollower, 1)
+}
+
+func TestAddWitness(t *testing.T) {
+	r := newTestRaft(1, []uint64{1}, 10, 1, NewMemoryStorage())
+	r.addWitness(2)
+
+	if g := r.voters.IDs(); len(g) != 2 {
+		t.Fatalf("voters = %v, want [1 2]", g)
+	}
+	if w := r.confState().Witnesses; !reflect.DeepEqual(w, []uint64{2}) {
+		t.Fatalf("witnesses = %v, want [2]", w)
+	}
+
+	r.removeNode(2)
+	if w := r.confState().Witnesses; len(w) != 0 {
+		t.Fatalf("witnesses = %v, want []", w)
+	}
+}
+
 func checkLeaderTransferState(t *testing.T, r *raft, state StateType, lead uint64) {
 	if r.state != state || r.lead != lead {
 		t.Fatalf("after transferring, node has state %v lead %v, want state %v lead %v", r.state, r.lead, state, lead)
@@ -4201,11 +4536,13 @@ func newNetworkWithConfig(configFunc func(*Config), peers ...stateMachine) *netw
 			v.id = id
 			v.prs = make(map[uint64]*Progress)
 			v.learnerPrs = make(map[uint64]*Progress)
+			v.voters = tracker.JointConfig{tracker.MajorityConfig{}, tracker.MajorityConfig{}}
 			for i := 0; i < size; i++ {
 				if _, ok := learners[peerAddrs[i]]; ok {
 					v.learnerPrs[peerAddrs[i]] = &Progress{IsLearner: true}
 				} else {
 					v.prs[peerAddrs[i]] = &Progress{}
+					v.voters[0][peerAddrs[i]] = struct{}{}
 				}
 			}
 			v.reset(v.Term)
@@ -4317,6 +4654,16 @@ func setRandomizedElectionTimeout(r *raft, v int) {
 	r.randomizedElectionTimeout = v
 }
 
+// setWitness marks the given node as a witness on all peers of the network.
+func setWitness(nt *network, id uint64) {
+	for _, p := range nt.peers {
+		if sm, ok := p.(*raft); ok {
+			sm.witnesses[id] = struct{}{}
+			sm.isWitness = sm.id == id
+		}
+	}
+}
+
 func newTestConfig(id uint64, peers []uint64, election, heartbeat int, storage Storage) *Config {
 	return &Config{
 		ID:              id,
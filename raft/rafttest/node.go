@@ -34,8 +34,9 @@ type node struct {
 	// stable
 	storage *raft.MemoryStorage
 
-	mu    sync.Mutex // guards state
-	state raftpb.HardState
+	mu        sync.Mutex // guards state and confState
+	state     raftpb.HardState
+	confState raftpb.ConfState
 }
 
 func startNode(id uint64, peers []raft.Peer, iface iface) *node {
@@ -78,6 +79,7 @@ func (n *node) start() {
 					n.storage.SetHardState(n.state)
 				}
 				n.storage.Append(rd.Entries)
+				n.applyConfChanges(rd.CommittedEntries)
 				time.Sleep(time.Millisecond)
 				// TODO: make send async, more like real world...
 				for _, m := range rd.Messages {
@@ -110,6 +112,41 @@ func (n *node) start() {
 	}()
 }
 
+// applyConfChanges applies the configuration changes among the given
+// committed entries and records the resulting ConfState.
+func (n *node) applyConfChanges(ents []raftpb.Entry) {
+	for _, e := range ents {
+		var cc raftpb.ConfChangeI
+		switch e.Type {
+		case raftpb.EntryConfChange:
+			var ccc raftpb.ConfChange
+			if err := ccc.Unmarshal(e.Data); err != nil {
+				log.Panicf("raft.%d: unmarshal conf change: %v", n.id, err)
+			}
+			cc = ccc
+		case raftpb.EntryConfChangeV2:
+			var ccc raftpb.ConfChangeV2
+			if err := ccc.Unmarshal(e.Data); err != nil {
+				log.Panicf("raft.%d: unmarshal conf change: %v", n.id, err)
+			}
+			cc = ccc
+		default:
+			continue
+		}
+		cs := n.ApplyConfChange(cc)
+		n.mu.Lock()
+		n.confState = *cs
+		n.mu.Unlock()
+	}
+}
+
+// appliedConfState returns the configuration last applied by the node.
+func (n *node) appliedConfState() raftpb.ConfState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.confState
+}
+
 // stop stops the node. stop a stopped node might panic.
 // All in memory state of node is discarded.
 // All stable MUST be unchanged.
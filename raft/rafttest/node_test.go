@@ -16,10 +16,12 @@ package rafttest
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 
 	"go.etcd.io/etcd/raft"
+	"go.etcd.io/etcd/raft/raftpb"
 )
 
 func TestBasicProgress(t *testing.T) {
@@ -127,6 +129,133 @@ func TestPause(t *testing.T) {
 	}
 }
 
+// TestJointConfChangeAutoLeave replaces a voter of a three node cluster and
+// adds another one in a single step. The cluster enters a joint configuration
+// and leaves it automatically once the change has been applied.
+func TestJointConfChangeAutoLeave(t *testing.T) {
+	testJointConfChange(t, raftpb.ConfChangeTransitionAuto)
+}
+
+// TestJointConfChangeExplicitLeave is like TestJointConfChangeAutoLeave, but
+// the cluster stays in the joint configuration until an empty configuration
+// change is proposed.
+func TestJointConfChangeExplicitLeave(t *testing.T) {
+	testJointConfChange(t, raftpb.ConfChangeTransitionJointExplicit)
+}
+
+func testJointConfChange(t *testing.T, transition raftpb.ConfChangeTransition) {
+	peers := []raft.Peer{{ID: 1, Context: nil}, {ID: 2, Context: nil}, {ID: 3, Context: nil}}
+	nt := newRaftNetwork(1, 2, 3, 4, 5)
+
+	nodes := make([]*node, 0)
+	for i := 1; i <= 5; i++ {
+		var ps []raft.Peer
+		if i <= 3 {
+			ps = peers
+		}
+		nodes = append(nodes, startNode(uint64(i), ps, nt.nodeNetwork(uint64(i))))
+	}
+	voters := nodes[:3]
+
+	l := waitLeader(voters)
+	for i := 0; i < 10; i++ {
+		nodes[l].Propose(context.TODO(), []byte("somedata"))
+	}
+	if !waitCommitConverge(voters, 10) {
+		t.Fatalf("commits failed to converge!")
+	}
+
+	// replace the leader, so that the outgoing configuration has to hand
+	// over to the incoming one
+	cc := raftpb.ConfChangeV2{
+		Transition: transition,
+		Changes: []raftpb.ConfChangeSingle{
+			{Type: raftpb.ConfChangeAddNode, NodeID: 4},
+			{Type: raftpb.ConfChangeAddNode, NodeID: 5},
+			{Type: raftpb.ConfChangeRemoveNode, NodeID: nodes[l].id},
+		},
+	}
+	if err := nodes[l].ProposeConfChange(context.TODO(), cc); err != nil {
+		t.Fatal(err)
+	}
+
+	var want []uint64
+	remaining := make([]*node, 0)
+	for _, n := range nodes {
+		if n.id != nodes[l].id {
+			want = append(want, n.id)
+			remaining = append(remaining, n)
+		}
+	}
+
+	if transition == raftpb.ConfChangeTransitionJointExplicit {
+		joint := raftpb.ConfState{Nodes: want, Learners: []uint64{}, VotersOutgoing: []uint64{1, 2, 3}}
+		if !waitConfState(remaining, joint) {
+			t.Fatalf("nodes failed to enter joint configuration %v", joint)
+		}
+		// the joint configuration must not be left on its own
+		time.Sleep(100 * time.Millisecond)
+		for _, n := range remaining {
+			if cs := n.appliedConfState(); !reflect.DeepEqual(cs, joint) {
+				t.Fatalf("node %d: conf state = %v, want %v", n.id, cs, joint)
+			}
+		}
+		for {
+			// the outgoing leader may not have applied the joint
+			// configuration yet, or may have stepped down; retry on the
+			// new voters until the proposal goes through.
+			if err := remaining[0].ProposeConfChange(context.TODO(), raftpb.ConfChangeV2{}); err != nil {
+				t.Fatal(err)
+			}
+			if waitConfState(remaining, raftpb.ConfState{Nodes: want, Learners: []uint64{}}) {
+				break
+			}
+		}
+	}
+
+	if !waitConfState(remaining, raftpb.ConfState{Nodes: want, Learners: []uint64{}}) {
+		t.Fatalf("nodes failed to leave joint configuration")
+	}
+
+	// the removed node is no longer needed to make progress; wait for one of
+	// the remaining nodes to take over if it was still leading.
+	removed := nodes[l].id
+	nodes[l].stop()
+	l = waitLeader(remaining)
+	for remaining[l].Status().SoftState.Lead == removed {
+		time.Sleep(10 * time.Millisecond)
+		l = waitLeader(remaining)
+	}
+	for i := 0; i < 10; i++ {
+		remaining[l].Propose(context.TODO(), []byte("somedata"))
+	}
+	if !waitCommitConverge(remaining, 20) {
+		t.Errorf("commits failed to converge!")
+	}
+
+	for _, n := range remaining {
+		n.stop()
+	}
+}
+
+// waitConfState waits until all the given nodes have applied the given
+// configuration.
+func waitConfState(ns []*node, cs raftpb.ConfState) bool {
+	for i := 0; i < 50; i++ {
+		good := 0
+		for _, n := range ns {
+			if reflect.DeepEqual(n.appliedConfState(), cs) {
+				good++
+			}
+		}
+		if good == len(ns) {
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return false
+}
+
 func waitLeader(ns []*node) int {
 	var l map[uint64]struct{}
 	var lindex int
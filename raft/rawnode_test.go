@@ -115,6 +115,56 @@ func TestRawNodeProposeAndConfChange(t *testing.T) {
 	}
 }
 
+// TestRawNodeJointAutoLeave ensures that a ConfChangeV2 proposed through
+// RawNode.ProposeConfChange enters a joint configuration when applied, and that
+// the RawNode proposes and applies the transition out of it on its own.
+func TestRawNodeJointAutoLeave(t *testing.T) {
+	s := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, nil, 10, 1, s), []Peer{{ID: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	rawNode.Campaign()
+	cc := raftpb.ConfChangeV2{Changes: []raftpb.ConfChangeSingle{
+		{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 2},
+		{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3},
+	}}
+	proposed := false
+	var states []raftpb.ConfState
+	for i := 0; i < 10; i++ {
+		rd = rawNode.Ready()
+		s.Append(rd.Entries)
+		if !proposed && rd.SoftState != nil && rd.SoftState.Lead == rawNode.raft.id {
+			if err = rawNode.ProposeConfChange(cc); err != nil {
+				t.Fatal(err)
+			}
+			proposed = true
+		}
+		for _, entry := range rd.CommittedEntries {
+			if entry.Type == raftpb.EntryConfChangeV2 {
+				var cc raftpb.ConfChangeV2
+				if err = cc.Unmarshal(entry.Data); err != nil {
+					t.Fatal(err)
+				}
+				states = append(states, *rawNode.ApplyConfChange(cc))
+			}
+		}
+		rawNode.Advance(rd)
+	}
+
+	wstates := []raftpb.ConfState{
+		{Nodes: []uint64{1}, Learners: []uint64{2, 3}, VotersOutgoing: []uint64{1}, AutoLeave: true},
+		{Nodes: []uint64{1}, Learners: []uint64{2, 3}},
+	}
+	if !reflect.DeepEqual(states, wstates) {
+		t.Errorf("conf states = %+v, want %+v", states, wstates)
+	}
+}
+
 // TestRawNodeProposeAddDuplicateNode ensures that two proposes to add the same node should
 // not affect the later propose to add new node.
 func TestRawNodeProposeAddDuplicateNode(t *testing.T) {
@@ -215,7 +265,7 @@ func TestRawNodeReadIndex(t *testing.T) {
 	}
 	rd := rawNode.Ready()
 	if !reflect.DeepEqual(rd.ReadStates, wrs) {
-		t.Errorf("ReadStates = %d, want %d", rd.ReadStates, wrs)
+		t.Errorf("ReadStates = %+v, want %+v", rd.ReadStates, wrs)
 	}
 	s.Append(rd.Entries)
 	rawNode.Advance(rd)
@@ -616,3 +666,215 @@ func BenchmarkStatusProgress(b *testing.B) {
 		})
 	}
 }
+
+// asyncStorage handles the local storage messages of a node with
+// AsyncStorageWrites enabled on top of a MemoryStorage.
+type asyncStorage struct {
+	id   uint64
+	step func(raftpb.Message) error
+	s    *MemoryStorage
+	// appends and applies are the local messages that have not been processed
+	// yet, in the order they were received.
+	appends, applies []raftpb.Message
+	// applied are the entries applied to the "state machine".
+	applied []raftpb.Entry
+}
+
+// queue queues the local storage messages of rd and returns the messages
+// destined to other nodes.
+func (a *asyncStorage) queue(rd Ready) []raftpb.Message {
+	var msgs []raftpb.Message
+	for _, m := range rd.Messages {
+		switch m.To {
+		case LocalAppendThread:
+			a.appends = append(a.appends, m)
+		case LocalApplyThread:
+			a.applies = append(a.applies, m)
+		default:
+			msgs = append(msgs, m)
+		}
+	}
+	return msgs
+}
+
+// process handles all queued local messages and delivers their responses,
+// returning the responses destined to other nodes.
+func (a *asyncStorage) process(t *testing.T) []raftpb.Message {
+	var out []raftpb.Message
+	deliver := func(resps []raftpb.Message) {
+		for _, r := range resps {
+			if r.To != a.id {
+				out = append(out, r)
+				continue
+			}
+			if err := a.step(r); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	appends, applies := a.appends, a.applies
+	a.appends, a.applies = nil, nil
+	for _, m := range appends {
+		if !IsEmptySnap(m.Snapshot) {
+			if err := a.s.ApplySnapshot(m.Snapshot); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := a.s.Append(m.Entries); err != nil {
+			t.Fatal(err)
+		}
+		if m.Term != 0 || m.Vote != 0 || m.Commit != 0 {
+			if err := a.s.SetHardState(raftpb.HardState{Term: m.Term, Vote: m.Vote, Commit: m.Commit}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		deliver(m.Responses)
+	}
+	for _, m := range applies {
+		for _, e := range m.Entries {
+			if e.Type == raftpb.EntryNormal && len(e.Data) > 0 {
+				a.applied = append(a.applied, e)
+			}
+		}
+		deliver(m.Responses)
+	}
+	return out
+}
+
+func TestRawNodeAsyncStorageWritesSingleNode(t *testing.T) {
+	s := NewMemoryStorage()
+	cfg := newTestConfig(1, []uint64{1}, 10, 1, s)
+	cfg.AsyncStorageWrites = true
+	rn, err := NewRawNode(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &asyncStorage{id: 1, step: rn.Step, s: s}
+	ready := func() []raftpb.Message {
+		if !rn.HasReady() {
+			return nil
+		}
+		return a.queue(rn.Ready())
+	}
+
+	// The node only wins the election once its vote for itself is durable.
+	rn.Campaign()
+	if st := rn.raft.state; st != StateCandidate {
+		t.Fatalf("state = %s, want %s", st, StateCandidate)
+	}
+	ready()
+	a.process(t)
+	if st := rn.raft.state; st != StateLeader {
+		t.Fatalf("state = %s, want %s", st, StateLeader)
+	}
+	// Append, commit and apply the leader's empty entry.
+	for rn.HasReady() {
+		ready()
+		a.process(t)
+	}
+
+	data := []byte("somedata")
+	if err := rn.Propose(data); err != nil {
+		t.Fatal(err)
+	}
+	li := rn.raft.raftLog.lastIndex()
+	if msgs := ready(); len(msgs) != 0 {
+		t.Fatalf("unexpected messages: %v", msgs)
+	}
+	if len(a.appends) != 1 || len(a.applies) != 0 {
+		t.Fatalf("appends, applies = %d, %d, want 1, 0", len(a.appends), len(a.applies))
+	}
+	// The proposal is not committed before it is durable on the leader.
+	if c := rn.raft.raftLog.committed; c >= li {
+		t.Fatalf("committed = %d before append, want < %d", c, li)
+	}
+	// Nothing is handed out twice while the append is in flight.
+	if rn.HasReady() {
+		t.Fatalf("unexpected Ready: %+v", rn.Ready())
+	}
+	a.process(t)
+	if c := rn.raft.raftLog.committed; c != li {
+		t.Fatalf("committed = %d, want %d", c, li)
+	}
+	ready()
+	if len(a.applies) != 1 {
+		t.Fatalf("applies = %d, want 1", len(a.applies))
+	}
+	a.process(t)
+	if len(a.applied) != 1 || !bytes.Equal(a.applied[0].Data, data) {
+		t.Fatalf("applied = %+v, want entry with %q", a.applied, data)
+	}
+	if applied := rn.Status().Applied; applied != li {
+		t.Fatalf("status applied = %d, want %d", applied, li)
+	}
+}
+
+// TestRawNodeAsyncStorageWrites runs a three node cluster with async storage
+// writes, processing the local storage messages only after the next Ready has
+// been handed out, and ensures all nodes apply the same entries.
+func TestRawNodeAsyncStorageWrites(t *testing.T) {
+	peers := []uint64{1, 2, 3}
+	rns := make(map[uint64]*RawNode)
+	nodes := make(map[uint64]*asyncStorage)
+	for _, id := range peers {
+		s := NewMemoryStorage()
+		cfg := newTestConfig(id, peers, 10, 1, s)
+		cfg.AsyncStorageWrites = true
+		rn, err := NewRawNode(cfg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rns[id] = rn
+		nodes[id] = &asyncStorage{id: id, step: rn.Step, s: s}
+	}
+	var msgs []raftpb.Message
+	round := func() {
+		// Storage lags one Ready behind, which lets appends pipeline with
+		// the network.
+		for _, id := range peers {
+			n := nodes[id]
+			out := n.process(t)
+			if rns[id].HasReady() {
+				msgs = append(msgs, n.queue(rns[id].Ready())...)
+			}
+			msgs = append(msgs, out...)
+		}
+		cur := msgs
+		msgs = nil
+		for _, m := range cur {
+			rns[m.To].Step(m)
+		}
+	}
+
+	rns[1].Campaign()
+	for i := 0; i < 10 && rns[1].raft.state != StateLeader; i++ {
+		round()
+	}
+	if st := rns[1].raft.state; st != StateLeader {
+		t.Fatalf("state = %s, want %s", st, StateLeader)
+	}
+
+	var want [][]byte
+	for i := 0; i < 20; i++ {
+		data := []byte(fmt.Sprintf("prop-%d", i))
+		want = append(want, data)
+		if err := rns[1].Propose(data); err != nil {
+			t.Fatal(err)
+		}
+		if i%3 == 0 {
+			round()
+		}
+	}
+	for i := 0; i < 20; i++ {
+		round()
+	}
+	for _, id := range peers {
+		var got [][]byte
+		for _, e := range nodes[id].applied {
+			got = append(got, e.Data)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("node %d applied %q, want %q", id, got, want)
+		}
+	}
+}
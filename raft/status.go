@@ -30,6 +30,11 @@ type Status struct {
 	Progress map[uint64]Progress
 
 	LeadTransferee uint64
+
+	// UncommittedSize is the leader's estimate of the aggregate byte size of
+	// the uncommitted tail of its log, which MaxUncommittedEntriesSize bounds.
+	// It is zero on followers.
+	UncommittedSize uint64
 }
 
 func getProgressCopy(r *raft) map[uint64]Progress {
@@ -52,6 +57,9 @@ func getStatusWithoutProgress(r *raft) Status {
 	s.HardState = r.hardState()
 	s.SoftState = *r.softState()
 	s.Applied = r.raftLog.applied
+	if s.RaftState == StateLeader {
+		s.UncommittedSize = r.uncommittedSize
+	}
 	return s
 }
 
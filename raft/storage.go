@@ -81,6 +81,9 @@ type MemoryStorage struct {
 	snapshot  pb.Snapshot
 	// ents[i] has raft log position i+snapshot.Metadata.Index
 	ents []pb.Entry
+	// size is the aggregate byte size of the entries in ents, excluding
+	// the dummy entry at ents[0].
+	size uint64
 }
 
 // NewMemoryStorage creates an empty MemoryStorage.
@@ -160,6 +163,14 @@ func (ms *MemoryStorage) firstIndex() uint64 {
 	return ms.ents[0].Index + 1
 }
 
+// Size returns the aggregate byte size of the log entries held by the
+// storage.
+func (ms *MemoryStorage) Size() uint64 {
+	ms.Lock()
+	defer ms.Unlock()
+	return ms.size
+}
+
 // Snapshot implements the Storage interface.
 func (ms *MemoryStorage) Snapshot() (pb.Snapshot, error) {
 	ms.Lock()
@@ -182,6 +193,7 @@ func (ms *MemoryStorage) ApplySnapshot(snap pb.Snapshot) error {
 
 	ms.snapshot = snap
 	ms.ents = []pb.Entry{{Term: snap.Metadata.Term, Index: snap.Metadata.Index}}
+	ms.size = 0
 	return nil
 }
 
@@ -225,6 +237,7 @@ func (ms *MemoryStorage) Compact(compactIndex uint64) error {
 	}
 
 	i := compactIndex - offset
+	ms.size -= entsSize(ms.ents[1 : i+1])
 	ents := make([]pb.Entry, 1, 1+uint64(len(ms.ents))-i)
 	ents[0].Index = ms.ents[i].Index
 	ents[0].Term = ms.ents[i].Term
@@ -259,6 +272,7 @@ func (ms *MemoryStorage) Append(entries []pb.Entry) error {
 	offset := entries[0].Index - ms.ents[0].Index
 	switch {
 	case uint64(len(ms.ents)) > offset:
+		ms.size -= entsSize(ms.ents[offset:])
 		ms.ents = append([]pb.Entry{}, ms.ents[:offset]...)
 		ms.ents = append(ms.ents, entries...)
 	case uint64(len(ms.ents)) == offset:
@@ -267,5 +281,14 @@ func (ms *MemoryStorage) Append(entries []pb.Entry) error {
 		raftLogger.Panicf("missing log entry [last: %d, append at: %d]",
 			ms.lastIndex(), entries[0].Index)
 	}
+	ms.size += entsSize(entries)
 	return nil
 }
+
+func entsSize(ents []pb.Entry) uint64 {
+	var size uint64
+	for i := range ents {
+		size += uint64(ents[i].Size())
+	}
+	return size
+}
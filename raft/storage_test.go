@@ -158,7 +158,7 @@ func TestStorageCompact(t *testing.T) {
 	}
 
 	for i, tt := range tests {
-		s := &MemoryStorage{ents: ents}
+		s := &MemoryStorage{ents: ents, size: entsSize(ents[1:])}
 		err := s.Compact(tt.i)
 		if err != tt.werr {
 			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
@@ -172,6 +172,9 @@ func TestStorageCompact(t *testing.T) {
 		if len(s.ents) != tt.wlen {
 			t.Errorf("#%d: len = %d, want %d", i, len(s.ents), tt.wlen)
 		}
+		if w := entsSize(s.ents[1:]); s.Size() != w {
+			t.Errorf("#%d: size = %d, want %d", i, s.Size(), w)
+		}
 	}
 }
 
@@ -251,7 +254,7 @@ func TestStorageAppend(t *testing.T) {
 	}
 
 	for i, tt := range tests {
-		s := &MemoryStorage{ents: ents}
+		s := &MemoryStorage{ents: ents, size: entsSize(ents[1:])}
 		err := s.Append(tt.entries)
 		if err != tt.werr {
 			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
@@ -259,6 +262,9 @@ func TestStorageAppend(t *testing.T) {
 		if !reflect.DeepEqual(s.ents, tt.wentries) {
 			t.Errorf("#%d: entries = %v, want %v", i, s.ents, tt.wentries)
 		}
+		if w := entsSize(tt.wentries[1:]); s.Size() != w {
+			t.Errorf("#%d: size = %d, want %d", i, s.Size(), w)
+		}
 	}
 }
 
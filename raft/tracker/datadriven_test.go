@@ -0,0 +1,262 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var rewrite = flag.Bool("rewrite", false, "rewrite the expected output of the testdata files")
+
+// testCase is a single directive of a testdata file:
+//
+//	# optional comments
+//	<cmd> [key=(v1,v2,...) ...]
+//	----
+//	<expected output>
+//
+// Cases are separated by blank lines.
+type testCase struct {
+	pos  string
+	cmd  string
+	args map[string][]string
+	// start and end are the line indexes of the expected output.
+	start, end int
+	expected   string
+}
+
+func parseTestData(t *testing.T, path string) ([]string, []testCase) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimRight(string(b), "\n"), "\n")
+	var cases []testCase
+	for i := 0; i < len(lines); i++ {
+		l := strings.TrimSpace(lines[i])
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		tc := testCase{pos: fmt.Sprintf("%s:%d", path, i+1), args: map[string][]string{}}
+		fields := strings.Fields(l)
+		tc.cmd = fields[0]
+		for _, f := range fields[1:] {
+			kv := strings.SplitN(f, "=", 2)
+			if len(kv) != 2 || !strings.HasPrefix(kv[1], "(") || !strings.HasSuffix(kv[1], ")") {
+				t.Fatalf("%s: malformed argument %q", tc.pos, f)
+			}
+			var vals []string
+			if v := kv[1][1 : len(kv[1])-1]; v != "" {
+				vals = strings.Split(v, ",")
+			}
+			tc.args[kv[0]] = vals
+		}
+		i++
+		if i >= len(lines) || lines[i] != "----" {
+			t.Fatalf("%s: expected ---- after directive", tc.pos)
+		}
+		tc.start = i + 1
+		for i+1 < len(lines) && lines[i+1] != "" {
+			i++
+		}
+		tc.end = i + 1
+		tc.expected = strings.Join(lines[tc.start:tc.end], "\n")
+		cases = append(cases, tc)
+	}
+	return lines, cases
+}
+
+// runTestData runs every case in the given testdata file through f and
+// compares the result with the expected output. With -rewrite, the file is
+// updated with the actual output instead.
+func runTestData(t *testing.T, path string, f func(t *testing.T, tc testCase) string) {
+	lines, cases := parseTestData(t, path)
+	var out []string
+	last := 0
+	for _, tc := range cases {
+		actual := strings.TrimRight(f(t, tc), "\n")
+		if *rewrite {
+			out = append(out, lines[last:tc.start]...)
+			out = append(out, actual)
+			last = tc.end
+			continue
+		}
+		if actual != tc.expected {
+			t.Errorf("%s: %s\nexpected:\n%s\nactual:\n%s", tc.pos, tc.cmd, tc.expected, actual)
+		}
+	}
+	if *rewrite {
+		out = append(out, lines[last:]...)
+		if err := ioutil.WriteFile(path, []byte(strings.Join(out, "\n")+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func formatIndex(idx uint64) string {
+	if idx == math.MaxUint64 {
+		return "∞"
+	}
+	return strconv.FormatUint(idx, 10)
+}
+
+func parseIDs(t *testing.T, tc testCase, key string) []uint64 {
+	var ids []uint64
+	for _, s := range tc.args[key] {
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.pos, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// voters returns the sorted union of the voters in c. Per-voter arguments
+// (idx and votes) are given in this order.
+func voters(c JointConfig) []uint64 {
+	var ids []uint64
+	for id := range c.IDs() {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// naiveCommittedIndex finds the largest index acknowledged by a majority of
+// c by trying every acknowledged index, as a cross-check for CommittedIndex.
+func naiveCommittedIndex(c MajorityConfig, l MapAckIndexer) uint64 {
+	if len(c) == 0 {
+		return math.MaxUint64
+	}
+	var max uint64
+	for _, cand := range l {
+		var n int
+		for id := range c {
+			if idx, ok := l[id]; ok && idx >= cand {
+				n++
+			}
+		}
+		if n >= len(c)/2+1 && cand > max {
+			max = cand
+		}
+	}
+	return max
+}
+
+func testQuorum(t *testing.T, tc testCase) string {
+	c := JointConfig{
+		NewMajorityConfig(parseIDs(t, tc, "cfg")...),
+		NewMajorityConfig(parseIDs(t, tc, "cfgj")...),
+	}
+	ids := voters(c)
+	var buf bytes.Buffer
+
+	switch tc.cmd {
+	case "committed":
+		args := tc.args["idx"]
+		if len(args) != len(ids) {
+			t.Fatalf("%s: need %d indexes, got %d", tc.pos, len(ids), len(args))
+		}
+		l := MapAckIndexer{}
+		for i, s := range args {
+			if s == "_" {
+				continue
+			}
+			idx, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				t.Fatalf("%s: %v", tc.pos, err)
+			}
+			l[ids[i]] = idx
+		}
+		idx := c.CommittedIndex(l)
+		if !c.IsJoint() {
+			// A half-populated or doubled-up joint config must behave like
+			// the plain majority config.
+			if aIdx := c[0].CommittedIndex(l); aIdx != idx {
+				fmt.Fprintf(&buf, "%s <-- via plain majority config\n", formatIndex(aIdx))
+			}
+			if aIdx := (JointConfig{c[0], c[0]}).CommittedIndex(l); aIdx != idx {
+				fmt.Fprintf(&buf, "%s <-- via self-joint quorum\n", formatIndex(aIdx))
+			}
+			if aIdx := naiveCommittedIndex(c[0], l); aIdx != idx {
+				fmt.Fprintf(&buf, "%s <-- via naive computation\n", formatIndex(aIdx))
+			}
+		} else {
+			// The order of the constituent configs does not matter.
+			if aIdx := (JointConfig{c[1], c[0]}).CommittedIndex(l); aIdx != idx {
+				fmt.Fprintf(&buf, "%s <-- via swapped joint quorum\n", formatIndex(aIdx))
+			}
+		}
+		fmt.Fprintf(&buf, "%s\n", formatIndex(idx))
+	case "vote":
+		args := tc.args["votes"]
+		if len(args) != len(ids) {
+			t.Fatalf("%s: need %d votes, got %d", tc.pos, len(ids), len(args))
+		}
+		votes := map[uint64]bool{}
+		for i, s := range args {
+			switch s {
+			case "y":
+				votes[ids[i]] = true
+			case "n":
+				votes[ids[i]] = false
+			case "_":
+			default:
+				t.Fatalf("%s: unknown vote %q", tc.pos, s)
+			}
+		}
+		r := c.VoteResult(votes)
+		if !c.IsJoint() {
+			if ar := c[0].VoteResult(votes); ar != r {
+				fmt.Fprintf(&buf, "%v <-- via plain majority config\n", ar)
+			}
+			if ar := (JointConfig{c[0], c[0]}).VoteResult(votes); ar != r {
+				fmt.Fprintf(&buf, "%v <-- via self-joint quorum\n", ar)
+			}
+		} else if ar := (JointConfig{c[1], c[0]}).VoteResult(votes); ar != r {
+			fmt.Fprintf(&buf, "%v <-- via swapped joint quorum\n", ar)
+		}
+		fmt.Fprintf(&buf, "%v\n", r)
+	default:
+		t.Fatalf("%s: unknown command %q", tc.pos, tc.cmd)
+	}
+	return buf.String()
+}
+
+func TestDataDriven(t *testing.T) {
+	paths, err := filepath.Glob("testdata/*.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no testdata files found")
+	}
+	for _, path := range paths {
+		path := path
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			runTestData(t, path, testQuorum)
+		})
+	}
+}
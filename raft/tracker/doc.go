@@ -0,0 +1,28 @@
+// Copyright 2019 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package tracker implements the quorum computations used by package raft.
+
+A MajorityConfig is a set of voters that makes decisions by simple majority;
+a JointConfig combines an incoming and an outgoing MajorityConfig and requires
+both of them to agree, which is what raft uses during joint consensus
+configuration changes. Both compute the committed index from the indexes that
+voters have acknowledged (see AckedIndexer) and the outcome of an election
+from a set of votes (see VoteResult).
+
+The package has no dependency on the rest of raft, so that quorum decisions
+can be tested in isolation and reused by other replicated systems.
+*/
+package tracker
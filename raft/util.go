@@ -48,7 +48,15 @@ func max(a, b uint64) uint64 {
 
 func IsLocalMsg(msgt pb.MessageType) bool {
 	return msgt == pb.MsgHup || msgt == pb.MsgBeat || msgt == pb.MsgUnreachable ||
-		msgt == pb.MsgSnapStatus || msgt == pb.MsgCheckQuorum
+		msgt == pb.MsgSnapStatus || msgt == pb.MsgCheckQuorum ||
+		msgt == pb.MsgStorageAppend || msgt == pb.MsgStorageAppendResp ||
+		msgt == pb.MsgStorageApply || msgt == pb.MsgStorageApplyResp
+}
+
+// IsLocalMsgTarget reports whether id is one of the local storage threads
+// that messages are addressed to with Config.AsyncStorageWrites.
+func IsLocalMsgTarget(id uint64) bool {
+	return id == LocalAppendThread || id == LocalApplyThread
 }
 
 func IsResponseMsg(msgt pb.MessageType) bool {
@@ -76,6 +76,11 @@ func watchTest(cx ctlCtx) {
 			args:   []string{"--rev", "1", "--prefix"},
 			wkv:    []kvExec{{key: "key1", val: "val1"}, {key: "key2", val: "val2"}, {key: "key3", val: "val3"}},
 		},
+		{ // watch 3 keys by prefix with the initial state
+			puts: []kv{{"init1", "val1"}, {"init2", "val2"}, {"init3", "val3"}},
+			args: []string{"init", "--prefix", "--initial"},
+			wkv:  []kvExec{{key: "init1", val: "val1"}, {key: "init2", val: "val2"}, {key: "init3", val: "val3"}},
+		},
 		{ // watch by revision
 			puts: []kv{{"etcd", "revision_1"}, {"etcd", "revision_2"}, {"etcd", "revision_3"}},
 			args: []string{"etcd", "--rev", "2"},
@@ -31,7 +31,23 @@ func snapDir(dataDir string) string {
 	return filepath.Join(dataDir, "member", "snap")
 }
 
+// checkEngine returns an error if the database was not written by the bbolt
+// storage engine, the only one the tool reads.
+func checkEngine(dbPath string) error {
+	engine, err := backend.DetectEngine(dbPath)
+	if err != nil {
+		return err
+	}
+	if engine != backend.EngineBolt {
+		return fmt.Errorf("%s is a %s storage engine database; only %s databases are supported", dbPath, engine, backend.EngineBolt)
+	}
+	return nil
+}
+
 func getBuckets(dbPath string) (buckets []string, err error) {
+	if err = checkEngine(dbPath); err != nil {
+		return nil, err
+	}
 	db, derr := bolt.Open(dbPath, 0600, &bolt.Options{Timeout: flockTimeout})
 	if derr != nil {
 		return nil, fmt.Errorf("failed to open bolt DB %v", derr)
@@ -71,7 +87,7 @@ func bytesToRev(bytes []byte) revision {
 func keyDecoder(k, v []byte) {
 	rev := bytesToRev(k)
 	var kv mvccpb.KeyValue
-	if err := kv.Unmarshal(v); err != nil {
+	if err := mvcc.UnmarshalKeyValue(v, &kv); err != nil {
 		panic(err)
 	}
 	fmt.Printf("rev=%+v, value=[key %q | val %q | created %d | mod %d | ver %d]\n", rev, string(kv.Key), string(kv.Value), kv.CreateRevision, kv.ModRevision, kv.Version)
@@ -94,6 +110,9 @@ func leaseDecoder(k, v []byte) {
 }
 
 func iterateBucket(dbPath, bucket string, limit uint64, decode bool) (err error) {
+	if err = checkEngine(dbPath); err != nil {
+		return err
+	}
 	db, err := bolt.Open(dbPath, 0600, &bolt.Options{Timeout: flockTimeout})
 	if err != nil {
 		return fmt.Errorf("failed to open bolt DB %v", err)
@@ -130,6 +149,9 @@ func iterateBucket(dbPath, bucket string, limit uint64, decode bool) (err error)
 }
 
 func getHash(dbPath string) (hash uint32, err error) {
+	if err = checkEngine(dbPath); err != nil {
+		return 0, err
+	}
 	b := backend.NewDefaultBackend(dbPath)
 	return b.Hash(mvcc.DefaultIgnores)
 }